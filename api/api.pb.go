@@ -145,11 +145,13 @@ type RemoteRepo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Source      string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	WebUrl      string `protobuf:"bytes,5,opt,name=web_url,json=webUrl,proto3" json:"web_url,omitempty"`
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source          string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Name            string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	WebUrl          string `protobuf:"bytes,5,opt,name=web_url,json=webUrl,proto3" json:"web_url,omitempty"`
+	IssuesUrl       string `protobuf:"bytes,6,opt,name=issues_url,json=issuesUrl,proto3" json:"issues_url,omitempty"`
+	PullRequestsUrl string `protobuf:"bytes,7,opt,name=pull_requests_url,json=pullRequestsUrl,proto3" json:"pull_requests_url,omitempty"`
 }
 
 func (x *RemoteRepo) Reset() {
@@ -219,6 +221,20 @@ func (x *RemoteRepo) GetWebUrl() string {
 	return ""
 }
 
+func (x *RemoteRepo) GetIssuesUrl() string {
+	if x != nil {
+		return x.IssuesUrl
+	}
+	return ""
+}
+
+func (x *RemoteRepo) GetPullRequestsUrl() string {
+	if x != nil {
+		return x.PullRequestsUrl
+	}
+	return ""
+}
+
 type LocalRepo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -274,6 +290,140 @@ func (x *LocalRepo) GetAbsoluteCloneDir() string {
 	return ""
 }
 
+type CloneURL struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Url      string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *CloneURL) Reset() {
+	*x = CloneURL{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneURL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneURL) ProtoMessage() {}
+
+func (x *CloneURL) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneURL.ProtoReflect.Descriptor instead.
+func (*CloneURL) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CloneURL) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *CloneURL) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type LocalRepoStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Branch                string   `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	Head                  string   `protobuf:"bytes,2,opt,name=head,proto3" json:"head,omitempty"`
+	HasUncommittedChanges bool     `protobuf:"varint,3,opt,name=has_uncommitted_changes,json=hasUncommittedChanges,proto3" json:"has_uncommitted_changes,omitempty"`
+	UnpushedBranches      []string `protobuf:"bytes,4,rep,name=unpushed_branches,json=unpushedBranches,proto3" json:"unpushed_branches,omitempty"`
+	HasStashes            bool     `protobuf:"varint,5,opt,name=has_stashes,json=hasStashes,proto3" json:"has_stashes,omitempty"`
+}
+
+func (x *LocalRepoStatus) Reset() {
+	*x = LocalRepoStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalRepoStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalRepoStatus) ProtoMessage() {}
+
+func (x *LocalRepoStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalRepoStatus.ProtoReflect.Descriptor instead.
+func (*LocalRepoStatus) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LocalRepoStatus) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *LocalRepoStatus) GetHead() string {
+	if x != nil {
+		return x.Head
+	}
+	return ""
+}
+
+func (x *LocalRepoStatus) GetHasUncommittedChanges() bool {
+	if x != nil {
+		return x.HasUncommittedChanges
+	}
+	return false
+}
+
+func (x *LocalRepoStatus) GetUnpushedBranches() []string {
+	if x != nil {
+		return x.UnpushedBranches
+	}
+	return nil
+}
+
+func (x *LocalRepoStatus) GetHasStashes() bool {
+	if x != nil {
+		return x.HasStashes
+	}
+	return false
+}
+
 type ClientOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -285,7 +435,7 @@ type ClientOptions struct {
 func (x *ClientOptions) Reset() {
 	*x = ClientOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[3]
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -298,7 +448,7 @@ func (x *ClientOptions) String() string {
 func (*ClientOptions) ProtoMessage() {}
 
 func (x *ClientOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[3]
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,7 +461,7 @@ func (x *ClientOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientOptions.ProtoReflect.Descriptor instead.
 func (*ClientOptions) Descriptor() ([]byte, []int) {
-	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{3}
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ClientOptions) GetVerbose() bool {
@@ -332,7 +482,7 @@ type ClientOutput struct {
 func (x *ClientOutput) Reset() {
 	*x = ClientOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[4]
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -345,7 +495,7 @@ func (x *ClientOutput) String() string {
 func (*ClientOutput) ProtoMessage() {}
 
 func (x *ClientOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[4]
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,7 +508,7 @@ func (x *ClientOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientOutput.ProtoReflect.Descriptor instead.
 func (*ClientOutput) Descriptor() ([]byte, []int) {
-	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{4}
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ClientOutput) GetMessage() string {
@@ -377,7 +527,7 @@ type DaemonInfoRequest struct {
 func (x *DaemonInfoRequest) Reset() {
 	*x = DaemonInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[5]
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -390,7 +540,7 @@ func (x *DaemonInfoRequest) String() string {
 func (*DaemonInfoRequest) ProtoMessage() {}
 
 func (x *DaemonInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[5]
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,7 +553,7 @@ func (x *DaemonInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DaemonInfoRequest.ProtoReflect.Descriptor instead.
 func (*DaemonInfoRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{5}
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{7}
 }
 
 type DaemonInfoResponse struct {
@@ -418,7 +568,7 @@ type DaemonInfoResponse struct {
 func (x *DaemonInfoResponse) Reset() {
 	*x = DaemonInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[6]
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -431,7 +581,7 @@ func (x *DaemonInfoResponse) String() string {
 func (*DaemonInfoResponse) ProtoMessage() {}
 
 func (x *DaemonInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[6]
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -444,7 +594,7 @@ func (x *DaemonInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DaemonInfoResponse.ProtoReflect.Descriptor instead.
 func (*DaemonInfoResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{6}
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DaemonInfoResponse) GetVersion() string {
@@ -470,7 +620,7 @@ type ListSourcesRequest struct {
 func (x *ListSourcesRequest) Reset() {
 	*x = ListSourcesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[7]
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -483,7 +633,7 @@ func (x *ListSourcesRequest) String() string {
 func (*ListSourcesRequest) ProtoMessage() {}
 
 func (x *ListSourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[7]
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,7 +646,7 @@ func (x *ListSourcesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSourcesRequest.ProtoReflect.Descriptor instead.
 func (*ListSourcesRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{7}
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{9}
 }
 
 type ListSourcesResponse struct {
@@ -510,7 +660,7 @@ type ListSourcesResponse struct {
 func (x *ListSourcesResponse) Reset() {
 	*x = ListSourcesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[8]
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -523,7 +673,7 @@ func (x *ListSourcesResponse) String() string {
 func (*ListSourcesResponse) ProtoMessage() {}
 
 func (x *ListSourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[8]
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -536,7 +686,7 @@ func (x *ListSourcesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSourcesResponse.ProtoReflect.Descriptor instead.
 func (*ListSourcesResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{8}
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListSourcesResponse) GetSources() []*Source {
@@ -557,7 +707,7 @@ type SignInRequest struct {
 func (x *SignInRequest) Reset() {
 	*x = SignInRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[9]
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -570,7 +720,7 @@ func (x *SignInRequest) String() string {
 func (*SignInRequest) ProtoMessage() {}
 
 func (x *SignInRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[9]
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -583,7 +733,7 @@ func (x *SignInRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignInRequest.ProtoReflect.Descriptor instead.
 func (*SignInRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{9}
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SignInRequest) GetSource() string {
@@ -607,7 +757,7 @@ type SignInResponse struct {
 func (x *SignInResponse) Reset() {
 	*x = SignInResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[10]
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -620,7 +770,7 @@ func (x *SignInResponse) String() string {
 func (*SignInResponse) ProtoMessage() {}
 
 func (x *SignInResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[10]
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -633,7 +783,7 @@ func (x *SignInResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignInResponse.ProtoReflect.Descriptor instead.
 func (*SignInResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{10}
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{12}
 }
 
 func (m *SignInResponse) GetResponse() isSignInResponse_Response {
@@ -671,7 +821,7 @@ type SignOutRequest struct {
 func (x *SignOutRequest) Reset() {
 	*x = SignOutRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[11]
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -684,7 +834,7 @@ func (x *SignOutRequest) String() string {
 func (*SignOutRequest) ProtoMessage() {}
 
 func (x *SignOutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[11]
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,7 +847,7 @@ func (x *SignOutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignOutRequest.ProtoReflect.Descriptor instead.
 func (*SignOutRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{11}
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SignOutRequest) GetSource() string {
@@ -716,7 +866,7 @@ type SignOutResponse struct {
 func (x *SignOutResponse) Reset() {
 	*x = SignOutResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[12]
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -729,7 +879,7 @@ func (x *SignOutResponse) String() string {
 func (*SignOutResponse) ProtoMessage() {}
 
 func (x *SignOutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[12]
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -742,7 +892,7 @@ func (x *SignOutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignOutResponse.ProtoReflect.Descriptor instead.
 func (*SignOutResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{12}
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{14}
 }
 
 type ResolveRepoRequest struct {
@@ -759,7 +909,7 @@ type ResolveRepoRequest struct {
 func (x *ResolveRepoRequest) Reset() {
 	*x = ResolveRepoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[13]
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -772,7 +922,7 @@ func (x *ResolveRepoRequest) String() string {
 func (*ResolveRepoRequest) ProtoMessage() {}
 
 func (x *ResolveRepoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[13]
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -785,7 +935,7 @@ func (x *ResolveRepoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveRepoRequest.ProtoReflect.Descriptor instead.
 func (*ResolveRepoRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{13}
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ResolveRepoRequest) GetClientOptions() *ClientOptions {
@@ -832,7 +982,7 @@ type ResolveRepoResponse struct {
 func (x *ResolveRepoResponse) Reset() {
 	*x = ResolveRepoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[14]
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -845,7 +995,7 @@ func (x *ResolveRepoResponse) String() string {
 func (*ResolveRepoResponse) ProtoMessage() {}
 
 func (x *ResolveRepoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[14]
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -858,7 +1008,7 @@ func (x *ResolveRepoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveRepoResponse.ProtoReflect.Descriptor instead.
 func (*ResolveRepoResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{14}
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{16}
 }
 
 func (m *ResolveRepoResponse) GetResponse() isResolveRepoResponse_Response {
@@ -919,12 +1069,13 @@ type CloneRepoRequest struct {
 	ClientOptions *ClientOptions `protobuf:"bytes,1,opt,name=client_options,json=clientOptions,proto3" json:"client_options,omitempty"`
 	Source        string         `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
 	RepoId        string         `protobuf:"bytes,3,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
+	CloneOptions  *CloneOptions  `protobuf:"bytes,4,opt,name=clone_options,json=cloneOptions,proto3" json:"clone_options,omitempty"`
 }
 
 func (x *CloneRepoRequest) Reset() {
 	*x = CloneRepoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[15]
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -937,7 +1088,7 @@ func (x *CloneRepoRequest) String() string {
 func (*CloneRepoRequest) ProtoMessage() {}
 
 func (x *CloneRepoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[15]
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,7 +1101,7 @@ func (x *CloneRepoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloneRepoRequest.ProtoReflect.Descriptor instead.
 func (*CloneRepoRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{15}
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CloneRepoRequest) GetClientOptions() *ClientOptions {
@@ -974,6 +1125,68 @@ func (x *CloneRepoRequest) GetRepoId() string {
 	return ""
 }
 
+func (x *CloneRepoRequest) GetCloneOptions() *CloneOptions {
+	if x != nil {
+		return x.CloneOptions
+	}
+	return nil
+}
+
+type CloneOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Depth        *uint32 `protobuf:"varint,1,opt,name=depth,proto3,oneof" json:"depth,omitempty"`
+	SingleBranch *bool   `protobuf:"varint,2,opt,name=single_branch,json=singleBranch,proto3,oneof" json:"single_branch,omitempty"`
+}
+
+func (x *CloneOptions) Reset() {
+	*x = CloneOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneOptions) ProtoMessage() {}
+
+func (x *CloneOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneOptions.ProtoReflect.Descriptor instead.
+func (*CloneOptions) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CloneOptions) GetDepth() uint32 {
+	if x != nil && x.Depth != nil {
+		return *x.Depth
+	}
+	return 0
+}
+
+func (x *CloneOptions) GetSingleBranch() bool {
+	if x != nil && x.SingleBranch != nil {
+		return *x.SingleBranch
+	}
+	return false
+}
+
 type CloneRepoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -983,13 +1196,14 @@ type CloneRepoResponse struct {
 	//
 	//	*CloneRepoResponse_Output
 	//	*CloneRepoResponse_LocalRepo
+	//	*CloneRepoResponse_Progress
 	Response isCloneRepoResponse_Response `protobuf_oneof:"response"`
 }
 
 func (x *CloneRepoResponse) Reset() {
 	*x = CloneRepoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[16]
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1002,7 +1216,7 @@ func (x *CloneRepoResponse) String() string {
 func (*CloneRepoResponse) ProtoMessage() {}
 
 func (x *CloneRepoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[16]
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1015,7 +1229,7 @@ func (x *CloneRepoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloneRepoResponse.ProtoReflect.Descriptor instead.
 func (*CloneRepoResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{16}
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{19}
 }
 
 func (m *CloneRepoResponse) GetResponse() isCloneRepoResponse_Response {
@@ -1039,6 +1253,13 @@ func (x *CloneRepoResponse) GetLocalRepo() *LocalRepo {
 	return nil
 }
 
+func (x *CloneRepoResponse) GetProgress() *CloneProgress {
+	if x, ok := x.GetResponse().(*CloneRepoResponse_Progress); ok {
+		return x.Progress
+	}
+	return nil
+}
+
 type isCloneRepoResponse_Response interface {
 	isCloneRepoResponse_Response()
 }
@@ -1051,36 +1272,44 @@ type CloneRepoResponse_LocalRepo struct {
 	LocalRepo *LocalRepo `protobuf:"bytes,2,opt,name=local_repo,json=localRepo,proto3,oneof"`
 }
 
+type CloneRepoResponse_Progress struct {
+	Progress *CloneProgress `protobuf:"bytes,3,opt,name=progress,proto3,oneof"`
+}
+
 func (*CloneRepoResponse_Output) isCloneRepoResponse_Response() {}
 
 func (*CloneRepoResponse_LocalRepo) isCloneRepoResponse_Response() {}
 
-type SuggestReposRequest struct {
+func (*CloneRepoResponse_Progress) isCloneRepoResponse_Response() {}
+
+type CloneReposRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Word           string     `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
-	LocalityFilter []Locality `protobuf:"varint,2,rep,packed,name=locality_filter,json=localityFilter,proto3,enum=grit.v2.api.Locality" json:"locality_filter,omitempty"`
+	ClientOptions *ClientOptions `protobuf:"bytes,1,opt,name=client_options,json=clientOptions,proto3" json:"client_options,omitempty"`
+	Targets       []*CloneTarget `protobuf:"bytes,2,rep,name=targets,proto3" json:"targets,omitempty"`
+	Concurrency   uint32         `protobuf:"varint,3,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	CloneOptions  *CloneOptions  `protobuf:"bytes,4,opt,name=clone_options,json=cloneOptions,proto3" json:"clone_options,omitempty"`
 }
 
-func (x *SuggestReposRequest) Reset() {
-	*x = SuggestReposRequest{}
+func (x *CloneReposRequest) Reset() {
+	*x = CloneReposRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[17]
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SuggestReposRequest) String() string {
+func (x *CloneReposRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuggestReposRequest) ProtoMessage() {}
+func (*CloneReposRequest) ProtoMessage() {}
 
-func (x *SuggestReposRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[17]
+func (x *CloneReposRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1091,304 +1320,3895 @@ func (x *SuggestReposRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuggestReposRequest.ProtoReflect.Descriptor instead.
-func (*SuggestReposRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CloneReposRequest.ProtoReflect.Descriptor instead.
+func (*CloneReposRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *SuggestReposRequest) GetWord() string {
+func (x *CloneReposRequest) GetClientOptions() *ClientOptions {
 	if x != nil {
-		return x.Word
+		return x.ClientOptions
+	}
+	return nil
+}
+
+func (x *CloneReposRequest) GetTargets() []*CloneTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *CloneReposRequest) GetConcurrency() uint32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *CloneReposRequest) GetCloneOptions() *CloneOptions {
+	if x != nil {
+		return x.CloneOptions
+	}
+	return nil
+}
+
+type CloneTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	RepoId string `protobuf:"bytes,2,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
+}
+
+func (x *CloneTarget) Reset() {
+	*x = CloneTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneTarget) ProtoMessage() {}
+
+func (x *CloneTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneTarget.ProtoReflect.Descriptor instead.
+func (*CloneTarget) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CloneTarget) GetSource() string {
+	if x != nil {
+		return x.Source
 	}
 	return ""
 }
 
-func (x *SuggestReposRequest) GetLocalityFilter() []Locality {
+func (x *CloneTarget) GetRepoId() string {
 	if x != nil {
-		return x.LocalityFilter
+		return x.RepoId
+	}
+	return ""
+}
+
+type CloneReposResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*CloneReposResponse_Output
+	//	*CloneReposResponse_Result
+	Response isCloneReposResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *CloneReposResponse) Reset() {
+	*x = CloneReposResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneReposResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneReposResponse) ProtoMessage() {}
+
+func (x *CloneReposResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneReposResponse.ProtoReflect.Descriptor instead.
+func (*CloneReposResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (m *CloneReposResponse) GetResponse() isCloneReposResponse_Response {
+	if m != nil {
+		return m.Response
 	}
 	return nil
 }
 
-type SuggestResponse struct {
+func (x *CloneReposResponse) GetOutput() *ClientOutput {
+	if x, ok := x.GetResponse().(*CloneReposResponse_Output); ok {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *CloneReposResponse) GetResult() *CloneRepoResult {
+	if x, ok := x.GetResponse().(*CloneReposResponse_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+type isCloneReposResponse_Response interface {
+	isCloneReposResponse_Response()
+}
+
+type CloneReposResponse_Output struct {
+	Output *ClientOutput `protobuf:"bytes,1,opt,name=output,proto3,oneof"`
+}
+
+type CloneReposResponse_Result struct {
+	Result *CloneRepoResult `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
+}
+
+func (*CloneReposResponse_Output) isCloneReposResponse_Response() {}
+
+func (*CloneReposResponse_Result) isCloneReposResponse_Response() {}
+
+type CloneRepoResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Words []string `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	Target        *CloneTarget `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	LocalRepo     *LocalRepo   `protobuf:"bytes,2,opt,name=local_repo,json=localRepo,proto3" json:"local_repo,omitempty"`
+	AlreadyCloned bool         `protobuf:"varint,3,opt,name=already_cloned,json=alreadyCloned,proto3" json:"already_cloned,omitempty"`
+	Error         string       `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *SuggestResponse) Reset() {
-	*x = SuggestResponse{}
+func (x *CloneRepoResult) Reset() {
+	*x = CloneRepoResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[18]
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SuggestResponse) String() string {
+func (x *CloneRepoResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuggestResponse) ProtoMessage() {}
+func (*CloneRepoResult) ProtoMessage() {}
 
-func (x *SuggestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[18]
+func (x *CloneRepoResult) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneRepoResult.ProtoReflect.Descriptor instead.
+func (*CloneRepoResult) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CloneRepoResult) GetTarget() *CloneTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *CloneRepoResult) GetLocalRepo() *LocalRepo {
+	if x != nil {
+		return x.LocalRepo
+	}
+	return nil
+}
+
+func (x *CloneRepoResult) GetAlreadyCloned() bool {
+	if x != nil {
+		return x.AlreadyCloned
+	}
+	return false
+}
+
+func (x *CloneRepoResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CloneProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase   string `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Current uint64 `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"`
+	Total   uint64 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Bytes   uint64 `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (x *CloneProgress) Reset() {
+	*x = CloneProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneProgress) ProtoMessage() {}
+
+func (x *CloneProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneProgress.ProtoReflect.Descriptor instead.
+func (*CloneProgress) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CloneProgress) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *CloneProgress) GetCurrent() uint64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *CloneProgress) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CloneProgress) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+type ForkRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientOptions *ClientOptions `protobuf:"bytes,1,opt,name=client_options,json=clientOptions,proto3" json:"client_options,omitempty"`
+	Source        string         `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	RepoId        string         `protobuf:"bytes,3,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
+	CloneOptions  *CloneOptions  `protobuf:"bytes,4,opt,name=clone_options,json=cloneOptions,proto3" json:"clone_options,omitempty"`
+}
+
+func (x *ForkRepoRequest) Reset() {
+	*x = ForkRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForkRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkRepoRequest) ProtoMessage() {}
+
+func (x *ForkRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SuggestResponse.ProtoReflect.Descriptor instead.
-func (*SuggestResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *SuggestResponse) GetWords() []string {
-	if x != nil {
-		return x.Words
-	}
-	return nil
-}
-
-var File_github_com_gritcli_grit_api_api_proto protoreflect.FileDescriptor
-
-var file_github_com_gritcli_grit_api_api_proto_rawDesc = []byte{
-	0x0a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x69,
-	0x74, 0x63, 0x6c, 0x69, 0x2f, 0x67, 0x72, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
-	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32,
-	0x2e, 0x61, 0x70, 0x69, 0x22, 0x7c, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x0e,
-	0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x44,
-	0x69, 0x72, 0x22, 0x83, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x70,
-	0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x17, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x77, 0x65, 0x62, 0x55, 0x72, 0x6c, 0x22, 0x73, 0x0a, 0x09, 0x4c, 0x6f, 0x63, 0x61,
-	0x6c, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x38, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
-	0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x72, 0x69,
-	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12,
-	0x2c, 0x0a, 0x12, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x6e,
-	0x65, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x62, 0x73,
-	0x6f, 0x6c, 0x75, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x44, 0x69, 0x72, 0x22, 0x29, 0x0a,
-	0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x12, 0x44, 0x61, 0x65, 0x6d, 0x6f,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x70, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x44, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76,
-	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x51,
-	0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x33, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x28, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x53,
-	0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd2,
-	0x01, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x23,
-	0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x67,
-	0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x22, 0xcb, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72,
-	0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x12, 0x37, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x48, 0x00, 0x52, 0x09,
-	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x3a, 0x0a, 0x0b, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74,
-	0x65, 0x52, 0x65, 0x70, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x86, 0x01, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x49, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x11, 0x43,
-	0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x33, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72,
-	0x65, 0x70, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x69, 0x74,
-	0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70,
-	0x6f, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x42, 0x0a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x13, 0x53, 0x75,
-	0x67, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x3e, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74,
-	0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63,
-	0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x27, 0x0a, 0x0f, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2a, 0x37,
-	0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x49, 0x54, 0x59, 0x10, 0x00,
-	0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52,
-	0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0x02, 0x32, 0xa3, 0x04, 0x0a, 0x03, 0x41, 0x50, 0x49, 0x12,
-	0x4d, 0x0a, 0x0a, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e,
-	0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x65, 0x6d,
-	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x65, 0x6d,
-	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
-	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x2e,
-	0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
-	0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x43, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x12, 0x1a, 0x2e, 0x67, 0x72, 0x69,
-	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x07, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74,
-	0x12, 0x1b, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
-	0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x69, 0x67, 0x6e,
-	0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b, 0x52,
-	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x1f, 0x2e, 0x67, 0x72, 0x69,
-	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x72,
-	0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
-	0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12,
-	0x4c, 0x0a, 0x09, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x1d, 0x2e, 0x67,
-	0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
-	0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x72,
-	0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4e, 0x0a,
-	0x0c, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x20, 0x2e,
-	0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x67, 0x67,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1c, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75,
-	0x67, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1d, 0x5a,
-	0x1b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x69, 0x74,
-	0x63, 0x6c, 0x69, 0x2f, 0x67, 0x72, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_github_com_gritcli_grit_api_api_proto_rawDescOnce sync.Once
-	file_github_com_gritcli_grit_api_api_proto_rawDescData = file_github_com_gritcli_grit_api_api_proto_rawDesc
-)
-
-func file_github_com_gritcli_grit_api_api_proto_rawDescGZIP() []byte {
-	file_github_com_gritcli_grit_api_api_proto_rawDescOnce.Do(func() {
-		file_github_com_gritcli_grit_api_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_gritcli_grit_api_api_proto_rawDescData)
-	})
-	return file_github_com_gritcli_grit_api_api_proto_rawDescData
-}
-
-var file_github_com_gritcli_grit_api_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_gritcli_grit_api_api_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
-var file_github_com_gritcli_grit_api_api_proto_goTypes = []interface{}{
-	(Locality)(0),               // 0: grit.v2.api.Locality
-	(*Source)(nil),              // 1: grit.v2.api.Source
-	(*RemoteRepo)(nil),          // 2: grit.v2.api.RemoteRepo
-	(*LocalRepo)(nil),           // 3: grit.v2.api.LocalRepo
-	(*ClientOptions)(nil),       // 4: grit.v2.api.ClientOptions
-	(*ClientOutput)(nil),        // 5: grit.v2.api.ClientOutput
-	(*DaemonInfoRequest)(nil),   // 6: grit.v2.api.DaemonInfoRequest
-	(*DaemonInfoResponse)(nil),  // 7: grit.v2.api.DaemonInfoResponse
-	(*ListSourcesRequest)(nil),  // 8: grit.v2.api.ListSourcesRequest
-	(*ListSourcesResponse)(nil), // 9: grit.v2.api.ListSourcesResponse
-	(*SignInRequest)(nil),       // 10: grit.v2.api.SignInRequest
-	(*SignInResponse)(nil),      // 11: grit.v2.api.SignInResponse
-	(*SignOutRequest)(nil),      // 12: grit.v2.api.SignOutRequest
-	(*SignOutResponse)(nil),     // 13: grit.v2.api.SignOutResponse
-	(*ResolveRepoRequest)(nil),  // 14: grit.v2.api.ResolveRepoRequest
-	(*ResolveRepoResponse)(nil), // 15: grit.v2.api.ResolveRepoResponse
-	(*CloneRepoRequest)(nil),    // 16: grit.v2.api.CloneRepoRequest
-	(*CloneRepoResponse)(nil),   // 17: grit.v2.api.CloneRepoResponse
-	(*SuggestReposRequest)(nil), // 18: grit.v2.api.SuggestReposRequest
-	(*SuggestResponse)(nil),     // 19: grit.v2.api.SuggestResponse
-}
-var file_github_com_gritcli_grit_api_api_proto_depIdxs = []int32{
-	2,  // 0: grit.v2.api.LocalRepo.remote_repo:type_name -> grit.v2.api.RemoteRepo
-	1,  // 1: grit.v2.api.ListSourcesResponse.sources:type_name -> grit.v2.api.Source
-	5,  // 2: grit.v2.api.SignInResponse.output:type_name -> grit.v2.api.ClientOutput
-	4,  // 3: grit.v2.api.ResolveRepoRequest.client_options:type_name -> grit.v2.api.ClientOptions
-	0,  // 4: grit.v2.api.ResolveRepoRequest.locality_filter:type_name -> grit.v2.api.Locality
-	5,  // 5: grit.v2.api.ResolveRepoResponse.output:type_name -> grit.v2.api.ClientOutput
-	3,  // 6: grit.v2.api.ResolveRepoResponse.local_repo:type_name -> grit.v2.api.LocalRepo
-	2,  // 7: grit.v2.api.ResolveRepoResponse.remote_repo:type_name -> grit.v2.api.RemoteRepo
-	4,  // 8: grit.v2.api.CloneRepoRequest.client_options:type_name -> grit.v2.api.ClientOptions
-	5,  // 9: grit.v2.api.CloneRepoResponse.output:type_name -> grit.v2.api.ClientOutput
-	3,  // 10: grit.v2.api.CloneRepoResponse.local_repo:type_name -> grit.v2.api.LocalRepo
-	0,  // 11: grit.v2.api.SuggestReposRequest.locality_filter:type_name -> grit.v2.api.Locality
-	6,  // 12: grit.v2.api.API.DaemonInfo:input_type -> grit.v2.api.DaemonInfoRequest
-	8,  // 13: grit.v2.api.API.ListSources:input_type -> grit.v2.api.ListSourcesRequest
-	10, // 14: grit.v2.api.API.SignIn:input_type -> grit.v2.api.SignInRequest
-	12, // 15: grit.v2.api.API.SignOut:input_type -> grit.v2.api.SignOutRequest
-	14, // 16: grit.v2.api.API.ResolveRepo:input_type -> grit.v2.api.ResolveRepoRequest
-	16, // 17: grit.v2.api.API.CloneRepo:input_type -> grit.v2.api.CloneRepoRequest
-	18, // 18: grit.v2.api.API.SuggestRepos:input_type -> grit.v2.api.SuggestReposRequest
-	7,  // 19: grit.v2.api.API.DaemonInfo:output_type -> grit.v2.api.DaemonInfoResponse
-	9,  // 20: grit.v2.api.API.ListSources:output_type -> grit.v2.api.ListSourcesResponse
-	11, // 21: grit.v2.api.API.SignIn:output_type -> grit.v2.api.SignInResponse
-	13, // 22: grit.v2.api.API.SignOut:output_type -> grit.v2.api.SignOutResponse
-	15, // 23: grit.v2.api.API.ResolveRepo:output_type -> grit.v2.api.ResolveRepoResponse
-	17, // 24: grit.v2.api.API.CloneRepo:output_type -> grit.v2.api.CloneRepoResponse
-	19, // 25: grit.v2.api.API.SuggestRepos:output_type -> grit.v2.api.SuggestResponse
-	19, // [19:26] is the sub-list for method output_type
-	12, // [12:19] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
-}
-
-func init() { file_github_com_gritcli_grit_api_api_proto_init() }
-func file_github_com_gritcli_grit_api_api_proto_init() {
-	if File_github_com_gritcli_grit_api_api_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Source); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkRepoRequest.ProtoReflect.Descriptor instead.
+func (*ForkRepoRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ForkRepoRequest) GetClientOptions() *ClientOptions {
+	if x != nil {
+		return x.ClientOptions
+	}
+	return nil
+}
+
+func (x *ForkRepoRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ForkRepoRequest) GetRepoId() string {
+	if x != nil {
+		return x.RepoId
+	}
+	return ""
+}
+
+func (x *ForkRepoRequest) GetCloneOptions() *CloneOptions {
+	if x != nil {
+		return x.CloneOptions
+	}
+	return nil
+}
+
+type CreateRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientOptions *ClientOptions `protobuf:"bytes,1,opt,name=client_options,json=clientOptions,proto3" json:"client_options,omitempty"`
+	Source        string         `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Name          string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Private       bool           `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
+	Template      string         `protobuf:"bytes,5,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *CreateRepoRequest) Reset() {
+	*x = CreateRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRepoRequest) ProtoMessage() {}
+
+func (x *CreateRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRepoRequest.ProtoReflect.Descriptor instead.
+func (*CreateRepoRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateRepoRequest) GetClientOptions() *ClientOptions {
+	if x != nil {
+		return x.ClientOptions
+	}
+	return nil
+}
+
+func (x *CreateRepoRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CreateRepoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRepoRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *CreateRepoRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+type CheckoutPullRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientOptions *ClientOptions `protobuf:"bytes,1,opt,name=client_options,json=clientOptions,proto3" json:"client_options,omitempty"`
+	Query         string         `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Source        string         `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *CheckoutPullRequestRequest) Reset() {
+	*x = CheckoutPullRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckoutPullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutPullRequestRequest) ProtoMessage() {}
+
+func (x *CheckoutPullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutPullRequestRequest.ProtoReflect.Descriptor instead.
+func (*CheckoutPullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CheckoutPullRequestRequest) GetClientOptions() *ClientOptions {
+	if x != nil {
+		return x.ClientOptions
+	}
+	return nil
+}
+
+func (x *CheckoutPullRequestRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *CheckoutPullRequestRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type SuggestReposRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Word           string     `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	LocalityFilter []Locality `protobuf:"varint,2,rep,packed,name=locality_filter,json=localityFilter,proto3,enum=grit.v2.api.Locality" json:"locality_filter,omitempty"`
+}
+
+func (x *SuggestReposRequest) Reset() {
+	*x = SuggestReposRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestReposRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestReposRequest) ProtoMessage() {}
+
+func (x *SuggestReposRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestReposRequest.ProtoReflect.Descriptor instead.
+func (*SuggestReposRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SuggestReposRequest) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *SuggestReposRequest) GetLocalityFilter() []Locality {
+	if x != nil {
+		return x.LocalityFilter
+	}
+	return nil
+}
+
+type SuggestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Words []string `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+}
+
+func (x *SuggestResponse) Reset() {
+	*x = SuggestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestResponse) ProtoMessage() {}
+
+func (x *SuggestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestResponse.ProtoReflect.Descriptor instead.
+func (*SuggestResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SuggestResponse) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+type FetchReposRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientOptions *ClientOptions `protobuf:"bytes,1,opt,name=client_options,json=clientOptions,proto3" json:"client_options,omitempty"`
+	SourceFilter  []string       `protobuf:"bytes,2,rep,name=source_filter,json=sourceFilter,proto3" json:"source_filter,omitempty"`
+	NamePattern   string         `protobuf:"bytes,3,opt,name=name_pattern,json=namePattern,proto3" json:"name_pattern,omitempty"`
+	Concurrency   uint32         `protobuf:"varint,4,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+}
+
+func (x *FetchReposRequest) Reset() {
+	*x = FetchReposRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchReposRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchReposRequest) ProtoMessage() {}
+
+func (x *FetchReposRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchReposRequest.ProtoReflect.Descriptor instead.
+func (*FetchReposRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FetchReposRequest) GetClientOptions() *ClientOptions {
+	if x != nil {
+		return x.ClientOptions
+	}
+	return nil
+}
+
+func (x *FetchReposRequest) GetSourceFilter() []string {
+	if x != nil {
+		return x.SourceFilter
+	}
+	return nil
+}
+
+func (x *FetchReposRequest) GetNamePattern() string {
+	if x != nil {
+		return x.NamePattern
+	}
+	return ""
+}
+
+func (x *FetchReposRequest) GetConcurrency() uint32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+type FetchReposResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*FetchReposResponse_Output
+	//	*FetchReposResponse_Result
+	Response isFetchReposResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *FetchReposResponse) Reset() {
+	*x = FetchReposResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchReposResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchReposResponse) ProtoMessage() {}
+
+func (x *FetchReposResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchReposResponse.ProtoReflect.Descriptor instead.
+func (*FetchReposResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (m *FetchReposResponse) GetResponse() isFetchReposResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *FetchReposResponse) GetOutput() *ClientOutput {
+	if x, ok := x.GetResponse().(*FetchReposResponse_Output); ok {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *FetchReposResponse) GetResult() *FetchRepoResult {
+	if x, ok := x.GetResponse().(*FetchReposResponse_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+type isFetchReposResponse_Response interface {
+	isFetchReposResponse_Response()
+}
+
+type FetchReposResponse_Output struct {
+	Output *ClientOutput `protobuf:"bytes,1,opt,name=output,proto3,oneof"`
+}
+
+type FetchReposResponse_Result struct {
+	Result *FetchRepoResult `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
+}
+
+func (*FetchReposResponse_Output) isFetchReposResponse_Response() {}
+
+func (*FetchReposResponse_Result) isFetchReposResponse_Response() {}
+
+type FetchRepoResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocalRepo *LocalRepo `protobuf:"bytes,1,opt,name=local_repo,json=localRepo,proto3" json:"local_repo,omitempty"`
+	Error     string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *FetchRepoResult) Reset() {
+	*x = FetchRepoResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchRepoResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchRepoResult) ProtoMessage() {}
+
+func (x *FetchRepoResult) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchRepoResult.ProtoReflect.Descriptor instead.
+func (*FetchRepoResult) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *FetchRepoResult) GetLocalRepo() *LocalRepo {
+	if x != nil {
+		return x.LocalRepo
+	}
+	return nil
+}
+
+func (x *FetchRepoResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PullReposRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientOptions *ClientOptions `protobuf:"bytes,1,opt,name=client_options,json=clientOptions,proto3" json:"client_options,omitempty"`
+	SourceFilter  []string       `protobuf:"bytes,2,rep,name=source_filter,json=sourceFilter,proto3" json:"source_filter,omitempty"`
+	NamePattern   string         `protobuf:"bytes,3,opt,name=name_pattern,json=namePattern,proto3" json:"name_pattern,omitempty"`
+	Concurrency   uint32         `protobuf:"varint,4,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+}
+
+func (x *PullReposRequest) Reset() {
+	*x = PullReposRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullReposRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullReposRequest) ProtoMessage() {}
+
+func (x *PullReposRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullReposRequest.ProtoReflect.Descriptor instead.
+func (*PullReposRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PullReposRequest) GetClientOptions() *ClientOptions {
+	if x != nil {
+		return x.ClientOptions
+	}
+	return nil
+}
+
+func (x *PullReposRequest) GetSourceFilter() []string {
+	if x != nil {
+		return x.SourceFilter
+	}
+	return nil
+}
+
+func (x *PullReposRequest) GetNamePattern() string {
+	if x != nil {
+		return x.NamePattern
+	}
+	return ""
+}
+
+func (x *PullReposRequest) GetConcurrency() uint32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+type PullReposResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*PullReposResponse_Output
+	//	*PullReposResponse_Result
+	Response isPullReposResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *PullReposResponse) Reset() {
+	*x = PullReposResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullReposResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullReposResponse) ProtoMessage() {}
+
+func (x *PullReposResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullReposResponse.ProtoReflect.Descriptor instead.
+func (*PullReposResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (m *PullReposResponse) GetResponse() isPullReposResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *PullReposResponse) GetOutput() *ClientOutput {
+	if x, ok := x.GetResponse().(*PullReposResponse_Output); ok {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *PullReposResponse) GetResult() *PullRepoResult {
+	if x, ok := x.GetResponse().(*PullReposResponse_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+type isPullReposResponse_Response interface {
+	isPullReposResponse_Response()
+}
+
+type PullReposResponse_Output struct {
+	Output *ClientOutput `protobuf:"bytes,1,opt,name=output,proto3,oneof"`
+}
+
+type PullReposResponse_Result struct {
+	Result *PullRepoResult `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
+}
+
+func (*PullReposResponse_Output) isPullReposResponse_Response() {}
+
+func (*PullReposResponse_Result) isPullReposResponse_Response() {}
+
+type PullRepoResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocalRepo  *LocalRepo `protobuf:"bytes,1,opt,name=local_repo,json=localRepo,proto3" json:"local_repo,omitempty"`
+	Error      string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Updated    bool       `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	SkipReason string     `protobuf:"bytes,4,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
+}
+
+func (x *PullRepoResult) Reset() {
+	*x = PullRepoResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullRepoResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRepoResult) ProtoMessage() {}
+
+func (x *PullRepoResult) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRepoResult.ProtoReflect.Descriptor instead.
+func (*PullRepoResult) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PullRepoResult) GetLocalRepo() *LocalRepo {
+	if x != nil {
+		return x.LocalRepo
+	}
+	return nil
+}
+
+func (x *PullRepoResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PullRepoResult) GetUpdated() bool {
+	if x != nil {
+		return x.Updated
+	}
+	return false
+}
+
+func (x *PullRepoResult) GetSkipReason() string {
+	if x != nil {
+		return x.SkipReason
+	}
+	return ""
+}
+
+type RemoveRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientOptions    *ClientOptions `protobuf:"bytes,1,opt,name=client_options,json=clientOptions,proto3" json:"client_options,omitempty"`
+	AbsoluteCloneDir string         `protobuf:"bytes,2,opt,name=absolute_clone_dir,json=absoluteCloneDir,proto3" json:"absolute_clone_dir,omitempty"`
+	Force            bool           `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *RemoveRepoRequest) Reset() {
+	*x = RemoveRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRepoRequest) ProtoMessage() {}
+
+func (x *RemoveRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRepoRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRepoRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RemoveRepoRequest) GetClientOptions() *ClientOptions {
+	if x != nil {
+		return x.ClientOptions
+	}
+	return nil
+}
+
+func (x *RemoveRepoRequest) GetAbsoluteCloneDir() string {
+	if x != nil {
+		return x.AbsoluteCloneDir
+	}
+	return ""
+}
+
+func (x *RemoveRepoRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type RemoveRepoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*RemoveRepoResponse_Output
+	//	*RemoveRepoResponse_LocalRepo
+	Response isRemoveRepoResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *RemoveRepoResponse) Reset() {
+	*x = RemoveRepoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveRepoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRepoResponse) ProtoMessage() {}
+
+func (x *RemoveRepoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRepoResponse.ProtoReflect.Descriptor instead.
+func (*RemoveRepoResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (m *RemoveRepoResponse) GetResponse() isRemoveRepoResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *RemoveRepoResponse) GetOutput() *ClientOutput {
+	if x, ok := x.GetResponse().(*RemoveRepoResponse_Output); ok {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *RemoveRepoResponse) GetLocalRepo() *LocalRepo {
+	if x, ok := x.GetResponse().(*RemoveRepoResponse_LocalRepo); ok {
+		return x.LocalRepo
+	}
+	return nil
+}
+
+type isRemoveRepoResponse_Response interface {
+	isRemoveRepoResponse_Response()
+}
+
+type RemoveRepoResponse_Output struct {
+	Output *ClientOutput `protobuf:"bytes,1,opt,name=output,proto3,oneof"`
+}
+
+type RemoveRepoResponse_LocalRepo struct {
+	LocalRepo *LocalRepo `protobuf:"bytes,2,opt,name=local_repo,json=localRepo,proto3,oneof"`
+}
+
+func (*RemoveRepoResponse_Output) isRemoveRepoResponse_Response() {}
+
+func (*RemoveRepoResponse_LocalRepo) isRemoveRepoResponse_Response() {}
+
+type ArchiveReposRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientOptions *ClientOptions `protobuf:"bytes,1,opt,name=client_options,json=clientOptions,proto3" json:"client_options,omitempty"`
+	SourceFilter  []string       `protobuf:"bytes,2,rep,name=source_filter,json=sourceFilter,proto3" json:"source_filter,omitempty"`
+	NamePattern   string         `protobuf:"bytes,3,opt,name=name_pattern,json=namePattern,proto3" json:"name_pattern,omitempty"`
+	Concurrency   uint32         `protobuf:"varint,4,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	InactiveFor   string         `protobuf:"bytes,5,opt,name=inactive_for,json=inactiveFor,proto3" json:"inactive_for,omitempty"`
+	DryRun        bool           `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *ArchiveReposRequest) Reset() {
+	*x = ArchiveReposRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveReposRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveReposRequest) ProtoMessage() {}
+
+func (x *ArchiveReposRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveReposRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveReposRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ArchiveReposRequest) GetClientOptions() *ClientOptions {
+	if x != nil {
+		return x.ClientOptions
+	}
+	return nil
+}
+
+func (x *ArchiveReposRequest) GetSourceFilter() []string {
+	if x != nil {
+		return x.SourceFilter
+	}
+	return nil
+}
+
+func (x *ArchiveReposRequest) GetNamePattern() string {
+	if x != nil {
+		return x.NamePattern
+	}
+	return ""
+}
+
+func (x *ArchiveReposRequest) GetConcurrency() uint32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *ArchiveReposRequest) GetInactiveFor() string {
+	if x != nil {
+		return x.InactiveFor
+	}
+	return ""
+}
+
+func (x *ArchiveReposRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ArchiveReposResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*ArchiveReposResponse_Output
+	//	*ArchiveReposResponse_Result
+	Response isArchiveReposResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *ArchiveReposResponse) Reset() {
+	*x = ArchiveReposResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveReposResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveReposResponse) ProtoMessage() {}
+
+func (x *ArchiveReposResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveReposResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveReposResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (m *ArchiveReposResponse) GetResponse() isArchiveReposResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ArchiveReposResponse) GetOutput() *ClientOutput {
+	if x, ok := x.GetResponse().(*ArchiveReposResponse_Output); ok {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *ArchiveReposResponse) GetResult() *ArchiveRepoResult {
+	if x, ok := x.GetResponse().(*ArchiveReposResponse_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+type isArchiveReposResponse_Response interface {
+	isArchiveReposResponse_Response()
+}
+
+type ArchiveReposResponse_Output struct {
+	Output *ClientOutput `protobuf:"bytes,1,opt,name=output,proto3,oneof"`
+}
+
+type ArchiveReposResponse_Result struct {
+	Result *ArchiveRepoResult `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
+}
+
+func (*ArchiveReposResponse_Output) isArchiveReposResponse_Response() {}
+
+func (*ArchiveReposResponse_Result) isArchiveReposResponse_Response() {}
+
+type ArchiveRepoResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocalRepo   *LocalRepo `protobuf:"bytes,1,opt,name=local_repo,json=localRepo,proto3" json:"local_repo,omitempty"`
+	Error       string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Eligible    bool       `protobuf:"varint,3,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	ArchiveFile string     `protobuf:"bytes,4,opt,name=archive_file,json=archiveFile,proto3" json:"archive_file,omitempty"`
+	SkipReason  string     `protobuf:"bytes,5,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
+}
+
+func (x *ArchiveRepoResult) Reset() {
+	*x = ArchiveRepoResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveRepoResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveRepoResult) ProtoMessage() {}
+
+func (x *ArchiveRepoResult) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveRepoResult.ProtoReflect.Descriptor instead.
+func (*ArchiveRepoResult) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ArchiveRepoResult) GetLocalRepo() *LocalRepo {
+	if x != nil {
+		return x.LocalRepo
+	}
+	return nil
+}
+
+func (x *ArchiveRepoResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ArchiveRepoResult) GetEligible() bool {
+	if x != nil {
+		return x.Eligible
+	}
+	return false
+}
+
+func (x *ArchiveRepoResult) GetArchiveFile() string {
+	if x != nil {
+		return x.ArchiveFile
+	}
+	return ""
+}
+
+func (x *ArchiveRepoResult) GetSkipReason() string {
+	if x != nil {
+		return x.SkipReason
+	}
+	return ""
+}
+
+type UnarchiveRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientOptions *ClientOptions `protobuf:"bytes,1,opt,name=client_options,json=clientOptions,proto3" json:"client_options,omitempty"`
+	Query         string         `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	SourceFilter  []string       `protobuf:"bytes,3,rep,name=source_filter,json=sourceFilter,proto3" json:"source_filter,omitempty"`
+}
+
+func (x *UnarchiveRepoRequest) Reset() {
+	*x = UnarchiveRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnarchiveRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveRepoRequest) ProtoMessage() {}
+
+func (x *UnarchiveRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveRepoRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveRepoRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UnarchiveRepoRequest) GetClientOptions() *ClientOptions {
+	if x != nil {
+		return x.ClientOptions
+	}
+	return nil
+}
+
+func (x *UnarchiveRepoRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *UnarchiveRepoRequest) GetSourceFilter() []string {
+	if x != nil {
+		return x.SourceFilter
+	}
+	return nil
+}
+
+type UnarchiveRepoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*UnarchiveRepoResponse_Output
+	//	*UnarchiveRepoResponse_LocalRepo
+	Response isUnarchiveRepoResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *UnarchiveRepoResponse) Reset() {
+	*x = UnarchiveRepoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnarchiveRepoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveRepoResponse) ProtoMessage() {}
+
+func (x *UnarchiveRepoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveRepoResponse.ProtoReflect.Descriptor instead.
+func (*UnarchiveRepoResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (m *UnarchiveRepoResponse) GetResponse() isUnarchiveRepoResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *UnarchiveRepoResponse) GetOutput() *ClientOutput {
+	if x, ok := x.GetResponse().(*UnarchiveRepoResponse_Output); ok {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *UnarchiveRepoResponse) GetLocalRepo() *LocalRepo {
+	if x, ok := x.GetResponse().(*UnarchiveRepoResponse_LocalRepo); ok {
+		return x.LocalRepo
+	}
+	return nil
+}
+
+type isUnarchiveRepoResponse_Response interface {
+	isUnarchiveRepoResponse_Response()
+}
+
+type UnarchiveRepoResponse_Output struct {
+	Output *ClientOutput `protobuf:"bytes,1,opt,name=output,proto3,oneof"`
+}
+
+type UnarchiveRepoResponse_LocalRepo struct {
+	LocalRepo *LocalRepo `protobuf:"bytes,2,opt,name=local_repo,json=localRepo,proto3,oneof"`
+}
+
+func (*UnarchiveRepoResponse_Output) isUnarchiveRepoResponse_Response() {}
+
+func (*UnarchiveRepoResponse_LocalRepo) isUnarchiveRepoResponse_Response() {}
+
+type AdoptRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientOptions *ClientOptions `protobuf:"bytes,1,opt,name=client_options,json=clientOptions,proto3" json:"client_options,omitempty"`
+	AbsoluteDir   string         `protobuf:"bytes,2,opt,name=absolute_dir,json=absoluteDir,proto3" json:"absolute_dir,omitempty"`
+	Symlink       bool           `protobuf:"varint,3,opt,name=symlink,proto3" json:"symlink,omitempty"`
+}
+
+func (x *AdoptRepoRequest) Reset() {
+	*x = AdoptRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdoptRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdoptRepoRequest) ProtoMessage() {}
+
+func (x *AdoptRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdoptRepoRequest.ProtoReflect.Descriptor instead.
+func (*AdoptRepoRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AdoptRepoRequest) GetClientOptions() *ClientOptions {
+	if x != nil {
+		return x.ClientOptions
+	}
+	return nil
+}
+
+func (x *AdoptRepoRequest) GetAbsoluteDir() string {
+	if x != nil {
+		return x.AbsoluteDir
+	}
+	return ""
+}
+
+func (x *AdoptRepoRequest) GetSymlink() bool {
+	if x != nil {
+		return x.Symlink
+	}
+	return false
+}
+
+type AdoptRepoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*AdoptRepoResponse_Output
+	//	*AdoptRepoResponse_LocalRepo
+	Response isAdoptRepoResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *AdoptRepoResponse) Reset() {
+	*x = AdoptRepoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdoptRepoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdoptRepoResponse) ProtoMessage() {}
+
+func (x *AdoptRepoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdoptRepoResponse.ProtoReflect.Descriptor instead.
+func (*AdoptRepoResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (m *AdoptRepoResponse) GetResponse() isAdoptRepoResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *AdoptRepoResponse) GetOutput() *ClientOutput {
+	if x, ok := x.GetResponse().(*AdoptRepoResponse_Output); ok {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *AdoptRepoResponse) GetLocalRepo() *LocalRepo {
+	if x, ok := x.GetResponse().(*AdoptRepoResponse_LocalRepo); ok {
+		return x.LocalRepo
+	}
+	return nil
+}
+
+type isAdoptRepoResponse_Response interface {
+	isAdoptRepoResponse_Response()
+}
+
+type AdoptRepoResponse_Output struct {
+	Output *ClientOutput `protobuf:"bytes,1,opt,name=output,proto3,oneof"`
+}
+
+type AdoptRepoResponse_LocalRepo struct {
+	LocalRepo *LocalRepo `protobuf:"bytes,2,opt,name=local_repo,json=localRepo,proto3,oneof"`
+}
+
+func (*AdoptRepoResponse_Output) isAdoptRepoResponse_Response() {}
+
+func (*AdoptRepoResponse_LocalRepo) isAdoptRepoResponse_Response() {}
+
+type RelocateReposRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientOptions *ClientOptions `protobuf:"bytes,1,opt,name=client_options,json=clientOptions,proto3" json:"client_options,omitempty"`
+	DryRun        bool           `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *RelocateReposRequest) Reset() {
+	*x = RelocateReposRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelocateReposRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelocateReposRequest) ProtoMessage() {}
+
+func (x *RelocateReposRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelocateReposRequest.ProtoReflect.Descriptor instead.
+func (*RelocateReposRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RelocateReposRequest) GetClientOptions() *ClientOptions {
+	if x != nil {
+		return x.ClientOptions
+	}
+	return nil
+}
+
+func (x *RelocateReposRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type RelocateReposResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*RelocateReposResponse_Output
+	//	*RelocateReposResponse_Result
+	Response isRelocateReposResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *RelocateReposResponse) Reset() {
+	*x = RelocateReposResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelocateReposResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelocateReposResponse) ProtoMessage() {}
+
+func (x *RelocateReposResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelocateReposResponse.ProtoReflect.Descriptor instead.
+func (*RelocateReposResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (m *RelocateReposResponse) GetResponse() isRelocateReposResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *RelocateReposResponse) GetOutput() *ClientOutput {
+	if x, ok := x.GetResponse().(*RelocateReposResponse_Output); ok {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *RelocateReposResponse) GetResult() *RelocateRepoResult {
+	if x, ok := x.GetResponse().(*RelocateReposResponse_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+type isRelocateReposResponse_Response interface {
+	isRelocateReposResponse_Response()
+}
+
+type RelocateReposResponse_Output struct {
+	Output *ClientOutput `protobuf:"bytes,1,opt,name=output,proto3,oneof"`
+}
+
+type RelocateReposResponse_Result struct {
+	Result *RelocateRepoResult `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
+}
+
+func (*RelocateReposResponse_Output) isRelocateReposResponse_Response() {}
+
+func (*RelocateReposResponse_Result) isRelocateReposResponse_Response() {}
+
+type RelocateRepoResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocalRepo        *LocalRepo `protobuf:"bytes,1,opt,name=local_repo,json=localRepo,proto3" json:"local_repo,omitempty"`
+	PreviousCloneDir string     `protobuf:"bytes,2,opt,name=previous_clone_dir,json=previousCloneDir,proto3" json:"previous_clone_dir,omitempty"`
+	Error            string     `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Conflict         bool       `protobuf:"varint,4,opt,name=conflict,proto3" json:"conflict,omitempty"`
+}
+
+func (x *RelocateRepoResult) Reset() {
+	*x = RelocateRepoResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelocateRepoResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelocateRepoResult) ProtoMessage() {}
+
+func (x *RelocateRepoResult) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelocateRepoResult.ProtoReflect.Descriptor instead.
+func (*RelocateRepoResult) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RelocateRepoResult) GetLocalRepo() *LocalRepo {
+	if x != nil {
+		return x.LocalRepo
+	}
+	return nil
+}
+
+func (x *RelocateRepoResult) GetPreviousCloneDir() string {
+	if x != nil {
+		return x.PreviousCloneDir
+	}
+	return ""
+}
+
+func (x *RelocateRepoResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RelocateRepoResult) GetConflict() bool {
+	if x != nil {
+		return x.Conflict
+	}
+	return false
+}
+
+type ListLocalReposRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceFilter  []string `protobuf:"bytes,1,rep,name=source_filter,json=sourceFilter,proto3" json:"source_filter,omitempty"`
+	NamePattern   string   `protobuf:"bytes,2,opt,name=name_pattern,json=namePattern,proto3" json:"name_pattern,omitempty"`
+	IncludeStatus bool     `protobuf:"varint,3,opt,name=include_status,json=includeStatus,proto3" json:"include_status,omitempty"`
+}
+
+func (x *ListLocalReposRequest) Reset() {
+	*x = ListLocalReposRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLocalReposRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocalReposRequest) ProtoMessage() {}
+
+func (x *ListLocalReposRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocalReposRequest.ProtoReflect.Descriptor instead.
+func (*ListLocalReposRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListLocalReposRequest) GetSourceFilter() []string {
+	if x != nil {
+		return x.SourceFilter
+	}
+	return nil
+}
+
+func (x *ListLocalReposRequest) GetNamePattern() string {
+	if x != nil {
+		return x.NamePattern
+	}
+	return ""
+}
+
+func (x *ListLocalReposRequest) GetIncludeStatus() bool {
+	if x != nil {
+		return x.IncludeStatus
+	}
+	return false
+}
+
+type ListLocalReposResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocalRepos []*LocalRepoListing `protobuf:"bytes,1,rep,name=local_repos,json=localRepos,proto3" json:"local_repos,omitempty"`
+}
+
+func (x *ListLocalReposResponse) Reset() {
+	*x = ListLocalReposResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLocalReposResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocalReposResponse) ProtoMessage() {}
+
+func (x *ListLocalReposResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocalReposResponse.ProtoReflect.Descriptor instead.
+func (*ListLocalReposResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListLocalReposResponse) GetLocalRepos() []*LocalRepoListing {
+	if x != nil {
+		return x.LocalRepos
+	}
+	return nil
+}
+
+type LocalRepoListing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocalRepo   *LocalRepo       `protobuf:"bytes,1,opt,name=local_repo,json=localRepo,proto3" json:"local_repo,omitempty"`
+	Status      *LocalRepoStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	StatusError string           `protobuf:"bytes,3,opt,name=status_error,json=statusError,proto3" json:"status_error,omitempty"`
+}
+
+func (x *LocalRepoListing) Reset() {
+	*x = LocalRepoListing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalRepoListing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalRepoListing) ProtoMessage() {}
+
+func (x *LocalRepoListing) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalRepoListing.ProtoReflect.Descriptor instead.
+func (*LocalRepoListing) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *LocalRepoListing) GetLocalRepo() *LocalRepo {
+	if x != nil {
+		return x.LocalRepo
+	}
+	return nil
+}
+
+func (x *LocalRepoListing) GetStatus() *LocalRepoStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *LocalRepoListing) GetStatusError() string {
+	if x != nil {
+		return x.StatusError
+	}
+	return ""
+}
+
+type DescribeRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	RepoId string `protobuf:"bytes,2,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
+}
+
+func (x *DescribeRepoRequest) Reset() {
+	*x = DescribeRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeRepoRequest) ProtoMessage() {}
+
+func (x *DescribeRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeRepoRequest.ProtoReflect.Descriptor instead.
+func (*DescribeRepoRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DescribeRepoRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DescribeRepoRequest) GetRepoId() string {
+	if x != nil {
+		return x.RepoId
+	}
+	return ""
+}
+
+type DescribeRepoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RemoteRepo  *RemoteRepo         `protobuf:"bytes,1,opt,name=remote_repo,json=remoteRepo,proto3" json:"remote_repo,omitempty"`
+	RemoteError string              `protobuf:"bytes,2,opt,name=remote_error,json=remoteError,proto3" json:"remote_error,omitempty"`
+	CloneUrls   []*CloneURL         `protobuf:"bytes,3,rep,name=clone_urls,json=cloneUrls,proto3" json:"clone_urls,omitempty"`
+	LocalRepos  []*LocalRepoListing `protobuf:"bytes,4,rep,name=local_repos,json=localRepos,proto3" json:"local_repos,omitempty"`
+}
+
+func (x *DescribeRepoResponse) Reset() {
+	*x = DescribeRepoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeRepoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeRepoResponse) ProtoMessage() {}
+
+func (x *DescribeRepoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeRepoResponse.ProtoReflect.Descriptor instead.
+func (*DescribeRepoResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DescribeRepoResponse) GetRemoteRepo() *RemoteRepo {
+	if x != nil {
+		return x.RemoteRepo
+	}
+	return nil
+}
+
+func (x *DescribeRepoResponse) GetRemoteError() string {
+	if x != nil {
+		return x.RemoteError
+	}
+	return ""
+}
+
+func (x *DescribeRepoResponse) GetCloneUrls() []*CloneURL {
+	if x != nil {
+		return x.CloneUrls
+	}
+	return nil
+}
+
+func (x *DescribeRepoResponse) GetLocalRepos() []*LocalRepoListing {
+	if x != nil {
+		return x.LocalRepos
+	}
+	return nil
+}
+
+type Worktree struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocalRepo             *LocalRepo `protobuf:"bytes,1,opt,name=local_repo,json=localRepo,proto3" json:"local_repo,omitempty"`
+	AbsoluteDir           string     `protobuf:"bytes,2,opt,name=absolute_dir,json=absoluteDir,proto3" json:"absolute_dir,omitempty"`
+	Branch                string     `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	HasUncommittedChanges bool       `protobuf:"varint,4,opt,name=has_uncommitted_changes,json=hasUncommittedChanges,proto3" json:"has_uncommitted_changes,omitempty"`
+}
+
+func (x *Worktree) Reset() {
+	*x = Worktree{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Worktree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Worktree) ProtoMessage() {}
+
+func (x *Worktree) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Worktree.ProtoReflect.Descriptor instead.
+func (*Worktree) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Worktree) GetLocalRepo() *LocalRepo {
+	if x != nil {
+		return x.LocalRepo
+	}
+	return nil
+}
+
+func (x *Worktree) GetAbsoluteDir() string {
+	if x != nil {
+		return x.AbsoluteDir
+	}
+	return ""
+}
+
+func (x *Worktree) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *Worktree) GetHasUncommittedChanges() bool {
+	if x != nil {
+		return x.HasUncommittedChanges
+	}
+	return false
+}
+
+type AddWorktreeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientOptions    *ClientOptions `protobuf:"bytes,1,opt,name=client_options,json=clientOptions,proto3" json:"client_options,omitempty"`
+	AbsoluteCloneDir string         `protobuf:"bytes,2,opt,name=absolute_clone_dir,json=absoluteCloneDir,proto3" json:"absolute_clone_dir,omitempty"`
+	Branch           string         `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+}
+
+func (x *AddWorktreeRequest) Reset() {
+	*x = AddWorktreeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddWorktreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWorktreeRequest) ProtoMessage() {}
+
+func (x *AddWorktreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWorktreeRequest.ProtoReflect.Descriptor instead.
+func (*AddWorktreeRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AddWorktreeRequest) GetClientOptions() *ClientOptions {
+	if x != nil {
+		return x.ClientOptions
+	}
+	return nil
+}
+
+func (x *AddWorktreeRequest) GetAbsoluteCloneDir() string {
+	if x != nil {
+		return x.AbsoluteCloneDir
+	}
+	return ""
+}
+
+func (x *AddWorktreeRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+type AddWorktreeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*AddWorktreeResponse_Output
+	//	*AddWorktreeResponse_Worktree
+	Response isAddWorktreeResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *AddWorktreeResponse) Reset() {
+	*x = AddWorktreeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddWorktreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWorktreeResponse) ProtoMessage() {}
+
+func (x *AddWorktreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWorktreeResponse.ProtoReflect.Descriptor instead.
+func (*AddWorktreeResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (m *AddWorktreeResponse) GetResponse() isAddWorktreeResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *AddWorktreeResponse) GetOutput() *ClientOutput {
+	if x, ok := x.GetResponse().(*AddWorktreeResponse_Output); ok {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *AddWorktreeResponse) GetWorktree() *Worktree {
+	if x, ok := x.GetResponse().(*AddWorktreeResponse_Worktree); ok {
+		return x.Worktree
+	}
+	return nil
+}
+
+type isAddWorktreeResponse_Response interface {
+	isAddWorktreeResponse_Response()
+}
+
+type AddWorktreeResponse_Output struct {
+	Output *ClientOutput `protobuf:"bytes,1,opt,name=output,proto3,oneof"`
+}
+
+type AddWorktreeResponse_Worktree struct {
+	Worktree *Worktree `protobuf:"bytes,2,opt,name=worktree,proto3,oneof"`
+}
+
+func (*AddWorktreeResponse_Output) isAddWorktreeResponse_Response() {}
+
+func (*AddWorktreeResponse_Worktree) isAddWorktreeResponse_Response() {}
+
+type ListWorktreesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AbsoluteCloneDir string `protobuf:"bytes,1,opt,name=absolute_clone_dir,json=absoluteCloneDir,proto3" json:"absolute_clone_dir,omitempty"`
+}
+
+func (x *ListWorktreesRequest) Reset() {
+	*x = ListWorktreesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorktreesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorktreesRequest) ProtoMessage() {}
+
+func (x *ListWorktreesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorktreesRequest.ProtoReflect.Descriptor instead.
+func (*ListWorktreesRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListWorktreesRequest) GetAbsoluteCloneDir() string {
+	if x != nil {
+		return x.AbsoluteCloneDir
+	}
+	return ""
+}
+
+type ListWorktreesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Worktrees []*Worktree `protobuf:"bytes,1,rep,name=worktrees,proto3" json:"worktrees,omitempty"`
+}
+
+func (x *ListWorktreesResponse) Reset() {
+	*x = ListWorktreesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorktreesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorktreesResponse) ProtoMessage() {}
+
+func (x *ListWorktreesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorktreesResponse.ProtoReflect.Descriptor instead.
+func (*ListWorktreesResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListWorktreesResponse) GetWorktrees() []*Worktree {
+	if x != nil {
+		return x.Worktrees
+	}
+	return nil
+}
+
+type RemoveWorktreeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientOptions    *ClientOptions `protobuf:"bytes,1,opt,name=client_options,json=clientOptions,proto3" json:"client_options,omitempty"`
+	AbsoluteCloneDir string         `protobuf:"bytes,2,opt,name=absolute_clone_dir,json=absoluteCloneDir,proto3" json:"absolute_clone_dir,omitempty"`
+	Branch           string         `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	Force            bool           `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *RemoveWorktreeRequest) Reset() {
+	*x = RemoveWorktreeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveWorktreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWorktreeRequest) ProtoMessage() {}
+
+func (x *RemoveWorktreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWorktreeRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWorktreeRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RemoveWorktreeRequest) GetClientOptions() *ClientOptions {
+	if x != nil {
+		return x.ClientOptions
+	}
+	return nil
+}
+
+func (x *RemoveWorktreeRequest) GetAbsoluteCloneDir() string {
+	if x != nil {
+		return x.AbsoluteCloneDir
+	}
+	return ""
+}
+
+func (x *RemoveWorktreeRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *RemoveWorktreeRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type RemoveWorktreeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*RemoveWorktreeResponse_Output
+	//	*RemoveWorktreeResponse_Worktree
+	Response isRemoveWorktreeResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *RemoveWorktreeResponse) Reset() {
+	*x = RemoveWorktreeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveWorktreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWorktreeResponse) ProtoMessage() {}
+
+func (x *RemoveWorktreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_gritcli_grit_api_api_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWorktreeResponse.ProtoReflect.Descriptor instead.
+func (*RemoveWorktreeResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_gritcli_grit_api_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (m *RemoveWorktreeResponse) GetResponse() isRemoveWorktreeResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *RemoveWorktreeResponse) GetOutput() *ClientOutput {
+	if x, ok := x.GetResponse().(*RemoveWorktreeResponse_Output); ok {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *RemoveWorktreeResponse) GetWorktree() *Worktree {
+	if x, ok := x.GetResponse().(*RemoveWorktreeResponse_Worktree); ok {
+		return x.Worktree
+	}
+	return nil
+}
+
+type isRemoveWorktreeResponse_Response interface {
+	isRemoveWorktreeResponse_Response()
+}
+
+type RemoveWorktreeResponse_Output struct {
+	Output *ClientOutput `protobuf:"bytes,1,opt,name=output,proto3,oneof"`
+}
+
+type RemoveWorktreeResponse_Worktree struct {
+	Worktree *Worktree `protobuf:"bytes,2,opt,name=worktree,proto3,oneof"`
+}
+
+func (*RemoveWorktreeResponse_Output) isRemoveWorktreeResponse_Response() {}
+
+func (*RemoveWorktreeResponse_Worktree) isRemoveWorktreeResponse_Response() {}
+
+var File_github_com_gritcli_grit_api_api_proto protoreflect.FileDescriptor
+
+var file_github_com_gritcli_grit_api_api_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x69,
+	0x74, 0x63, 0x6c, 0x69, 0x2f, 0x67, 0x72, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x22, 0x7c, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x0e,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x44,
+	0x69, 0x72, 0x22, 0xce, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x77, 0x65, 0x62, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x73, 0x55, 0x72, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x75, 0x6c, 0x6c, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x70, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x55, 0x72, 0x6c, 0x22, 0x73, 0x0a, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f,
+	0x12, 0x38, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x0a,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x62,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x5f, 0x64, 0x69, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65,
+	0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x44, 0x69, 0x72, 0x22, 0x38, 0x0a, 0x08, 0x43, 0x6c, 0x6f, 0x6e,
+	0x65, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x22, 0xc3, 0x01, 0x0a, 0x0f, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x65,
+	0x61, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x68, 0x61, 0x73, 0x5f, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x15, 0x68, 0x61, 0x73, 0x55, 0x6e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x6e,
+	0x70, 0x75, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x75, 0x6e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64, 0x42,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x5f, 0x73,
+	0x74, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61,
+	0x73, 0x53, 0x74, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x29, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x62, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62,
+	0x6f, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x13, 0x0a,
+	0x11, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x40, 0x0a, 0x12, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x03, 0x70, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x44, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x22, 0x27, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x51, 0x0a, 0x0e, 0x53, 0x69, 0x67,
+	0x6e, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72,
+	0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x0e,
+	0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x12, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3e,
+	0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0e,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xcb,
+	0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x52, 0x65, 0x70, 0x6f, 0x12, 0x3a, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x72, 0x69, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc6, 0x01, 0x0a,
+	0x10, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72, 0x69, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x65, 0x70, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x70, 0x6f, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x5f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6f, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x88, 0x01, 0x01,
+	0x12, 0x28, 0x0a, 0x0d, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0xc7, 0x01, 0x0a, 0x11, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x37, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x48, 0x00, 0x52,
+	0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xec, 0x01, 0x0a, 0x11, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x69,
+	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x3e, 0x0a, 0x0d, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x0c, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x3e, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x49, 0x64, 0x22,
+	0x8d, 0x01, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x72,
+	0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xb7, 0x01, 0x0a, 0x0f, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x69, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70,
+	0x6f, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x25, 0x0a, 0x0e,
+	0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x43, 0x6c, 0x6f,
+	0x6e, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6b, 0x0a, 0x0d, 0x43, 0x6c, 0x6f,
+	0x6e, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68,
+	0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x0f, 0x46, 0x6f, 0x72, 0x6b, 0x52,
+	0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x49, 0x64, 0x12, 0x3e,
+	0x0a, 0x0d, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x0c, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb8,
+	0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x1a, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x69, 0x0a, 0x13, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x3e, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x22, 0x27, 0x0a, 0x0f, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xc0, 0x01,
+	0x0a, 0x11, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72,
+	0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
+	0x61, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x22, 0x8d, 0x01, 0x0a, 0x12, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x36, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x5e, 0x0a, 0x0f, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x52,
+	0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0xbf, 0x01, 0x0a, 0x10, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a,
+	0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x22, 0x8b, 0x01, 0x0a, 0x11, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x35, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x75, 0x6c, 0x6c,
+	0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x98, 0x01, 0x0a, 0x0e, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x52,
+	0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b,
+	0x69, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x6b, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x11,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72, 0x69, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65,
+	0x5f, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x44,
+	0x69, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x33, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65,
+	0x70, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f,
+	0x48, 0x00, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x42, 0x0a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xfe, 0x01, 0x0a, 0x13, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72, 0x69, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x21,
+	0x0a, 0x0c, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x6f,
+	0x72, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x14, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00,
+	0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc0,
+	0x01, 0x0a, 0x11, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65,
+	0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f,
+	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x94, 0x01, 0x0a, 0x14, 0x55, 0x6e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x91, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72,
+	0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52,
+	0x65, 0x70, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92, 0x01, 0x0a,
+	0x10, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72, 0x69, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65,
+	0x5f, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x65, 0x44, 0x69, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x6c, 0x69,
+	0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x6c, 0x69, 0x6e,
+	0x6b, 0x22, 0x8d, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x0a,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x52, 0x65, 0x70, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x72, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x93, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x33, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x12,
+	0x52, 0x65, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x09,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43,
+	0x6c, 0x6f, 0x6e, 0x65, 0x44, 0x69, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65,
+	0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x58, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x22, 0xa2, 0x01, 0x0a,
+	0x10, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x35, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x09, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x46, 0x0a, 0x13, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x49, 0x64, 0x22, 0xe9, 0x01, 0x0a, 0x14, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x70,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x34, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x6e,
+	0x65, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x72, 0x69,
+	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65,
+	0x70, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x52, 0x65, 0x70, 0x6f, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x74, 0x72,
+	0x65, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x09,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x62, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x44, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x12, 0x36, 0x0a, 0x17, 0x68, 0x61, 0x73, 0x5f, 0x75, 0x6e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x68, 0x61, 0x73, 0x55, 0x6e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x9d, 0x01, 0x0a,
+	0x12, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72,
+	0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x6e,
+	0x65, 0x44, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0x8b, 0x01, 0x0a,
+	0x13, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48,
+	0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x77, 0x6f, 0x72,
+	0x6b, 0x74, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x72,
+	0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x74, 0x72,
+	0x65, 0x65, 0x48, 0x00, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x63,
+	0x6c, 0x6f, 0x6e, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x44, 0x69, 0x72,
+	0x22, 0x4c, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x77, 0x6f, 0x72,
+	0x6b, 0x74, 0x72, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67,
+	0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x74,
+	0x72, 0x65, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65, 0x73, 0x22, 0xb6,
+	0x01, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x61,
+	0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x5f, 0x64, 0x69,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x65, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x44, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x74,
+	0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x72, 0x69, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65,
+	0x48, 0x00, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x37, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f,
+	0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x49, 0x54, 0x59, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f,
+	0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10,
+	0x02, 0x32, 0xf4, 0x0e, 0x0a, 0x03, 0x41, 0x50, 0x49, 0x12, 0x4d, 0x0a, 0x0a, 0x44, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x53, 0x69,
+	0x67, 0x6e, 0x49, 0x6e, 0x12, 0x1a, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12,
+	0x44, 0x0a, 0x07, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x1b, 0x2e, 0x67, 0x72, 0x69,
+	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x12, 0x1f, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4c, 0x0a, 0x09, 0x43, 0x6c, 0x6f,
+	0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x1d, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x08, 0x46, 0x6f, 0x72, 0x6b,
+	0x52, 0x65, 0x70, 0x6f, 0x12, 0x1c, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x30, 0x01, 0x12, 0x4e, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x12, 0x1e, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x30, 0x01, 0x12, 0x60, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x2e, 0x67, 0x72,
+	0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4e, 0x0a, 0x0c, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x20, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4c, 0x0a, 0x09, 0x50, 0x75, 0x6c, 0x6c, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x12, 0x1e, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x55, 0x0a, 0x0c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x20, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x58, 0x0a,
+	0x0d, 0x55, 0x6e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x21,
+	0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x6e, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x55, 0x6e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4c, 0x0a, 0x09, 0x41, 0x64, 0x6f, 0x70, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x12, 0x1d, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x58, 0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x21, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x72, 0x69, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12,
+	0x59, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x12, 0x22, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x20, 0x2e, 0x67, 0x72, 0x69,
+	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67,
+	0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65, 0x12, 0x1f,
+	0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64,
+	0x57, 0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x64, 0x57, 0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x30, 0x01, 0x12, 0x56, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x74,
+	0x72, 0x65, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x74, 0x72,
+	0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65, 0x12, 0x22, 0x2e,
+	0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x67, 0x72, 0x69, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x1d, 0x5a, 0x1b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x69, 0x74, 0x63, 0x6c, 0x69, 0x2f, 0x67,
+	0x72, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_gritcli_grit_api_api_proto_rawDescOnce sync.Once
+	file_github_com_gritcli_grit_api_api_proto_rawDescData = file_github_com_gritcli_grit_api_api_proto_rawDesc
+)
+
+func file_github_com_gritcli_grit_api_api_proto_rawDescGZIP() []byte {
+	file_github_com_gritcli_grit_api_api_proto_rawDescOnce.Do(func() {
+		file_github_com_gritcli_grit_api_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_gritcli_grit_api_api_proto_rawDescData)
+	})
+	return file_github_com_gritcli_grit_api_api_proto_rawDescData
+}
+
+var file_github_com_gritcli_grit_api_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_github_com_gritcli_grit_api_api_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
+var file_github_com_gritcli_grit_api_api_proto_goTypes = []interface{}{
+	(Locality)(0),                      // 0: grit.v2.api.Locality
+	(*Source)(nil),                     // 1: grit.v2.api.Source
+	(*RemoteRepo)(nil),                 // 2: grit.v2.api.RemoteRepo
+	(*LocalRepo)(nil),                  // 3: grit.v2.api.LocalRepo
+	(*CloneURL)(nil),                   // 4: grit.v2.api.CloneURL
+	(*LocalRepoStatus)(nil),            // 5: grit.v2.api.LocalRepoStatus
+	(*ClientOptions)(nil),              // 6: grit.v2.api.ClientOptions
+	(*ClientOutput)(nil),               // 7: grit.v2.api.ClientOutput
+	(*DaemonInfoRequest)(nil),          // 8: grit.v2.api.DaemonInfoRequest
+	(*DaemonInfoResponse)(nil),         // 9: grit.v2.api.DaemonInfoResponse
+	(*ListSourcesRequest)(nil),         // 10: grit.v2.api.ListSourcesRequest
+	(*ListSourcesResponse)(nil),        // 11: grit.v2.api.ListSourcesResponse
+	(*SignInRequest)(nil),              // 12: grit.v2.api.SignInRequest
+	(*SignInResponse)(nil),             // 13: grit.v2.api.SignInResponse
+	(*SignOutRequest)(nil),             // 14: grit.v2.api.SignOutRequest
+	(*SignOutResponse)(nil),            // 15: grit.v2.api.SignOutResponse
+	(*ResolveRepoRequest)(nil),         // 16: grit.v2.api.ResolveRepoRequest
+	(*ResolveRepoResponse)(nil),        // 17: grit.v2.api.ResolveRepoResponse
+	(*CloneRepoRequest)(nil),           // 18: grit.v2.api.CloneRepoRequest
+	(*CloneOptions)(nil),               // 19: grit.v2.api.CloneOptions
+	(*CloneRepoResponse)(nil),          // 20: grit.v2.api.CloneRepoResponse
+	(*CloneReposRequest)(nil),          // 21: grit.v2.api.CloneReposRequest
+	(*CloneTarget)(nil),                // 22: grit.v2.api.CloneTarget
+	(*CloneReposResponse)(nil),         // 23: grit.v2.api.CloneReposResponse
+	(*CloneRepoResult)(nil),            // 24: grit.v2.api.CloneRepoResult
+	(*CloneProgress)(nil),              // 25: grit.v2.api.CloneProgress
+	(*ForkRepoRequest)(nil),            // 26: grit.v2.api.ForkRepoRequest
+	(*CreateRepoRequest)(nil),          // 27: grit.v2.api.CreateRepoRequest
+	(*CheckoutPullRequestRequest)(nil), // 28: grit.v2.api.CheckoutPullRequestRequest
+	(*SuggestReposRequest)(nil),        // 29: grit.v2.api.SuggestReposRequest
+	(*SuggestResponse)(nil),            // 30: grit.v2.api.SuggestResponse
+	(*FetchReposRequest)(nil),          // 31: grit.v2.api.FetchReposRequest
+	(*FetchReposResponse)(nil),         // 32: grit.v2.api.FetchReposResponse
+	(*FetchRepoResult)(nil),            // 33: grit.v2.api.FetchRepoResult
+	(*PullReposRequest)(nil),           // 34: grit.v2.api.PullReposRequest
+	(*PullReposResponse)(nil),          // 35: grit.v2.api.PullReposResponse
+	(*PullRepoResult)(nil),             // 36: grit.v2.api.PullRepoResult
+	(*RemoveRepoRequest)(nil),          // 37: grit.v2.api.RemoveRepoRequest
+	(*RemoveRepoResponse)(nil),         // 38: grit.v2.api.RemoveRepoResponse
+	(*ArchiveReposRequest)(nil),        // 39: grit.v2.api.ArchiveReposRequest
+	(*ArchiveReposResponse)(nil),       // 40: grit.v2.api.ArchiveReposResponse
+	(*ArchiveRepoResult)(nil),          // 41: grit.v2.api.ArchiveRepoResult
+	(*UnarchiveRepoRequest)(nil),       // 42: grit.v2.api.UnarchiveRepoRequest
+	(*UnarchiveRepoResponse)(nil),      // 43: grit.v2.api.UnarchiveRepoResponse
+	(*AdoptRepoRequest)(nil),           // 44: grit.v2.api.AdoptRepoRequest
+	(*AdoptRepoResponse)(nil),          // 45: grit.v2.api.AdoptRepoResponse
+	(*RelocateReposRequest)(nil),       // 46: grit.v2.api.RelocateReposRequest
+	(*RelocateReposResponse)(nil),      // 47: grit.v2.api.RelocateReposResponse
+	(*RelocateRepoResult)(nil),         // 48: grit.v2.api.RelocateRepoResult
+	(*ListLocalReposRequest)(nil),      // 49: grit.v2.api.ListLocalReposRequest
+	(*ListLocalReposResponse)(nil),     // 50: grit.v2.api.ListLocalReposResponse
+	(*LocalRepoListing)(nil),           // 51: grit.v2.api.LocalRepoListing
+	(*DescribeRepoRequest)(nil),        // 52: grit.v2.api.DescribeRepoRequest
+	(*DescribeRepoResponse)(nil),       // 53: grit.v2.api.DescribeRepoResponse
+	(*Worktree)(nil),                   // 54: grit.v2.api.Worktree
+	(*AddWorktreeRequest)(nil),         // 55: grit.v2.api.AddWorktreeRequest
+	(*AddWorktreeResponse)(nil),        // 56: grit.v2.api.AddWorktreeResponse
+	(*ListWorktreesRequest)(nil),       // 57: grit.v2.api.ListWorktreesRequest
+	(*ListWorktreesResponse)(nil),      // 58: grit.v2.api.ListWorktreesResponse
+	(*RemoveWorktreeRequest)(nil),      // 59: grit.v2.api.RemoveWorktreeRequest
+	(*RemoveWorktreeResponse)(nil),     // 60: grit.v2.api.RemoveWorktreeResponse
+}
+var file_github_com_gritcli_grit_api_api_proto_depIdxs = []int32{
+	2,  // 0: grit.v2.api.LocalRepo.remote_repo:type_name -> grit.v2.api.RemoteRepo
+	1,  // 1: grit.v2.api.ListSourcesResponse.sources:type_name -> grit.v2.api.Source
+	7,  // 2: grit.v2.api.SignInResponse.output:type_name -> grit.v2.api.ClientOutput
+	6,  // 3: grit.v2.api.ResolveRepoRequest.client_options:type_name -> grit.v2.api.ClientOptions
+	0,  // 4: grit.v2.api.ResolveRepoRequest.locality_filter:type_name -> grit.v2.api.Locality
+	7,  // 5: grit.v2.api.ResolveRepoResponse.output:type_name -> grit.v2.api.ClientOutput
+	3,  // 6: grit.v2.api.ResolveRepoResponse.local_repo:type_name -> grit.v2.api.LocalRepo
+	2,  // 7: grit.v2.api.ResolveRepoResponse.remote_repo:type_name -> grit.v2.api.RemoteRepo
+	6,  // 8: grit.v2.api.CloneRepoRequest.client_options:type_name -> grit.v2.api.ClientOptions
+	19, // 9: grit.v2.api.CloneRepoRequest.clone_options:type_name -> grit.v2.api.CloneOptions
+	7,  // 10: grit.v2.api.CloneRepoResponse.output:type_name -> grit.v2.api.ClientOutput
+	3,  // 11: grit.v2.api.CloneRepoResponse.local_repo:type_name -> grit.v2.api.LocalRepo
+	25, // 12: grit.v2.api.CloneRepoResponse.progress:type_name -> grit.v2.api.CloneProgress
+	6,  // 13: grit.v2.api.CloneReposRequest.client_options:type_name -> grit.v2.api.ClientOptions
+	22, // 14: grit.v2.api.CloneReposRequest.targets:type_name -> grit.v2.api.CloneTarget
+	19, // 15: grit.v2.api.CloneReposRequest.clone_options:type_name -> grit.v2.api.CloneOptions
+	7,  // 16: grit.v2.api.CloneReposResponse.output:type_name -> grit.v2.api.ClientOutput
+	24, // 17: grit.v2.api.CloneReposResponse.result:type_name -> grit.v2.api.CloneRepoResult
+	22, // 18: grit.v2.api.CloneRepoResult.target:type_name -> grit.v2.api.CloneTarget
+	3,  // 19: grit.v2.api.CloneRepoResult.local_repo:type_name -> grit.v2.api.LocalRepo
+	6,  // 20: grit.v2.api.ForkRepoRequest.client_options:type_name -> grit.v2.api.ClientOptions
+	19, // 21: grit.v2.api.ForkRepoRequest.clone_options:type_name -> grit.v2.api.CloneOptions
+	6,  // 22: grit.v2.api.CreateRepoRequest.client_options:type_name -> grit.v2.api.ClientOptions
+	6,  // 23: grit.v2.api.CheckoutPullRequestRequest.client_options:type_name -> grit.v2.api.ClientOptions
+	0,  // 24: grit.v2.api.SuggestReposRequest.locality_filter:type_name -> grit.v2.api.Locality
+	6,  // 25: grit.v2.api.FetchReposRequest.client_options:type_name -> grit.v2.api.ClientOptions
+	7,  // 26: grit.v2.api.FetchReposResponse.output:type_name -> grit.v2.api.ClientOutput
+	33, // 27: grit.v2.api.FetchReposResponse.result:type_name -> grit.v2.api.FetchRepoResult
+	3,  // 28: grit.v2.api.FetchRepoResult.local_repo:type_name -> grit.v2.api.LocalRepo
+	6,  // 29: grit.v2.api.PullReposRequest.client_options:type_name -> grit.v2.api.ClientOptions
+	7,  // 30: grit.v2.api.PullReposResponse.output:type_name -> grit.v2.api.ClientOutput
+	36, // 31: grit.v2.api.PullReposResponse.result:type_name -> grit.v2.api.PullRepoResult
+	3,  // 32: grit.v2.api.PullRepoResult.local_repo:type_name -> grit.v2.api.LocalRepo
+	6,  // 33: grit.v2.api.RemoveRepoRequest.client_options:type_name -> grit.v2.api.ClientOptions
+	7,  // 34: grit.v2.api.RemoveRepoResponse.output:type_name -> grit.v2.api.ClientOutput
+	3,  // 35: grit.v2.api.RemoveRepoResponse.local_repo:type_name -> grit.v2.api.LocalRepo
+	6,  // 36: grit.v2.api.ArchiveReposRequest.client_options:type_name -> grit.v2.api.ClientOptions
+	7,  // 37: grit.v2.api.ArchiveReposResponse.output:type_name -> grit.v2.api.ClientOutput
+	41, // 38: grit.v2.api.ArchiveReposResponse.result:type_name -> grit.v2.api.ArchiveRepoResult
+	3,  // 39: grit.v2.api.ArchiveRepoResult.local_repo:type_name -> grit.v2.api.LocalRepo
+	6,  // 40: grit.v2.api.UnarchiveRepoRequest.client_options:type_name -> grit.v2.api.ClientOptions
+	7,  // 41: grit.v2.api.UnarchiveRepoResponse.output:type_name -> grit.v2.api.ClientOutput
+	3,  // 42: grit.v2.api.UnarchiveRepoResponse.local_repo:type_name -> grit.v2.api.LocalRepo
+	6,  // 43: grit.v2.api.AdoptRepoRequest.client_options:type_name -> grit.v2.api.ClientOptions
+	7,  // 44: grit.v2.api.AdoptRepoResponse.output:type_name -> grit.v2.api.ClientOutput
+	3,  // 45: grit.v2.api.AdoptRepoResponse.local_repo:type_name -> grit.v2.api.LocalRepo
+	6,  // 46: grit.v2.api.RelocateReposRequest.client_options:type_name -> grit.v2.api.ClientOptions
+	7,  // 47: grit.v2.api.RelocateReposResponse.output:type_name -> grit.v2.api.ClientOutput
+	48, // 48: grit.v2.api.RelocateReposResponse.result:type_name -> grit.v2.api.RelocateRepoResult
+	3,  // 49: grit.v2.api.RelocateRepoResult.local_repo:type_name -> grit.v2.api.LocalRepo
+	51, // 50: grit.v2.api.ListLocalReposResponse.local_repos:type_name -> grit.v2.api.LocalRepoListing
+	3,  // 51: grit.v2.api.LocalRepoListing.local_repo:type_name -> grit.v2.api.LocalRepo
+	5,  // 52: grit.v2.api.LocalRepoListing.status:type_name -> grit.v2.api.LocalRepoStatus
+	2,  // 53: grit.v2.api.DescribeRepoResponse.remote_repo:type_name -> grit.v2.api.RemoteRepo
+	4,  // 54: grit.v2.api.DescribeRepoResponse.clone_urls:type_name -> grit.v2.api.CloneURL
+	51, // 55: grit.v2.api.DescribeRepoResponse.local_repos:type_name -> grit.v2.api.LocalRepoListing
+	3,  // 56: grit.v2.api.Worktree.local_repo:type_name -> grit.v2.api.LocalRepo
+	6,  // 57: grit.v2.api.AddWorktreeRequest.client_options:type_name -> grit.v2.api.ClientOptions
+	7,  // 58: grit.v2.api.AddWorktreeResponse.output:type_name -> grit.v2.api.ClientOutput
+	54, // 59: grit.v2.api.AddWorktreeResponse.worktree:type_name -> grit.v2.api.Worktree
+	54, // 60: grit.v2.api.ListWorktreesResponse.worktrees:type_name -> grit.v2.api.Worktree
+	6,  // 61: grit.v2.api.RemoveWorktreeRequest.client_options:type_name -> grit.v2.api.ClientOptions
+	7,  // 62: grit.v2.api.RemoveWorktreeResponse.output:type_name -> grit.v2.api.ClientOutput
+	54, // 63: grit.v2.api.RemoveWorktreeResponse.worktree:type_name -> grit.v2.api.Worktree
+	8,  // 64: grit.v2.api.API.DaemonInfo:input_type -> grit.v2.api.DaemonInfoRequest
+	10, // 65: grit.v2.api.API.ListSources:input_type -> grit.v2.api.ListSourcesRequest
+	12, // 66: grit.v2.api.API.SignIn:input_type -> grit.v2.api.SignInRequest
+	14, // 67: grit.v2.api.API.SignOut:input_type -> grit.v2.api.SignOutRequest
+	16, // 68: grit.v2.api.API.ResolveRepo:input_type -> grit.v2.api.ResolveRepoRequest
+	18, // 69: grit.v2.api.API.CloneRepo:input_type -> grit.v2.api.CloneRepoRequest
+	21, // 70: grit.v2.api.API.CloneRepos:input_type -> grit.v2.api.CloneReposRequest
+	26, // 71: grit.v2.api.API.ForkRepo:input_type -> grit.v2.api.ForkRepoRequest
+	27, // 72: grit.v2.api.API.CreateRepo:input_type -> grit.v2.api.CreateRepoRequest
+	28, // 73: grit.v2.api.API.CheckoutPullRequest:input_type -> grit.v2.api.CheckoutPullRequestRequest
+	29, // 74: grit.v2.api.API.SuggestRepos:input_type -> grit.v2.api.SuggestReposRequest
+	31, // 75: grit.v2.api.API.FetchRepos:input_type -> grit.v2.api.FetchReposRequest
+	34, // 76: grit.v2.api.API.PullRepos:input_type -> grit.v2.api.PullReposRequest
+	37, // 77: grit.v2.api.API.RemoveRepo:input_type -> grit.v2.api.RemoveRepoRequest
+	39, // 78: grit.v2.api.API.ArchiveRepos:input_type -> grit.v2.api.ArchiveReposRequest
+	42, // 79: grit.v2.api.API.UnarchiveRepo:input_type -> grit.v2.api.UnarchiveRepoRequest
+	44, // 80: grit.v2.api.API.AdoptRepo:input_type -> grit.v2.api.AdoptRepoRequest
+	46, // 81: grit.v2.api.API.RelocateRepos:input_type -> grit.v2.api.RelocateReposRequest
+	49, // 82: grit.v2.api.API.ListLocalRepos:input_type -> grit.v2.api.ListLocalReposRequest
+	52, // 83: grit.v2.api.API.DescribeRepo:input_type -> grit.v2.api.DescribeRepoRequest
+	55, // 84: grit.v2.api.API.AddWorktree:input_type -> grit.v2.api.AddWorktreeRequest
+	57, // 85: grit.v2.api.API.ListWorktrees:input_type -> grit.v2.api.ListWorktreesRequest
+	59, // 86: grit.v2.api.API.RemoveWorktree:input_type -> grit.v2.api.RemoveWorktreeRequest
+	9,  // 87: grit.v2.api.API.DaemonInfo:output_type -> grit.v2.api.DaemonInfoResponse
+	11, // 88: grit.v2.api.API.ListSources:output_type -> grit.v2.api.ListSourcesResponse
+	13, // 89: grit.v2.api.API.SignIn:output_type -> grit.v2.api.SignInResponse
+	15, // 90: grit.v2.api.API.SignOut:output_type -> grit.v2.api.SignOutResponse
+	17, // 91: grit.v2.api.API.ResolveRepo:output_type -> grit.v2.api.ResolveRepoResponse
+	20, // 92: grit.v2.api.API.CloneRepo:output_type -> grit.v2.api.CloneRepoResponse
+	23, // 93: grit.v2.api.API.CloneRepos:output_type -> grit.v2.api.CloneReposResponse
+	20, // 94: grit.v2.api.API.ForkRepo:output_type -> grit.v2.api.CloneRepoResponse
+	20, // 95: grit.v2.api.API.CreateRepo:output_type -> grit.v2.api.CloneRepoResponse
+	20, // 96: grit.v2.api.API.CheckoutPullRequest:output_type -> grit.v2.api.CloneRepoResponse
+	30, // 97: grit.v2.api.API.SuggestRepos:output_type -> grit.v2.api.SuggestResponse
+	32, // 98: grit.v2.api.API.FetchRepos:output_type -> grit.v2.api.FetchReposResponse
+	35, // 99: grit.v2.api.API.PullRepos:output_type -> grit.v2.api.PullReposResponse
+	38, // 100: grit.v2.api.API.RemoveRepo:output_type -> grit.v2.api.RemoveRepoResponse
+	40, // 101: grit.v2.api.API.ArchiveRepos:output_type -> grit.v2.api.ArchiveReposResponse
+	43, // 102: grit.v2.api.API.UnarchiveRepo:output_type -> grit.v2.api.UnarchiveRepoResponse
+	45, // 103: grit.v2.api.API.AdoptRepo:output_type -> grit.v2.api.AdoptRepoResponse
+	47, // 104: grit.v2.api.API.RelocateRepos:output_type -> grit.v2.api.RelocateReposResponse
+	50, // 105: grit.v2.api.API.ListLocalRepos:output_type -> grit.v2.api.ListLocalReposResponse
+	53, // 106: grit.v2.api.API.DescribeRepo:output_type -> grit.v2.api.DescribeRepoResponse
+	56, // 107: grit.v2.api.API.AddWorktree:output_type -> grit.v2.api.AddWorktreeResponse
+	58, // 108: grit.v2.api.API.ListWorktrees:output_type -> grit.v2.api.ListWorktreesResponse
+	60, // 109: grit.v2.api.API.RemoveWorktree:output_type -> grit.v2.api.RemoveWorktreeResponse
+	87, // [87:110] is the sub-list for method output_type
+	64, // [64:87] is the sub-list for method input_type
+	64, // [64:64] is the sub-list for extension type_name
+	64, // [64:64] is the sub-list for extension extendee
+	0,  // [0:64] is the sub-list for field type_name
+}
+
+func init() { file_github_com_gritcli_grit_api_api_proto_init() }
+func file_github_com_gritcli_grit_api_api_proto_init() {
+	if File_github_com_gritcli_grit_api_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Source); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteRepo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalRepo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneURL); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalRepoStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DaemonInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DaemonInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSourcesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSourcesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignInRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignInResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignOutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignOutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveRepoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneRepoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneReposRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneReposResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneRepoResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForkRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckoutPullRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestReposRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1399,8 +5219,8 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoteRepo); i {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchReposRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1411,8 +5231,8 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocalRepo); i {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchReposResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1423,8 +5243,8 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientOptions); i {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchRepoResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1435,8 +5255,8 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientOutput); i {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullReposRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1447,8 +5267,8 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DaemonInfoRequest); i {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullReposResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1459,8 +5279,8 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DaemonInfoResponse); i {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullRepoResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1471,8 +5291,8 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSourcesRequest); i {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveRepoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1483,8 +5303,8 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSourcesResponse); i {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveRepoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1495,8 +5315,8 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInRequest); i {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveReposRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1507,8 +5327,8 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInResponse); i {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveReposResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1519,8 +5339,8 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignOutRequest); i {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveRepoResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1531,8 +5351,8 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignOutResponse); i {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnarchiveRepoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1543,8 +5363,8 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResolveRepoRequest); i {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnarchiveRepoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1555,8 +5375,8 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResolveRepoResponse); i {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdoptRepoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1567,8 +5387,8 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloneRepoRequest); i {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdoptRepoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1579,8 +5399,8 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloneRepoResponse); i {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelocateReposRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1591,8 +5411,8 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestReposRequest); i {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelocateReposResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1603,8 +5423,152 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_gritcli_grit_api_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestResponse); i {
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelocateRepoResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLocalReposRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLocalReposResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalRepoListing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeRepoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Worktree); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddWorktreeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddWorktreeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorktreesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorktreesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveWorktreeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_gritcli_grit_api_api_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveWorktreeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1616,17 +5580,59 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 			}
 		}
 	}
-	file_github_com_gritcli_grit_api_api_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_github_com_gritcli_grit_api_api_proto_msgTypes[12].OneofWrappers = []interface{}{
 		(*SignInResponse_Output)(nil),
 	}
-	file_github_com_gritcli_grit_api_api_proto_msgTypes[14].OneofWrappers = []interface{}{
+	file_github_com_gritcli_grit_api_api_proto_msgTypes[16].OneofWrappers = []interface{}{
 		(*ResolveRepoResponse_Output)(nil),
 		(*ResolveRepoResponse_LocalRepo)(nil),
 		(*ResolveRepoResponse_RemoteRepo)(nil),
 	}
-	file_github_com_gritcli_grit_api_api_proto_msgTypes[16].OneofWrappers = []interface{}{
+	file_github_com_gritcli_grit_api_api_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_github_com_gritcli_grit_api_api_proto_msgTypes[19].OneofWrappers = []interface{}{
 		(*CloneRepoResponse_Output)(nil),
 		(*CloneRepoResponse_LocalRepo)(nil),
+		(*CloneRepoResponse_Progress)(nil),
+	}
+	file_github_com_gritcli_grit_api_api_proto_msgTypes[22].OneofWrappers = []interface{}{
+		(*CloneReposResponse_Output)(nil),
+		(*CloneReposResponse_Result)(nil),
+	}
+	file_github_com_gritcli_grit_api_api_proto_msgTypes[31].OneofWrappers = []interface{}{
+		(*FetchReposResponse_Output)(nil),
+		(*FetchReposResponse_Result)(nil),
+	}
+	file_github_com_gritcli_grit_api_api_proto_msgTypes[34].OneofWrappers = []interface{}{
+		(*PullReposResponse_Output)(nil),
+		(*PullReposResponse_Result)(nil),
+	}
+	file_github_com_gritcli_grit_api_api_proto_msgTypes[37].OneofWrappers = []interface{}{
+		(*RemoveRepoResponse_Output)(nil),
+		(*RemoveRepoResponse_LocalRepo)(nil),
+	}
+	file_github_com_gritcli_grit_api_api_proto_msgTypes[39].OneofWrappers = []interface{}{
+		(*ArchiveReposResponse_Output)(nil),
+		(*ArchiveReposResponse_Result)(nil),
+	}
+	file_github_com_gritcli_grit_api_api_proto_msgTypes[42].OneofWrappers = []interface{}{
+		(*UnarchiveRepoResponse_Output)(nil),
+		(*UnarchiveRepoResponse_LocalRepo)(nil),
+	}
+	file_github_com_gritcli_grit_api_api_proto_msgTypes[44].OneofWrappers = []interface{}{
+		(*AdoptRepoResponse_Output)(nil),
+		(*AdoptRepoResponse_LocalRepo)(nil),
+	}
+	file_github_com_gritcli_grit_api_api_proto_msgTypes[46].OneofWrappers = []interface{}{
+		(*RelocateReposResponse_Output)(nil),
+		(*RelocateReposResponse_Result)(nil),
+	}
+	file_github_com_gritcli_grit_api_api_proto_msgTypes[55].OneofWrappers = []interface{}{
+		(*AddWorktreeResponse_Output)(nil),
+		(*AddWorktreeResponse_Worktree)(nil),
+	}
+	file_github_com_gritcli_grit_api_api_proto_msgTypes[59].OneofWrappers = []interface{}{
+		(*RemoveWorktreeResponse_Output)(nil),
+		(*RemoveWorktreeResponse_Worktree)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1634,7 +5640,7 @@ func file_github_com_gritcli_grit_api_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_gritcli_grit_api_api_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   19,
+			NumMessages:   60,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,13 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	API_DaemonInfo_FullMethodName   = "/grit.v2.api.API/DaemonInfo"
-	API_ListSources_FullMethodName  = "/grit.v2.api.API/ListSources"
-	API_SignIn_FullMethodName       = "/grit.v2.api.API/SignIn"
-	API_SignOut_FullMethodName      = "/grit.v2.api.API/SignOut"
-	API_ResolveRepo_FullMethodName  = "/grit.v2.api.API/ResolveRepo"
-	API_CloneRepo_FullMethodName    = "/grit.v2.api.API/CloneRepo"
-	API_SuggestRepos_FullMethodName = "/grit.v2.api.API/SuggestRepos"
+	API_DaemonInfo_FullMethodName          = "/grit.v2.api.API/DaemonInfo"
+	API_ListSources_FullMethodName         = "/grit.v2.api.API/ListSources"
+	API_SignIn_FullMethodName              = "/grit.v2.api.API/SignIn"
+	API_SignOut_FullMethodName             = "/grit.v2.api.API/SignOut"
+	API_ResolveRepo_FullMethodName         = "/grit.v2.api.API/ResolveRepo"
+	API_CloneRepo_FullMethodName           = "/grit.v2.api.API/CloneRepo"
+	API_CloneRepos_FullMethodName          = "/grit.v2.api.API/CloneRepos"
+	API_ForkRepo_FullMethodName            = "/grit.v2.api.API/ForkRepo"
+	API_CreateRepo_FullMethodName          = "/grit.v2.api.API/CreateRepo"
+	API_CheckoutPullRequest_FullMethodName = "/grit.v2.api.API/CheckoutPullRequest"
+	API_SuggestRepos_FullMethodName        = "/grit.v2.api.API/SuggestRepos"
+	API_FetchRepos_FullMethodName          = "/grit.v2.api.API/FetchRepos"
+	API_PullRepos_FullMethodName           = "/grit.v2.api.API/PullRepos"
+	API_RemoveRepo_FullMethodName          = "/grit.v2.api.API/RemoveRepo"
+	API_ArchiveRepos_FullMethodName        = "/grit.v2.api.API/ArchiveRepos"
+	API_UnarchiveRepo_FullMethodName       = "/grit.v2.api.API/UnarchiveRepo"
+	API_AdoptRepo_FullMethodName           = "/grit.v2.api.API/AdoptRepo"
+	API_RelocateRepos_FullMethodName       = "/grit.v2.api.API/RelocateRepos"
+	API_ListLocalRepos_FullMethodName      = "/grit.v2.api.API/ListLocalRepos"
+	API_DescribeRepo_FullMethodName        = "/grit.v2.api.API/DescribeRepo"
+	API_AddWorktree_FullMethodName         = "/grit.v2.api.API/AddWorktree"
+	API_ListWorktrees_FullMethodName       = "/grit.v2.api.API/ListWorktrees"
+	API_RemoveWorktree_FullMethodName      = "/grit.v2.api.API/RemoveWorktree"
 )
 
 // APIClient is the client API for API service.
@@ -45,9 +61,52 @@ type APIClient interface {
 	ResolveRepo(ctx context.Context, in *ResolveRepoRequest, opts ...grpc.CallOption) (API_ResolveRepoClient, error)
 	// CloneRepo makes a local clone of a repository from a source.
 	CloneRepo(ctx context.Context, in *CloneRepoRequest, opts ...grpc.CallOption) (API_CloneRepoClient, error)
+	// CloneRepos makes local clones of multiple repositories in parallel,
+	// skipping any that have already been cloned.
+	CloneRepos(ctx context.Context, in *CloneReposRequest, opts ...grpc.CallOption) (API_CloneReposClient, error)
+	// ForkRepo creates a fork of a repository from a source, then makes a local
+	// clone of the fork.
+	ForkRepo(ctx context.Context, in *ForkRepoRequest, opts ...grpc.CallOption) (API_ForkRepoClient, error)
+	// CreateRepo creates a new repository on a source, then makes a local clone
+	// of it.
+	CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (API_CreateRepoClient, error)
+	// CheckoutPullRequest checks out a pull request in a local branch of the
+	// local clone of its repository, cloning the repository if necessary.
+	CheckoutPullRequest(ctx context.Context, in *CheckoutPullRequestRequest, opts ...grpc.CallOption) (API_CheckoutPullRequestClient, error)
 	// SuggestRepos returns a list of repository names to be used as suggestions
 	// for completing a partial repository name.
 	SuggestRepos(ctx context.Context, in *SuggestReposRequest, opts ...grpc.CallOption) (*SuggestResponse, error)
+	// FetchRepos fetches changes from the remote repositories of existing local
+	// clones without modifying their working trees.
+	FetchRepos(ctx context.Context, in *FetchReposRequest, opts ...grpc.CallOption) (API_FetchReposClient, error)
+	// PullRepos fetches changes from the remote repositories of existing local
+	// clones then fast-forwards their checked-out branches, where possible.
+	PullRepos(ctx context.Context, in *PullReposRequest, opts ...grpc.CallOption) (API_PullReposClient, error)
+	// RemoveRepo removes a local clone.
+	RemoveRepo(ctx context.Context, in *RemoveRepoRequest, opts ...grpc.CallOption) (API_RemoveRepoClient, error)
+	// ArchiveRepos moves local clones that have not been used recently into
+	// compressed archives.
+	ArchiveRepos(ctx context.Context, in *ArchiveReposRequest, opts ...grpc.CallOption) (API_ArchiveReposClient, error)
+	// UnarchiveRepo restores an archived clone to its original location.
+	UnarchiveRepo(ctx context.Context, in *UnarchiveRepoRequest, opts ...grpc.CallOption) (API_UnarchiveRepoClient, error)
+	// AdoptRepo moves an existing clone from outside of Grit's clone directory
+	// into the location Grit would use if it had cloned it.
+	AdoptRepo(ctx context.Context, in *AdoptRepoRequest, opts ...grpc.CallOption) (API_AdoptRepoClient, error)
+	// RelocateRepos moves local clones to the locations dictated by the current
+	// configuration.
+	RelocateRepos(ctx context.Context, in *RelocateReposRequest, opts ...grpc.CallOption) (API_RelocateReposClient, error)
+	// ListLocalRepos lists the local clones that match a filter.
+	ListLocalRepos(ctx context.Context, in *ListLocalReposRequest, opts ...grpc.CallOption) (*ListLocalReposResponse, error)
+	// DescribeRepo returns detailed information about a single repository,
+	// including the state of any local clones.
+	DescribeRepo(ctx context.Context, in *DescribeRepoRequest, opts ...grpc.CallOption) (*DescribeRepoResponse, error)
+	// AddWorktree creates an additional working tree for a local clone with a
+	// specific branch checked out.
+	AddWorktree(ctx context.Context, in *AddWorktreeRequest, opts ...grpc.CallOption) (API_AddWorktreeClient, error)
+	// ListWorktrees lists the additional working trees of local clones.
+	ListWorktrees(ctx context.Context, in *ListWorktreesRequest, opts ...grpc.CallOption) (*ListWorktreesResponse, error)
+	// RemoveWorktree removes an additional working tree of a local clone.
+	RemoveWorktree(ctx context.Context, in *RemoveWorktreeRequest, opts ...grpc.CallOption) (API_RemoveWorktreeClient, error)
 }
 
 type aPIClient struct {
@@ -181,6 +240,134 @@ func (x *aPICloneRepoClient) Recv() (*CloneRepoResponse, error) {
 	return m, nil
 }
 
+func (c *aPIClient) CloneRepos(ctx context.Context, in *CloneReposRequest, opts ...grpc.CallOption) (API_CloneReposClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[3], API_CloneRepos_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPICloneReposClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_CloneReposClient interface {
+	Recv() (*CloneReposResponse, error)
+	grpc.ClientStream
+}
+
+type aPICloneReposClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPICloneReposClient) Recv() (*CloneReposResponse, error) {
+	m := new(CloneReposResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) ForkRepo(ctx context.Context, in *ForkRepoRequest, opts ...grpc.CallOption) (API_ForkRepoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[4], API_ForkRepo_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIForkRepoClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ForkRepoClient interface {
+	Recv() (*CloneRepoResponse, error)
+	grpc.ClientStream
+}
+
+type aPIForkRepoClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIForkRepoClient) Recv() (*CloneRepoResponse, error) {
+	m := new(CloneRepoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (API_CreateRepoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[5], API_CreateRepo_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPICreateRepoClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_CreateRepoClient interface {
+	Recv() (*CloneRepoResponse, error)
+	grpc.ClientStream
+}
+
+type aPICreateRepoClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPICreateRepoClient) Recv() (*CloneRepoResponse, error) {
+	m := new(CloneRepoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) CheckoutPullRequest(ctx context.Context, in *CheckoutPullRequestRequest, opts ...grpc.CallOption) (API_CheckoutPullRequestClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[6], API_CheckoutPullRequest_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPICheckoutPullRequestClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_CheckoutPullRequestClient interface {
+	Recv() (*CloneRepoResponse, error)
+	grpc.ClientStream
+}
+
+type aPICheckoutPullRequestClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPICheckoutPullRequestClient) Recv() (*CloneRepoResponse, error) {
+	m := new(CloneRepoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *aPIClient) SuggestRepos(ctx context.Context, in *SuggestReposRequest, opts ...grpc.CallOption) (*SuggestResponse, error) {
 	out := new(SuggestResponse)
 	err := c.cc.Invoke(ctx, API_SuggestRepos_FullMethodName, in, out, opts...)
@@ -190,6 +377,321 @@ func (c *aPIClient) SuggestRepos(ctx context.Context, in *SuggestReposRequest, o
 	return out, nil
 }
 
+func (c *aPIClient) FetchRepos(ctx context.Context, in *FetchReposRequest, opts ...grpc.CallOption) (API_FetchReposClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[7], API_FetchRepos_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIFetchReposClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_FetchReposClient interface {
+	Recv() (*FetchReposResponse, error)
+	grpc.ClientStream
+}
+
+type aPIFetchReposClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIFetchReposClient) Recv() (*FetchReposResponse, error) {
+	m := new(FetchReposResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) PullRepos(ctx context.Context, in *PullReposRequest, opts ...grpc.CallOption) (API_PullReposClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[8], API_PullRepos_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIPullReposClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_PullReposClient interface {
+	Recv() (*PullReposResponse, error)
+	grpc.ClientStream
+}
+
+type aPIPullReposClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIPullReposClient) Recv() (*PullReposResponse, error) {
+	m := new(PullReposResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) RemoveRepo(ctx context.Context, in *RemoveRepoRequest, opts ...grpc.CallOption) (API_RemoveRepoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[9], API_RemoveRepo_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIRemoveRepoClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_RemoveRepoClient interface {
+	Recv() (*RemoveRepoResponse, error)
+	grpc.ClientStream
+}
+
+type aPIRemoveRepoClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIRemoveRepoClient) Recv() (*RemoveRepoResponse, error) {
+	m := new(RemoveRepoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) ArchiveRepos(ctx context.Context, in *ArchiveReposRequest, opts ...grpc.CallOption) (API_ArchiveReposClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[10], API_ArchiveRepos_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIArchiveReposClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ArchiveReposClient interface {
+	Recv() (*ArchiveReposResponse, error)
+	grpc.ClientStream
+}
+
+type aPIArchiveReposClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIArchiveReposClient) Recv() (*ArchiveReposResponse, error) {
+	m := new(ArchiveReposResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) UnarchiveRepo(ctx context.Context, in *UnarchiveRepoRequest, opts ...grpc.CallOption) (API_UnarchiveRepoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[11], API_UnarchiveRepo_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIUnarchiveRepoClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_UnarchiveRepoClient interface {
+	Recv() (*UnarchiveRepoResponse, error)
+	grpc.ClientStream
+}
+
+type aPIUnarchiveRepoClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIUnarchiveRepoClient) Recv() (*UnarchiveRepoResponse, error) {
+	m := new(UnarchiveRepoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) AdoptRepo(ctx context.Context, in *AdoptRepoRequest, opts ...grpc.CallOption) (API_AdoptRepoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[12], API_AdoptRepo_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIAdoptRepoClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_AdoptRepoClient interface {
+	Recv() (*AdoptRepoResponse, error)
+	grpc.ClientStream
+}
+
+type aPIAdoptRepoClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIAdoptRepoClient) Recv() (*AdoptRepoResponse, error) {
+	m := new(AdoptRepoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) RelocateRepos(ctx context.Context, in *RelocateReposRequest, opts ...grpc.CallOption) (API_RelocateReposClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[13], API_RelocateRepos_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIRelocateReposClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_RelocateReposClient interface {
+	Recv() (*RelocateReposResponse, error)
+	grpc.ClientStream
+}
+
+type aPIRelocateReposClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIRelocateReposClient) Recv() (*RelocateReposResponse, error) {
+	m := new(RelocateReposResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) ListLocalRepos(ctx context.Context, in *ListLocalReposRequest, opts ...grpc.CallOption) (*ListLocalReposResponse, error) {
+	out := new(ListLocalReposResponse)
+	err := c.cc.Invoke(ctx, API_ListLocalRepos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DescribeRepo(ctx context.Context, in *DescribeRepoRequest, opts ...grpc.CallOption) (*DescribeRepoResponse, error) {
+	out := new(DescribeRepoResponse)
+	err := c.cc.Invoke(ctx, API_DescribeRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) AddWorktree(ctx context.Context, in *AddWorktreeRequest, opts ...grpc.CallOption) (API_AddWorktreeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[14], API_AddWorktree_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIAddWorktreeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_AddWorktreeClient interface {
+	Recv() (*AddWorktreeResponse, error)
+	grpc.ClientStream
+}
+
+type aPIAddWorktreeClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIAddWorktreeClient) Recv() (*AddWorktreeResponse, error) {
+	m := new(AddWorktreeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) ListWorktrees(ctx context.Context, in *ListWorktreesRequest, opts ...grpc.CallOption) (*ListWorktreesResponse, error) {
+	out := new(ListWorktreesResponse)
+	err := c.cc.Invoke(ctx, API_ListWorktrees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RemoveWorktree(ctx context.Context, in *RemoveWorktreeRequest, opts ...grpc.CallOption) (API_RemoveWorktreeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[15], API_RemoveWorktree_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIRemoveWorktreeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_RemoveWorktreeClient interface {
+	Recv() (*RemoveWorktreeResponse, error)
+	grpc.ClientStream
+}
+
+type aPIRemoveWorktreeClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIRemoveWorktreeClient) Recv() (*RemoveWorktreeResponse, error) {
+	m := new(RemoveWorktreeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // APIServer is the server API for API service.
 // All implementations should embed UnimplementedAPIServer
 // for forward compatibility
@@ -207,183 +709,601 @@ type APIServer interface {
 	ResolveRepo(*ResolveRepoRequest, API_ResolveRepoServer) error
 	// CloneRepo makes a local clone of a repository from a source.
 	CloneRepo(*CloneRepoRequest, API_CloneRepoServer) error
+	// CloneRepos makes local clones of multiple repositories in parallel,
+	// skipping any that have already been cloned.
+	CloneRepos(*CloneReposRequest, API_CloneReposServer) error
+	// ForkRepo creates a fork of a repository from a source, then makes a local
+	// clone of the fork.
+	ForkRepo(*ForkRepoRequest, API_ForkRepoServer) error
+	// CreateRepo creates a new repository on a source, then makes a local clone
+	// of it.
+	CreateRepo(*CreateRepoRequest, API_CreateRepoServer) error
+	// CheckoutPullRequest checks out a pull request in a local branch of the
+	// local clone of its repository, cloning the repository if necessary.
+	CheckoutPullRequest(*CheckoutPullRequestRequest, API_CheckoutPullRequestServer) error
 	// SuggestRepos returns a list of repository names to be used as suggestions
 	// for completing a partial repository name.
 	SuggestRepos(context.Context, *SuggestReposRequest) (*SuggestResponse, error)
+	// FetchRepos fetches changes from the remote repositories of existing local
+	// clones without modifying their working trees.
+	FetchRepos(*FetchReposRequest, API_FetchReposServer) error
+	// PullRepos fetches changes from the remote repositories of existing local
+	// clones then fast-forwards their checked-out branches, where possible.
+	PullRepos(*PullReposRequest, API_PullReposServer) error
+	// RemoveRepo removes a local clone.
+	RemoveRepo(*RemoveRepoRequest, API_RemoveRepoServer) error
+	// ArchiveRepos moves local clones that have not been used recently into
+	// compressed archives.
+	ArchiveRepos(*ArchiveReposRequest, API_ArchiveReposServer) error
+	// UnarchiveRepo restores an archived clone to its original location.
+	UnarchiveRepo(*UnarchiveRepoRequest, API_UnarchiveRepoServer) error
+	// AdoptRepo moves an existing clone from outside of Grit's clone directory
+	// into the location Grit would use if it had cloned it.
+	AdoptRepo(*AdoptRepoRequest, API_AdoptRepoServer) error
+	// RelocateRepos moves local clones to the locations dictated by the current
+	// configuration.
+	RelocateRepos(*RelocateReposRequest, API_RelocateReposServer) error
+	// ListLocalRepos lists the local clones that match a filter.
+	ListLocalRepos(context.Context, *ListLocalReposRequest) (*ListLocalReposResponse, error)
+	// DescribeRepo returns detailed information about a single repository,
+	// including the state of any local clones.
+	DescribeRepo(context.Context, *DescribeRepoRequest) (*DescribeRepoResponse, error)
+	// AddWorktree creates an additional working tree for a local clone with a
+	// specific branch checked out.
+	AddWorktree(*AddWorktreeRequest, API_AddWorktreeServer) error
+	// ListWorktrees lists the additional working trees of local clones.
+	ListWorktrees(context.Context, *ListWorktreesRequest) (*ListWorktreesResponse, error)
+	// RemoveWorktree removes an additional working tree of a local clone.
+	RemoveWorktree(*RemoveWorktreeRequest, API_RemoveWorktreeServer) error
+}
+
+// UnimplementedAPIServer should be embedded to have forward compatible implementations.
+type UnimplementedAPIServer struct {
+}
+
+func (UnimplementedAPIServer) DaemonInfo(context.Context, *DaemonInfoRequest) (*DaemonInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DaemonInfo not implemented")
+}
+func (UnimplementedAPIServer) ListSources(context.Context, *ListSourcesRequest) (*ListSourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSources not implemented")
+}
+func (UnimplementedAPIServer) SignIn(*SignInRequest, API_SignInServer) error {
+	return status.Errorf(codes.Unimplemented, "method SignIn not implemented")
+}
+func (UnimplementedAPIServer) SignOut(context.Context, *SignOutRequest) (*SignOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignOut not implemented")
+}
+func (UnimplementedAPIServer) ResolveRepo(*ResolveRepoRequest, API_ResolveRepoServer) error {
+	return status.Errorf(codes.Unimplemented, "method ResolveRepo not implemented")
+}
+func (UnimplementedAPIServer) CloneRepo(*CloneRepoRequest, API_CloneRepoServer) error {
+	return status.Errorf(codes.Unimplemented, "method CloneRepo not implemented")
+}
+func (UnimplementedAPIServer) CloneRepos(*CloneReposRequest, API_CloneReposServer) error {
+	return status.Errorf(codes.Unimplemented, "method CloneRepos not implemented")
+}
+func (UnimplementedAPIServer) ForkRepo(*ForkRepoRequest, API_ForkRepoServer) error {
+	return status.Errorf(codes.Unimplemented, "method ForkRepo not implemented")
+}
+func (UnimplementedAPIServer) CreateRepo(*CreateRepoRequest, API_CreateRepoServer) error {
+	return status.Errorf(codes.Unimplemented, "method CreateRepo not implemented")
+}
+func (UnimplementedAPIServer) CheckoutPullRequest(*CheckoutPullRequestRequest, API_CheckoutPullRequestServer) error {
+	return status.Errorf(codes.Unimplemented, "method CheckoutPullRequest not implemented")
+}
+func (UnimplementedAPIServer) SuggestRepos(context.Context, *SuggestReposRequest) (*SuggestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestRepos not implemented")
+}
+func (UnimplementedAPIServer) FetchRepos(*FetchReposRequest, API_FetchReposServer) error {
+	return status.Errorf(codes.Unimplemented, "method FetchRepos not implemented")
+}
+func (UnimplementedAPIServer) PullRepos(*PullReposRequest, API_PullReposServer) error {
+	return status.Errorf(codes.Unimplemented, "method PullRepos not implemented")
+}
+func (UnimplementedAPIServer) RemoveRepo(*RemoveRepoRequest, API_RemoveRepoServer) error {
+	return status.Errorf(codes.Unimplemented, "method RemoveRepo not implemented")
+}
+func (UnimplementedAPIServer) ArchiveRepos(*ArchiveReposRequest, API_ArchiveReposServer) error {
+	return status.Errorf(codes.Unimplemented, "method ArchiveRepos not implemented")
+}
+func (UnimplementedAPIServer) UnarchiveRepo(*UnarchiveRepoRequest, API_UnarchiveRepoServer) error {
+	return status.Errorf(codes.Unimplemented, "method UnarchiveRepo not implemented")
+}
+func (UnimplementedAPIServer) AdoptRepo(*AdoptRepoRequest, API_AdoptRepoServer) error {
+	return status.Errorf(codes.Unimplemented, "method AdoptRepo not implemented")
+}
+func (UnimplementedAPIServer) RelocateRepos(*RelocateReposRequest, API_RelocateReposServer) error {
+	return status.Errorf(codes.Unimplemented, "method RelocateRepos not implemented")
+}
+func (UnimplementedAPIServer) ListLocalRepos(context.Context, *ListLocalReposRequest) (*ListLocalReposResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLocalRepos not implemented")
+}
+func (UnimplementedAPIServer) DescribeRepo(context.Context, *DescribeRepoRequest) (*DescribeRepoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeRepo not implemented")
+}
+func (UnimplementedAPIServer) AddWorktree(*AddWorktreeRequest, API_AddWorktreeServer) error {
+	return status.Errorf(codes.Unimplemented, "method AddWorktree not implemented")
+}
+func (UnimplementedAPIServer) ListWorktrees(context.Context, *ListWorktreesRequest) (*ListWorktreesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorktrees not implemented")
+}
+func (UnimplementedAPIServer) RemoveWorktree(*RemoveWorktreeRequest, API_RemoveWorktreeServer) error {
+	return status.Errorf(codes.Unimplemented, "method RemoveWorktree not implemented")
+}
+
+// UnsafeAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to APIServer will
+// result in compilation errors.
+type UnsafeAPIServer interface {
+	mustEmbedUnimplementedAPIServer()
+}
+
+func RegisterAPIServer(s grpc.ServiceRegistrar, srv APIServer) {
+	s.RegisterService(&API_ServiceDesc, srv)
+}
+
+func _API_DaemonInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaemonInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DaemonInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DaemonInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DaemonInfo(ctx, req.(*DaemonInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ListSources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListSources(ctx, req.(*ListSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SignIn_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SignInRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).SignIn(m, &aPISignInServer{stream})
+}
+
+type API_SignInServer interface {
+	Send(*SignInResponse) error
+	grpc.ServerStream
+}
+
+type aPISignInServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPISignInServer) Send(m *SignInResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_SignOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SignOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SignOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SignOut(ctx, req.(*SignOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ResolveRepo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResolveRepoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ResolveRepo(m, &aPIResolveRepoServer{stream})
+}
+
+type API_ResolveRepoServer interface {
+	Send(*ResolveRepoResponse) error
+	grpc.ServerStream
+}
+
+type aPIResolveRepoServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIResolveRepoServer) Send(m *ResolveRepoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_CloneRepo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CloneRepoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).CloneRepo(m, &aPICloneRepoServer{stream})
+}
+
+type API_CloneRepoServer interface {
+	Send(*CloneRepoResponse) error
+	grpc.ServerStream
+}
+
+type aPICloneRepoServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPICloneRepoServer) Send(m *CloneRepoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_CloneRepos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CloneReposRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).CloneRepos(m, &aPICloneReposServer{stream})
+}
+
+type API_CloneReposServer interface {
+	Send(*CloneReposResponse) error
+	grpc.ServerStream
+}
+
+type aPICloneReposServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPICloneReposServer) Send(m *CloneReposResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_ForkRepo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ForkRepoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ForkRepo(m, &aPIForkRepoServer{stream})
+}
+
+type API_ForkRepoServer interface {
+	Send(*CloneRepoResponse) error
+	grpc.ServerStream
 }
 
-// UnimplementedAPIServer should be embedded to have forward compatible implementations.
-type UnimplementedAPIServer struct {
+type aPIForkRepoServer struct {
+	grpc.ServerStream
 }
 
-func (UnimplementedAPIServer) DaemonInfo(context.Context, *DaemonInfoRequest) (*DaemonInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DaemonInfo not implemented")
+func (x *aPIForkRepoServer) Send(m *CloneRepoResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedAPIServer) ListSources(context.Context, *ListSourcesRequest) (*ListSourcesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListSources not implemented")
+
+func _API_CreateRepo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CreateRepoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).CreateRepo(m, &aPICreateRepoServer{stream})
 }
-func (UnimplementedAPIServer) SignIn(*SignInRequest, API_SignInServer) error {
-	return status.Errorf(codes.Unimplemented, "method SignIn not implemented")
+
+type API_CreateRepoServer interface {
+	Send(*CloneRepoResponse) error
+	grpc.ServerStream
 }
-func (UnimplementedAPIServer) SignOut(context.Context, *SignOutRequest) (*SignOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignOut not implemented")
+
+type aPICreateRepoServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedAPIServer) ResolveRepo(*ResolveRepoRequest, API_ResolveRepoServer) error {
-	return status.Errorf(codes.Unimplemented, "method ResolveRepo not implemented")
+
+func (x *aPICreateRepoServer) Send(m *CloneRepoResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedAPIServer) CloneRepo(*CloneRepoRequest, API_CloneRepoServer) error {
-	return status.Errorf(codes.Unimplemented, "method CloneRepo not implemented")
+
+func _API_CheckoutPullRequest_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CheckoutPullRequestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).CheckoutPullRequest(m, &aPICheckoutPullRequestServer{stream})
 }
-func (UnimplementedAPIServer) SuggestRepos(context.Context, *SuggestReposRequest) (*SuggestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SuggestRepos not implemented")
+
+type API_CheckoutPullRequestServer interface {
+	Send(*CloneRepoResponse) error
+	grpc.ServerStream
 }
 
-// UnsafeAPIServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to APIServer will
-// result in compilation errors.
-type UnsafeAPIServer interface {
-	mustEmbedUnimplementedAPIServer()
+type aPICheckoutPullRequestServer struct {
+	grpc.ServerStream
 }
 
-func RegisterAPIServer(s grpc.ServiceRegistrar, srv APIServer) {
-	s.RegisterService(&API_ServiceDesc, srv)
+func (x *aPICheckoutPullRequestServer) Send(m *CloneRepoResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _API_DaemonInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DaemonInfoRequest)
+func _API_SuggestRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestReposRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).DaemonInfo(ctx, in)
+		return srv.(APIServer).SuggestRepos(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_DaemonInfo_FullMethodName,
+		FullMethod: API_SuggestRepos_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DaemonInfo(ctx, req.(*DaemonInfoRequest))
+		return srv.(APIServer).SuggestRepos(ctx, req.(*SuggestReposRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_ListSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListSourcesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).ListSources(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: API_ListSources_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ListSources(ctx, req.(*ListSourcesRequest))
+func _API_FetchRepos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FetchReposRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(APIServer).FetchRepos(m, &aPIFetchReposServer{stream})
 }
 
-func _API_SignIn_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SignInRequest)
+type API_FetchReposServer interface {
+	Send(*FetchReposResponse) error
+	grpc.ServerStream
+}
+
+type aPIFetchReposServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIFetchReposServer) Send(m *FetchReposResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_PullRepos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PullReposRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(APIServer).SignIn(m, &aPISignInServer{stream})
+	return srv.(APIServer).PullRepos(m, &aPIPullReposServer{stream})
 }
 
-type API_SignInServer interface {
-	Send(*SignInResponse) error
+type API_PullReposServer interface {
+	Send(*PullReposResponse) error
 	grpc.ServerStream
 }
 
-type aPISignInServer struct {
+type aPIPullReposServer struct {
 	grpc.ServerStream
 }
 
-func (x *aPISignInServer) Send(m *SignInResponse) error {
+func (x *aPIPullReposServer) Send(m *PullReposResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _API_SignOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignOutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func _API_RemoveRepo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RemoveRepoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).SignOut(ctx, in)
+	return srv.(APIServer).RemoveRepo(m, &aPIRemoveRepoServer{stream})
+}
+
+type API_RemoveRepoServer interface {
+	Send(*RemoveRepoResponse) error
+	grpc.ServerStream
+}
+
+type aPIRemoveRepoServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIRemoveRepoServer) Send(m *RemoveRepoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_ArchiveRepos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ArchiveReposRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: API_SignOut_FullMethodName,
+	return srv.(APIServer).ArchiveRepos(m, &aPIArchiveReposServer{stream})
+}
+
+type API_ArchiveReposServer interface {
+	Send(*ArchiveReposResponse) error
+	grpc.ServerStream
+}
+
+type aPIArchiveReposServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIArchiveReposServer) Send(m *ArchiveReposResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_UnarchiveRepo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UnarchiveRepoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).SignOut(ctx, req.(*SignOutRequest))
+	return srv.(APIServer).UnarchiveRepo(m, &aPIUnarchiveRepoServer{stream})
+}
+
+type API_UnarchiveRepoServer interface {
+	Send(*UnarchiveRepoResponse) error
+	grpc.ServerStream
+}
+
+type aPIUnarchiveRepoServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIUnarchiveRepoServer) Send(m *UnarchiveRepoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_AdoptRepo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AdoptRepoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(APIServer).AdoptRepo(m, &aPIAdoptRepoServer{stream})
 }
 
-func _API_ResolveRepo_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ResolveRepoRequest)
+type API_AdoptRepoServer interface {
+	Send(*AdoptRepoResponse) error
+	grpc.ServerStream
+}
+
+type aPIAdoptRepoServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIAdoptRepoServer) Send(m *AdoptRepoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_RelocateRepos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RelocateReposRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(APIServer).ResolveRepo(m, &aPIResolveRepoServer{stream})
+	return srv.(APIServer).RelocateRepos(m, &aPIRelocateReposServer{stream})
 }
 
-type API_ResolveRepoServer interface {
-	Send(*ResolveRepoResponse) error
+type API_RelocateReposServer interface {
+	Send(*RelocateReposResponse) error
 	grpc.ServerStream
 }
 
-type aPIResolveRepoServer struct {
+type aPIRelocateReposServer struct {
 	grpc.ServerStream
 }
 
-func (x *aPIResolveRepoServer) Send(m *ResolveRepoResponse) error {
+func (x *aPIRelocateReposServer) Send(m *RelocateReposResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _API_CloneRepo_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(CloneRepoRequest)
+func _API_ListLocalRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLocalReposRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListLocalRepos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ListLocalRepos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListLocalRepos(ctx, req.(*ListLocalReposRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DescribeRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DescribeRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DescribeRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DescribeRepo(ctx, req.(*DescribeRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_AddWorktree_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AddWorktreeRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(APIServer).CloneRepo(m, &aPICloneRepoServer{stream})
+	return srv.(APIServer).AddWorktree(m, &aPIAddWorktreeServer{stream})
 }
 
-type API_CloneRepoServer interface {
-	Send(*CloneRepoResponse) error
+type API_AddWorktreeServer interface {
+	Send(*AddWorktreeResponse) error
 	grpc.ServerStream
 }
 
-type aPICloneRepoServer struct {
+type aPIAddWorktreeServer struct {
 	grpc.ServerStream
 }
 
-func (x *aPICloneRepoServer) Send(m *CloneRepoResponse) error {
+func (x *aPIAddWorktreeServer) Send(m *AddWorktreeResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _API_SuggestRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SuggestReposRequest)
+func _API_ListWorktrees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorktreesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).SuggestRepos(ctx, in)
+		return srv.(APIServer).ListWorktrees(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: API_SuggestRepos_FullMethodName,
+		FullMethod: API_ListWorktrees_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).SuggestRepos(ctx, req.(*SuggestReposRequest))
+		return srv.(APIServer).ListWorktrees(ctx, req.(*ListWorktreesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_RemoveWorktree_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RemoveWorktreeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).RemoveWorktree(m, &aPIRemoveWorktreeServer{stream})
+}
+
+type API_RemoveWorktreeServer interface {
+	Send(*RemoveWorktreeResponse) error
+	grpc.ServerStream
+}
+
+type aPIRemoveWorktreeServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIRemoveWorktreeServer) Send(m *RemoveWorktreeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // API_ServiceDesc is the grpc.ServiceDesc for API service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -407,6 +1327,18 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SuggestRepos",
 			Handler:    _API_SuggestRepos_Handler,
 		},
+		{
+			MethodName: "ListLocalRepos",
+			Handler:    _API_ListLocalRepos_Handler,
+		},
+		{
+			MethodName: "DescribeRepo",
+			Handler:    _API_DescribeRepo_Handler,
+		},
+		{
+			MethodName: "ListWorktrees",
+			Handler:    _API_ListWorktrees_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -424,6 +1356,71 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _API_CloneRepo_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "CloneRepos",
+			Handler:       _API_CloneRepos_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ForkRepo",
+			Handler:       _API_ForkRepo_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CreateRepo",
+			Handler:       _API_CreateRepo_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CheckoutPullRequest",
+			Handler:       _API_CheckoutPullRequest_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FetchRepos",
+			Handler:       _API_FetchRepos_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PullRepos",
+			Handler:       _API_PullRepos_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RemoveRepo",
+			Handler:       _API_RemoveRepo_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ArchiveRepos",
+			Handler:       _API_ArchiveRepos_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UnarchiveRepo",
+			Handler:       _API_UnarchiveRepo_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AdoptRepo",
+			Handler:       _API_AdoptRepo_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RelocateRepos",
+			Handler:       _API_RelocateRepos_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AddWorktree",
+			Handler:       _API_AddWorktree_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RemoveWorktree",
+			Handler:       _API_RemoveWorktree_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "github.com/gritcli/grit/api/api.proto",
 }
@@ -0,0 +1,24 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/cli/internal/browser"
+)
+
+func init() {
+	imbue.With0(
+		catalog,
+		func(
+			ctx imbue.Context,
+		) (browser.Opener, error) {
+			command := os.Getenv("GRIT_BROWSER")
+			if command == "" {
+				command = os.Getenv("BROWSER")
+			}
+
+			return browser.NewOpener(command), nil
+		},
+	)
+}
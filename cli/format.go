@@ -0,0 +1,23 @@
+package cli
+
+import (
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/cli/internal/flags"
+	"github.com/gritcli/grit/cli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	imbue.With1(
+		catalog,
+		func(
+			ctx imbue.Context,
+			cmd *cobra.Command,
+		) (*render.Formatter, error) {
+			return render.NewFormatter(
+				flags.Format(cmd),
+				cmd.OutOrStdout(),
+			)
+		},
+	)
+}
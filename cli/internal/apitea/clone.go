@@ -0,0 +1,82 @@
+package apitea
+
+import (
+	"io"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gritcli/grit/api"
+)
+
+// CloneProgressReceived is a tea.Msg that indicates progress information has
+// been received from a CloneRepo() API operation.
+type CloneProgressReceived struct {
+	Progress *api.CloneProgress
+	Tag      string
+}
+
+// RepoCloned is a tea.Msg that indicates a CloneRepo() API operation has
+// produced a local clone.
+type RepoCloned struct {
+	Repo *api.LocalRepo
+	Tag  string
+}
+
+// CloneComplete is a tea.Msg that indicates a CloneRepo() API operation has
+// completed successfully.
+type CloneComplete struct {
+	Tag string
+}
+
+// CloneFailed is a tea.Msg that indicates a CloneRepo() API operation has
+// failed.
+type CloneFailed struct {
+	Error error
+	Tag   string
+}
+
+// WaitForCloneResponse waits for the next response of a CloneRepo() API
+// operation and dispatches the appropriate message.
+func WaitForCloneResponse(
+	responses api.API_CloneRepoClient,
+	tag string,
+) tea.Cmd {
+	return func() tea.Msg {
+		res, err := responses.Recv()
+
+		if err == io.EOF {
+			return CloneComplete{
+				Tag: tag,
+			}
+		}
+
+		if err != nil {
+			return CloneFailed{
+				Error: err,
+				Tag:   tag,
+			}
+		}
+
+		if out := res.GetOutput(); out != nil {
+			return OutputReceived{
+				Output: out,
+				Tag:    tag,
+			}
+		}
+
+		if p := res.GetProgress(); p != nil {
+			return CloneProgressReceived{
+				Progress: p,
+				Tag:      tag,
+			}
+		}
+
+		if repo := res.GetLocalRepo(); repo != nil {
+			return RepoCloned{
+				Repo: repo,
+				Tag:  tag,
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,2 @@
+// Package browser contains utilities for opening URLs in a web browser.
+package browser
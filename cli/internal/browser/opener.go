@@ -0,0 +1,43 @@
+package browser
+
+import (
+	"context"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Opener is a function that opens a URL in a web browser.
+type Opener func(ctx context.Context, url string) error
+
+// NewOpener returns an Opener that opens URLs by running the given command
+// with the URL as its final argument.
+//
+// The command is split into arguments on whitespace. If it is empty, the
+// platform's default command for opening URLs is used.
+func NewOpener(command string) Opener {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		args = defaultCommand()
+	}
+
+	return func(ctx context.Context, url string) error {
+		return exec.CommandContext(
+			ctx,
+			args[0],
+			append(args[1:], url)...,
+		).Run()
+	}
+}
+
+// defaultCommand returns the platform's default command for opening URLs.
+func defaultCommand() []string {
+	switch runtime.GOOS {
+	case "darwin":
+		return []string{"open"}
+	case "windows":
+		return []string{"rundll32", "url.dll,FileProtocolHandler"}
+	default:
+		return []string{"xdg-open"}
+	}
+}
@@ -0,0 +1,45 @@
+package browser_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/gritcli/grit/cli/internal/browser"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func NewOpener()", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(func() {
+			os.RemoveAll(dir)
+		})
+	})
+
+	It("runs the command with the URL as the final argument", func() {
+		// Use "touch" as the opener, and a file path as the URL, so that the
+		// existence of the files confirms how the command was run.
+		arg := filepath.Join(dir, "arg")
+		url := filepath.Join(dir, "url")
+		open := NewOpener("touch " + arg)
+
+		err := open(context.Background(), url)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(arg).To(BeAnExistingFile())
+		Expect(url).To(BeAnExistingFile())
+	})
+
+	It("returns an error if the command fails", func() {
+		open := NewOpener("false")
+
+		err := open(context.Background(), "https://example.org")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,3 @@
+// Package cloneprogress displays the progress of clone operations performed by
+// the Grit daemon.
+package cloneprogress
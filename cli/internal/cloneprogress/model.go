@@ -0,0 +1,102 @@
+package cloneprogress
+
+import (
+	"errors"
+
+	"github.com/charmbracelet/bubbles/progress"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/apitea"
+	"github.com/gritcli/grit/cli/internal/style"
+)
+
+// model is the model used to display the progress of a clone operation.
+type model struct {
+	Repo  *api.LocalRepo
+	Error error
+
+	responses api.API_CloneRepoClient
+	bar       progress.Model
+	output    []string
+	progress  *api.CloneProgress
+	done      bool
+}
+
+// newModel returns a new model for displaying the progress of a clone
+// operation.
+func newModel(responses api.API_CloneRepoClient) model {
+	return model{
+		responses: responses,
+		bar:       progress.New(progress.WithDefaultGradient()),
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return apitea.WaitForCloneResponse(m.responses, "")
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "esc":
+			m.Error = errors.New("clone cancelled")
+			return m.quit()
+		}
+
+	case tea.WindowSizeMsg:
+		m.bar.Width = msg.Width / 2
+
+	case apitea.OutputReceived:
+		m.output = append(m.output, msg.Output.GetMessage())
+		return m, apitea.WaitForCloneResponse(m.responses, "")
+
+	case apitea.CloneProgressReceived:
+		m.progress = msg.Progress
+		return m, apitea.WaitForCloneResponse(m.responses, "")
+
+	case apitea.RepoCloned:
+		m.Repo = msg.Repo
+		return m, apitea.WaitForCloneResponse(m.responses, "")
+
+	case apitea.CloneComplete:
+		return m.quit()
+
+	case apitea.CloneFailed:
+		m.Error = msg.Error
+		return m.quit()
+	}
+
+	return m, nil
+}
+
+// quit stops the program once the clone operation has finished.
+func (m model) quit() (tea.Model, tea.Cmd) {
+	m.done = true
+	return m, tea.Quit
+}
+
+func (m model) View() string {
+	view := ""
+
+	for _, o := range m.output {
+		view += o + "\n"
+	}
+
+	// Once the clone operation has finished the progress bar is removed from
+	// the view, leaving only the log output in the terminal.
+	if m.done || m.progress == nil {
+		return view
+	}
+
+	percent := 0.0
+	if t := m.progress.GetTotal(); t != 0 {
+		percent = float64(m.progress.GetCurrent()) / float64(t)
+	}
+
+	view += Render(m.progress) + "\n"
+	view += m.bar.ViewAs(percent) + "\n"
+	view += style.Instructions.Render("press [esc] to cancel") + "\n"
+
+	return view
+}
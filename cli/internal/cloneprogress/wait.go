@@ -0,0 +1,101 @@
+package cloneprogress
+
+import (
+	"fmt"
+	"io"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dustin/go-humanize"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+// Wait waits for a clone operation to complete and returns the local clone it
+// produced.
+//
+// If the command is interactive the progress of the operation is displayed as
+// a progress bar, otherwise it is printed as plain text.
+func Wait(
+	cmd *cobra.Command,
+	responses api.API_CloneRepoClient,
+) (*api.LocalRepo, error) {
+	if flags.IsInteractive(cmd) {
+		return waitInteractive(cmd, responses)
+	}
+
+	return waitNonInteractive(cmd, responses)
+}
+
+// waitInteractive waits for a clone operation to complete, displaying its
+// progress as a progress bar.
+func waitInteractive(
+	cmd *cobra.Command,
+	responses api.API_CloneRepoClient,
+) (*api.LocalRepo, error) {
+	p := tea.NewProgram(
+		newModel(responses),
+		tea.WithInput(cmd.InOrStdin()),
+		tea.WithOutput(cmd.OutOrStdout()),
+	)
+
+	x, err := p.StartReturningModel()
+	if err != nil {
+		return nil, err
+	}
+
+	m := x.(model)
+	return m.Repo, m.Error
+}
+
+// waitNonInteractive waits for a clone operation to complete, printing its
+// progress as plain text.
+func waitNonInteractive(
+	cmd *cobra.Command,
+	responses api.API_CloneRepoClient,
+) (*api.LocalRepo, error) {
+	var local *api.LocalRepo
+
+	for {
+		res, err := responses.Recv()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if out := res.GetOutput(); out != nil {
+			cmd.Println(out.Message)
+		} else if p := res.GetProgress(); p != nil {
+			cmd.Println(Render(p))
+		} else if r := res.GetLocalRepo(); r != nil {
+			local = r
+		}
+	}
+
+	return local, nil
+}
+
+// Render returns a human-readable description of the progress of a clone
+// operation.
+func Render(p *api.CloneProgress) string {
+	s := fmt.Sprintf("%s: %d", p.GetPhase(), p.GetCurrent())
+
+	if t := p.GetTotal(); t != 0 {
+		s = fmt.Sprintf(
+			"%s: %d%% (%d/%d)",
+			p.GetPhase(),
+			p.GetCurrent()*100/t,
+			p.GetCurrent(),
+			t,
+		)
+	}
+
+	if b := p.GetBytes(); b != 0 {
+		s += ", " + humanize.IBytes(b)
+	}
+
+	return s
+}
@@ -0,0 +1,168 @@
+package adopt
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/render"
+	"github.com/gritcli/grit/cli/internal/shell"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+)
+
+//go:embed help.txt
+var helpText string
+
+// Command returns the "adopt" command.
+func Command(con *imbue.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "adopt [--symlink] <dir>...",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.MinimumNArgs(1),
+		Short:                 "Move existing clones into Grit's clone directory",
+		Long:                  helpText,
+		ValidArgsFunction: func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+			return nil, cobra.ShellCompDirectiveFilterDirs
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			symlink, err := cmd.Flags().GetBool("symlink")
+			if err != nil {
+				panic(err)
+			}
+
+			cmd.SilenceUsage = true
+
+			return imbue.Invoke4(
+				cmd.Context(),
+				con,
+				func(
+					ctx context.Context,
+					client api.APIClient,
+					options *api.ClientOptions,
+					exec shell.Executor,
+					format *render.Formatter,
+				) error {
+					if format != nil {
+						cmd.SetOut(cmd.ErrOrStderr())
+					}
+
+					cwd, _ := os.Getwd()
+					newCWD := ""
+					failures := 0
+					var adopted []*api.LocalRepo
+
+					for _, arg := range args {
+						dir, err := filepath.Abs(arg)
+						if err != nil {
+							return err
+						}
+
+						local, err := adopt(ctx, cmd, client, options, dir, symlink)
+						if err != nil {
+							if s, ok := status.FromError(err); ok {
+								err = errors.New(s.Message())
+							}
+
+							cmd.PrintErrf("unable to adopt %s: %s\n", render.RelPath(dir), err)
+							failures++
+							continue
+						}
+
+						adopted = append(adopted, local)
+						newDir := local.GetAbsoluteCloneDir()
+
+						if symlink {
+							cmd.Printf("linked %s to %s\n", render.AbsPath(newDir), render.RelPath(dir))
+						} else if newDir != dir {
+							cmd.Printf("moved %s to %s\n", render.RelPath(dir), render.AbsPath(newDir))
+
+							// If the current working directory was within
+							// the moved clone, follow it to its new location.
+							if rel, err := filepath.Rel(dir, cwd); err == nil && filepath.IsLocal(rel) {
+								newCWD = filepath.Join(newDir, rel)
+							}
+						}
+					}
+
+					if format != nil {
+						if err := render.WriteList(format, adopted); err != nil {
+							return err
+						}
+					}
+
+					if failures != 0 {
+						return fmt.Errorf("unable to adopt %d clone(s)", failures)
+					}
+
+					if newCWD != "" {
+						return exec("cd", newCWD)
+					}
+
+					return nil
+				},
+			)
+		},
+	}
+
+	cmd.Flags().Bool(
+		"symlink",
+		false,
+		"leave the clone in place and link to it from the clone directory",
+	)
+
+	return cmd
+}
+
+// adopt adopts the local clone in the given directory.
+func adopt(
+	ctx context.Context,
+	cmd *cobra.Command,
+	client api.APIClient,
+	options *api.ClientOptions,
+	dir string,
+	symlink bool,
+) (*api.LocalRepo, error) {
+	responses, err := client.AdoptRepo(
+		ctx,
+		&api.AdoptRepoRequest{
+			ClientOptions: options,
+			AbsoluteDir:   dir,
+			Symlink:       symlink,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var local *api.LocalRepo
+
+	for {
+		res, err := responses.Recv()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if out := res.GetOutput(); out != nil {
+			cmd.Println(out.Message)
+		} else if r := res.GetLocalRepo(); r != nil {
+			local = r
+		}
+	}
+
+	if local == nil {
+		return nil, errors.New("server did not provide information about the local clone")
+	}
+
+	return local, nil
+}
@@ -0,0 +1,2 @@
+// Package adopt contains the implementation of the "adopt" command.
+package adopt
@@ -0,0 +1,161 @@
+package archive
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/flags"
+	"github.com/gritcli/grit/cli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+//go:embed help.txt
+var helpText string
+
+// Command returns the "archive" command.
+func Command(con *imbue.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "archive [--from-source <source>] [--match <pattern>] [--inactive-for <period>] [--dry-run] [--jobs <n>]",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+		Short:                 "Archive local clones that have not been used recently",
+		Long:                  helpText,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sources, pattern := flags.LocalRepoFilter(cmd)
+
+			jobs, err := flags.Concurrency(cmd)
+			if err != nil {
+				return err
+			}
+
+			inactiveFor, err := cmd.Flags().GetString("inactive-for")
+			if err != nil {
+				panic(err)
+			}
+
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				panic(err)
+			}
+
+			cmd.SilenceUsage = true
+
+			return imbue.Invoke3(
+				cmd.Context(),
+				con,
+				func(
+					ctx context.Context,
+					client api.APIClient,
+					options *api.ClientOptions,
+					format *render.Formatter,
+				) error {
+					if format != nil {
+						cmd.SetOut(cmd.ErrOrStderr())
+					}
+
+					req := &api.ArchiveReposRequest{
+						ClientOptions: options,
+						SourceFilter:  sources,
+						NamePattern:   pattern,
+						Concurrency:   jobs,
+						InactiveFor:   inactiveFor,
+						DryRun:        dryRun,
+					}
+
+					responses, err := client.ArchiveRepos(ctx, req)
+					if err != nil {
+						return err
+					}
+
+					var archived, skipped int
+					var failures []*api.ArchiveRepoResult
+					var results []*api.ArchiveRepoResult
+
+					for {
+						res, err := responses.Recv()
+						if err == io.EOF {
+							break
+						}
+
+						if err != nil {
+							return err
+						}
+
+						if out := res.GetOutput(); out != nil {
+							cmd.Println(out.Message)
+							continue
+						}
+
+						r := res.GetResult()
+						results = append(results, r)
+
+						name := r.GetLocalRepo().GetRemoteRepo().GetName()
+
+						switch {
+						case r.GetError() != "":
+							failures = append(failures, r)
+						case r.GetSkipReason() != "":
+							skipped++
+							cmd.Printf("skipped %s: %s\n", name, r.GetSkipReason())
+						case r.GetArchiveFile() != "":
+							archived++
+							if dryRun {
+								cmd.Printf("would archive %s to %s\n", name, render.AbsPath(r.GetArchiveFile()))
+							} else {
+								cmd.Printf("archived %s to %s\n", name, render.AbsPath(r.GetArchiveFile()))
+							}
+						}
+					}
+
+					if format != nil {
+						if err := render.WriteList(format, results); err != nil {
+							return err
+						}
+					}
+
+					for _, r := range failures {
+						cmd.PrintErrf(
+							"%s (%s): %s\n",
+							r.GetLocalRepo().GetRemoteRepo().GetName(),
+							render.RelPath(r.GetLocalRepo().GetAbsoluteCloneDir()),
+							r.GetError(),
+						)
+					}
+
+					if len(failures) != 0 {
+						return fmt.Errorf("unable to archive %d clone(s)", len(failures))
+					}
+
+					if dryRun {
+						cmd.Printf("%d clone(s) would be archived, %d skipped\n", archived, skipped)
+					} else {
+						cmd.Printf("archived %d clone(s), skipped %d\n", archived, skipped)
+					}
+
+					return nil
+				},
+			)
+		},
+	}
+
+	flags.SetupLocalRepoFilter(cmd, con)
+	flags.SetupConcurrency(cmd)
+
+	cmd.Flags().String(
+		"inactive-for",
+		"",
+		"archive clones that have not been used for this `period`, such as \"90d\", instead of the configured period",
+	)
+
+	cmd.Flags().Bool(
+		"dry-run",
+		false,
+		"list the clones that would be archived without archiving them",
+	)
+
+	return cmd
+}
@@ -0,0 +1,2 @@
+// Package archive contains the implementation of the "archive" command.
+package archive
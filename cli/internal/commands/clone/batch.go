@@ -0,0 +1,351 @@
+package clone
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/render"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+// cloneMany clones multiple repositories in parallel and prints a summary of
+// the result for each repository.
+//
+// Unlike cloneOne() it never prompts the user to choose between ambiguous
+// matches; a query that does not resolve to exactly one repository is
+// reported as a failure.
+func cloneMany(
+	cmd *cobra.Command,
+	con *imbue.Container,
+	queries []string,
+	source string,
+	noResolve bool,
+	jobs uint32,
+	opts *api.CloneOptions,
+) error {
+	return imbue.Invoke3(
+		cmd.Context(),
+		con,
+		func(
+			ctx context.Context,
+			client api.APIClient,
+			options *api.ClientOptions,
+			format *render.Formatter,
+		) error {
+			if format != nil {
+				cmd.SetOut(cmd.ErrOrStderr())
+			}
+
+			targets, names, failures := resolveTargets(
+				ctx,
+				cmd,
+				client,
+				options,
+				queries,
+				source,
+				noResolve,
+				jobs,
+			)
+
+			// Queries that resolve to the same repository are counted once.
+			total := len(targets) + len(failures)
+
+			results, err := cloneTargets(ctx, cmd, client, options, targets, jobs, opts)
+			if err != nil {
+				return err
+			}
+
+			if format != nil {
+				if err := render.WriteList(format, results); err != nil {
+					return err
+				}
+			}
+
+			var cloned, skipped int
+
+			for _, r := range results {
+				name := r.GetLocalRepo().GetRemoteRepo().GetName()
+				dir := render.RelPath(r.GetLocalRepo().GetAbsoluteCloneDir())
+
+				switch {
+				case r.GetError() != "":
+					failures = append(failures, fmt.Sprintf(
+						"%s: %s",
+						names[keyOf(r.GetTarget())],
+						r.GetError(),
+					))
+				case r.GetAlreadyCloned():
+					skipped++
+					cmd.Printf("skipped %s, already cloned to %s\n", name, dir)
+				default:
+					cloned++
+					cmd.Printf("cloned %s to %s\n", name, dir)
+				}
+			}
+
+			for _, f := range failures {
+				cmd.PrintErrln(f)
+			}
+
+			if len(failures) != 0 {
+				return fmt.Errorf(
+					"unable to clone %d of %d repositories",
+					len(failures),
+					total,
+				)
+			}
+
+			cmd.Printf("cloned %d repositories, skipped %d\n", cloned, skipped)
+
+			return nil
+		},
+	)
+}
+
+// targetKey uniquely identifies the repository referred to by a clone target.
+type targetKey struct{ source, id string }
+
+// keyOf returns the key that identifies the repository referred to by t.
+func keyOf(t *api.CloneTarget) targetKey {
+	return targetKey{t.GetSource(), t.GetRepoId()}
+}
+
+// resolveTargets resolves each query to a single repository, at most n at a
+// time.
+//
+// It returns the distinct repositories to clone, in the same order as the
+// queries, the name to use when reporting on each of those repositories, and a
+// description of each query that could not be resolved.
+//
+// The name is the repository's name if the query was resolved, or the query
+// itself otherwise.
+func resolveTargets(
+	ctx context.Context,
+	cmd *cobra.Command,
+	client api.APIClient,
+	options *api.ClientOptions,
+	queries []string,
+	source string,
+	noResolve bool,
+	n uint32,
+) ([]*api.CloneTarget, map[targetKey]string, []string) {
+	targets := make([]*api.CloneTarget, len(queries))
+	names := make([]string, len(queries))
+	errs := make([]error, len(queries))
+
+	copy(names, queries)
+
+	if noResolve {
+		for i, id := range queries {
+			targets[i] = &api.CloneTarget{
+				Source: source,
+				RepoId: id,
+			}
+		}
+	} else {
+		g := &errgroup.Group{}
+		g.SetLimit(int(n))
+
+		for i, q := range queries {
+			i, q := i, q // capture loop variables
+
+			g.Go(func() error {
+				repo, err := resolveOne(ctx, cmd, client, options, q, source)
+				if err != nil {
+					errs[i] = err
+				} else {
+					targets[i] = &api.CloneTarget{
+						Source: repo.GetSource(),
+						RepoId: repo.GetId(),
+					}
+					names[i] = repo.GetName()
+				}
+
+				return nil
+			})
+		}
+
+		g.Wait()
+	}
+
+	// Multiple queries may resolve to the same repository, in which case it
+	// is only cloned once.
+	targetNames := map[targetKey]string{}
+
+	var resolved []*api.CloneTarget
+	var failures []string
+
+	for i, t := range targets {
+		if t == nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", queries[i], errs[i]))
+			continue
+		}
+
+		k := keyOf(t)
+		if _, ok := targetNames[k]; !ok {
+			targetNames[k] = names[i]
+			resolved = append(resolved, t)
+		}
+	}
+
+	return resolved, targetNames, failures
+}
+
+// resolveOne resolves a query to a single remote repository without allowing
+// user interaction.
+func resolveOne(
+	ctx context.Context,
+	cmd *cobra.Command,
+	client api.APIClient,
+	options *api.ClientOptions,
+	query string,
+	source string,
+) (*api.RemoteRepo, error) {
+	req := &api.ResolveRepoRequest{
+		ClientOptions: options,
+		Query:         query,
+		LocalityFilter: []api.Locality{
+			api.Locality_REMOTE,
+		},
+	}
+
+	if source != "" {
+		req.SourceFilter = []string{source}
+	}
+
+	responses, err := client.ResolveRepo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var repos []*api.RemoteRepo
+
+	for {
+		res, err := responses.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if out := res.GetOutput(); out != nil {
+			cmd.Println(out.GetMessage())
+		} else if r := res.GetRemoteRepo(); r != nil {
+			repos = append(repos, r)
+		}
+	}
+
+	switch len(repos) {
+	case 1:
+		return repos[0], nil
+	case 0:
+		return nil, errors.New("no matching repositories")
+	default:
+		var names []string
+		for _, r := range repos {
+			names = append(names, fmt.Sprintf("%s (%s)", r.GetName(), r.GetSource()))
+		}
+
+		return nil, fmt.Errorf(
+			"multiple matching repositories: %s",
+			strings.Join(names, ", "),
+		)
+	}
+}
+
+// cloneTargets clones the given repositories, at most n at a time.
+//
+// It returns the results in the same order as targets.
+func cloneTargets(
+	ctx context.Context,
+	cmd *cobra.Command,
+	client api.APIClient,
+	options *api.ClientOptions,
+	targets []*api.CloneTarget,
+	n uint32,
+	opts *api.CloneOptions,
+) ([]*api.CloneRepoResult, error) {
+	if len(targets) == 0 {
+		return nil, nil
+	}
+
+	responses, err := client.CloneRepos(ctx, &api.CloneReposRequest{
+		ClientOptions: options,
+		Targets:       targets,
+		Concurrency:   n,
+		CloneOptions:  opts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := map[targetKey]*api.CloneRepoResult{}
+
+	for {
+		res, err := responses.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if out := res.GetOutput(); out != nil {
+			cmd.Println(out.GetMessage())
+		} else if r := res.GetResult(); r != nil {
+			results[keyOf(r.GetTarget())] = r
+		}
+	}
+
+	var ordered []*api.CloneRepoResult
+	for _, t := range targets {
+		if r, ok := results[keyOf(t)]; ok {
+			ordered = append(ordered, r)
+		}
+	}
+
+	return ordered, nil
+}
+
+// readQueries reads repository queries from a file, one per line.
+//
+// Blank lines and lines beginning with # are ignored. If file is "-" the
+// queries are read from the command's standard input.
+func readQueries(cmd *cobra.Command, file string) ([]string, error) {
+	var r io.Reader = cmd.InOrStdin()
+
+	if file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read repository names: %w", err)
+		}
+		defer f.Close()
+
+		r = f
+	}
+
+	var queries []string
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			queries = append(queries, line)
+		}
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read repository names: %w", err)
+	}
+
+	return queries, nil
+}
@@ -3,9 +3,9 @@ package clone
 import (
 	"context"
 	"errors"
-	"io"
 
 	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/cloneprogress"
 	"github.com/spf13/cobra"
 )
 
@@ -16,40 +16,31 @@ func clone(
 	client api.APIClient,
 	options *api.ClientOptions,
 	id, source string,
-) (string, error) {
+	opts *api.CloneOptions,
+) (*api.LocalRepo, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	req := &api.CloneRepoRequest{
 		ClientOptions: options,
 		Source:        source,
 		RepoId:        id,
+		CloneOptions:  opts,
 	}
 
 	responses, err := client.CloneRepo(ctx, req)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	var local *api.LocalRepo
-
-	for {
-		res, err := responses.Recv()
-		if err == io.EOF {
-			break
-		}
-
-		if err != nil {
-			return "", err
-		}
-
-		if out := res.GetOutput(); out != nil {
-			cmd.Println(out.Message)
-		} else if r := res.GetLocalRepo(); r != nil {
-			local = r
-		}
+	local, err := cloneprogress.Wait(cmd, responses)
+	if err != nil {
+		return nil, err
 	}
 
 	if local == nil {
-		return "", errors.New("server did not provide information about the local clone")
+		return nil, errors.New("server did not provide information about the local clone")
 	}
 
-	return local.GetAbsoluteCloneDir(), nil
+	return local, nil
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/gritcli/grit/cli/internal/render"
 	"github.com/gritcli/grit/cli/internal/shell"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
 )
 
 //go:embed help.txt
@@ -20,78 +21,177 @@ var helpText string
 // Command returns the "clone" command.
 func Command(con *imbue.Container) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:                   "clone [--from-source <source> [--no-resolve]] <repo>",
+		Use:                   "clone [--from-source <source> [--no-resolve]] [--depth <n>] [--single-branch] [--file <file>] [--jobs <n>] <repo>...",
 		DisableFlagsInUseLine: true,
-		Args:                  cobra.ExactArgs(1),
-		Short:                 "Clone a remote repository",
+		Args:                  cobra.ArbitraryArgs,
+		Short:                 "Clone remote repositories",
 		Long:                  helpText,
-		ValidArgsFunction: completion.Positional(
-			completion.RepoName(con, api.Locality_REMOTE),
-		),
+		ValidArgsFunction:     completion.RepoName(con, api.Locality_REMOTE),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			queryOrID := args[0]
+			source, noResolve, err := flags.FromSource(cmd)
+			if err != nil {
+				return err
+			}
 
-			if queryOrID == "" {
-				return errors.New("<repo> argument must not be empty")
+			file, err := cmd.Flags().GetString("file")
+			if err != nil {
+				panic(err)
 			}
 
-			source, noResolve, err := flags.FromSource(cmd)
+			opts := cloneOptions(cmd)
+
+			if len(args) == 1 && file == "" {
+				return cloneOne(cmd, con, args[0], source, noResolve, opts)
+			}
+
+			queries := args
+			if file != "" {
+				q, err := readQueries(cmd, file)
+				if err != nil {
+					return err
+				}
+				queries = append(queries, q...)
+			}
+
+			if len(queries) == 0 {
+				return errors.New("at least one <repo> argument is required")
+			}
+
+			jobs, err := flags.Concurrency(cmd)
 			if err != nil {
 				return err
 			}
 
 			cmd.SilenceUsage = true
 
-			return imbue.Invoke3(
-				cmd.Context(),
-				con,
-				func(
-					ctx context.Context,
-					client api.APIClient,
-					options *api.ClientOptions,
-					exec shell.Executor,
-				) error {
-					if !noResolve {
-						repo, ok, err := resolve(
-							ctx,
-							cmd,
-							client,
-							options,
-							queryOrID,
-							source,
-						)
-						if err != nil {
-							return err
-						}
-						if !ok {
-							return nil
-						}
-
-						queryOrID = repo.GetId()
-						source = repo.GetSource()
-					}
-
-					dir, err := clone(
-						ctx,
-						cmd,
-						client,
-						options,
-						queryOrID,
-						source,
-					)
-					if err != nil {
-						return err
-					}
-
-					cmd.Println(render.RelPath(dir))
-
-					return exec("cd", dir)
-				},
-			)
+			return cloneMany(cmd, con, queries, source, noResolve, jobs, opts)
 		},
 	}
 
 	flags.SetupFromSource(cmd, con)
+	flags.SetupConcurrency(cmd)
+
+	cmd.Flags().String(
+		"file",
+		"",
+		"read repository names from a `file`, one per line (use - for stdin)",
+	)
+
+	cmd.Flags().Uint(
+		"depth",
+		0,
+		"limit the history of the clone to `n` commits",
+	)
+
+	cmd.Flags().Bool(
+		"single-branch",
+		false,
+		"only clone the repository's default branch",
+	)
 
 	return cmd
 }
+
+// cloneOptions returns the clone options requested via the command's flags.
+//
+// Flags that are not given explicitly are omitted, so that the source's
+// configuration is used instead.
+func cloneOptions(cmd *cobra.Command) *api.CloneOptions {
+	opts := &api.CloneOptions{}
+
+	if cmd.Flags().Changed("depth") {
+		depth, err := cmd.Flags().GetUint("depth")
+		if err != nil {
+			panic(err)
+		}
+
+		opts.Depth = proto.Uint32(uint32(depth))
+	}
+
+	if cmd.Flags().Changed("single-branch") {
+		singleBranch, err := cmd.Flags().GetBool("single-branch")
+		if err != nil {
+			panic(err)
+		}
+
+		opts.SingleBranch = proto.Bool(singleBranch)
+	}
+
+	return opts
+}
+
+// cloneOne clones a single repository then changes the current working
+// directory to the clone.
+func cloneOne(
+	cmd *cobra.Command,
+	con *imbue.Container,
+	queryOrID, source string,
+	noResolve bool,
+	opts *api.CloneOptions,
+) error {
+	if queryOrID == "" {
+		return errors.New("<repo> argument must not be empty")
+	}
+
+	cmd.SilenceUsage = true
+
+	return imbue.Invoke4(
+		cmd.Context(),
+		con,
+		func(
+			ctx context.Context,
+			client api.APIClient,
+			options *api.ClientOptions,
+			exec shell.Executor,
+			format *render.Formatter,
+		) error {
+			if format != nil {
+				cmd.SetOut(cmd.ErrOrStderr())
+			}
+
+			if !noResolve {
+				repo, ok, err := resolve(
+					ctx,
+					cmd,
+					client,
+					options,
+					queryOrID,
+					source,
+				)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return nil
+				}
+
+				queryOrID = repo.GetId()
+				source = repo.GetSource()
+			}
+
+			local, err := clone(
+				ctx,
+				cmd,
+				client,
+				options,
+				queryOrID,
+				source,
+				opts,
+			)
+			if err != nil {
+				return err
+			}
+
+			dir := local.GetAbsoluteCloneDir()
+			cmd.Println(render.RelPath(dir))
+
+			if format != nil {
+				if err := format.Write(local); err != nil {
+					return err
+				}
+			}
+
+			return exec("cd", dir)
+		},
+	)
+}
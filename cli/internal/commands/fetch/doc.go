@@ -0,0 +1,2 @@
+// Package fetch contains the implementation of the "fetch" command.
+package fetch
@@ -0,0 +1,148 @@
+package foreach
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"os/exec"
+	"sync"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/flags"
+	"github.com/gritcli/grit/cli/internal/render"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+//go:embed help.txt
+var helpText string
+
+// Command returns the "foreach" command.
+func Command(con *imbue.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "foreach [--from-source <source>] [--match <pattern>] [--jobs <n>] -- <command> [args...]",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.MinimumNArgs(1),
+		Short:                 "Run a command in each local clone",
+		Long:                  helpText,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := flags.RejectFormat(cmd); err != nil {
+				return err
+			}
+
+			sources, pattern := flags.LocalRepoFilter(cmd)
+
+			jobs, err := flags.Concurrency(cmd)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+
+			return imbue.Invoke1(
+				cmd.Context(),
+				con,
+				func(
+					ctx context.Context,
+					client api.APIClient,
+				) error {
+					res, err := client.ListLocalRepos(ctx, &api.ListLocalReposRequest{
+						SourceFilter: sources,
+						NamePattern:  pattern,
+					})
+					if err != nil {
+						return err
+					}
+
+					repos := make([]*api.LocalRepo, len(res.GetLocalRepos()))
+					for i, l := range res.GetLocalRepos() {
+						repos[i] = l.GetLocalRepo()
+					}
+
+					errs := run(ctx, cmd, repos, args, int(jobs))
+
+					var failures int
+					for i, err := range errs {
+						if err == nil {
+							continue
+						}
+
+						failures++
+						cmd.PrintErrf(
+							"%s (%s): %s\n",
+							repos[i].GetRemoteRepo().GetName(),
+							render.RelPath(repos[i].GetAbsoluteCloneDir()),
+							err,
+						)
+					}
+
+					if failures != 0 {
+						return fmt.Errorf("command failed in %d of %d clone(s)", failures, len(repos))
+					}
+
+					return nil
+				},
+			)
+		},
+	}
+
+	// Stop parsing flags at the first positional argument so that flags
+	// intended for the command being run are not interpreted by grit, even
+	// if "--" is omitted.
+	cmd.Flags().SetInterspersed(false)
+
+	flags.SetupLocalRepoFilter(cmd, con)
+	flags.SetupConcurrency(cmd)
+
+	return cmd
+}
+
+// run executes the command described by args in each of the given clones, at
+// most n at a time.
+//
+// It returns a slice containing the error (or nil) for each clone, in the same
+// order as repos.
+func run(
+	ctx context.Context,
+	cmd *cobra.Command,
+	repos []*api.LocalRepo,
+	args []string,
+	n int,
+) []error {
+	var (
+		m    sync.Mutex
+		g    errgroup.Group
+		errs = make([]error, len(repos))
+	)
+
+	g.SetLimit(n)
+
+	for i, r := range repos {
+		i, r := i, r // capture loop variables
+
+		g.Go(func() error {
+			prefix := []byte(r.GetRemoteRepo().GetName() + ": ")
+			stdout := &prefixWriter{m: &m, w: cmd.OutOrStdout(), prefix: prefix}
+			stderr := &prefixWriter{m: &m, w: cmd.ErrOrStderr(), prefix: prefix}
+
+			c := exec.CommandContext(ctx, args[0], args[1:]...)
+			c.Dir = r.GetAbsoluteCloneDir()
+			c.Stdout = stdout
+			c.Stderr = stderr
+
+			errs[i] = c.Run()
+
+			// Errors writing output are ignored, as there is nowhere
+			// sensible to report them.
+			stdout.Flush()
+			stderr.Flush()
+
+			return nil
+		})
+	}
+
+	g.Wait()
+
+	return errs
+}
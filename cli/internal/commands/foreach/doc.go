@@ -0,0 +1,2 @@
+// Package foreach contains the implementation of the "foreach" command.
+package foreach
@@ -0,0 +1,60 @@
+package foreach
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// prefixWriter is an io.Writer that prefixes each line written to it.
+//
+// Complete lines are written to the underlying writer in a single call while
+// holding m, so that output from multiple prefixWriters sharing the same
+// mutex is never interleaved within a line.
+type prefixWriter struct {
+	m      *sync.Mutex
+	w      io.Writer
+	prefix []byte
+	buf    []byte
+}
+
+// Write writes each complete line in data to the underlying writer, retaining
+// any trailing partial line until it is completed or Flush() is called.
+func (w *prefixWriter) Write(data []byte) (int, error) {
+	w.buf = append(w.buf, data...)
+
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i == -1 {
+			return len(data), nil
+		}
+
+		if err := w.writeLine(w.buf[:i+1]); err != nil {
+			return 0, err
+		}
+
+		w.buf = w.buf[i+1:]
+	}
+}
+
+// Flush writes any partial line that has not yet been written, terminating it
+// with a newline.
+func (w *prefixWriter) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	line := append(w.buf, '\n')
+	w.buf = nil
+
+	return w.writeLine(line)
+}
+
+// writeLine writes a single line to the underlying writer.
+func (w *prefixWriter) writeLine(line []byte) error {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	_, err := w.w.Write(append(w.prefix[:len(w.prefix):len(w.prefix)], line...))
+	return err
+}
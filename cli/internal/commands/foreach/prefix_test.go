@@ -0,0 +1,60 @@
+package foreach // note: no _test suffix to allow testing unexported prefixWriter type.
+
+import (
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type prefixWriter", func() {
+	var (
+		buf *strings.Builder
+		w   *prefixWriter
+	)
+
+	BeforeEach(func() {
+		buf = &strings.Builder{}
+		w = &prefixWriter{
+			m:      &sync.Mutex{},
+			w:      buf,
+			prefix: []byte("<prefix>: "),
+		}
+	})
+
+	Describe("func Write()", func() {
+		It("prefixes each line", func() {
+			_, err := w.Write([]byte("one\ntwo\n"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(Equal("<prefix>: one\n<prefix>: two\n"))
+		})
+
+		It("does not write partial lines until they are completed", func() {
+			_, err := w.Write([]byte("one\ntw"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(Equal("<prefix>: one\n"))
+
+			_, err = w.Write([]byte("o\n"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(Equal("<prefix>: one\n<prefix>: two\n"))
+		})
+	})
+
+	Describe("func Flush()", func() {
+		It("writes any partial line", func() {
+			_, err := w.Write([]byte("one"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = w.Flush()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(Equal("<prefix>: one\n"))
+		})
+
+		It("does nothing if there is no partial line", func() {
+			err := w.Flush()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(BeEmpty())
+		})
+	})
+})
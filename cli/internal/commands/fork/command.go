@@ -0,0 +1,128 @@
+package fork
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/cloneprogress"
+	"github.com/gritcli/grit/cli/internal/completion"
+	"github.com/gritcli/grit/cli/internal/flags"
+	"github.com/gritcli/grit/cli/internal/localrepo"
+	"github.com/gritcli/grit/cli/internal/render"
+	"github.com/gritcli/grit/cli/internal/shell"
+	"github.com/spf13/cobra"
+)
+
+//go:embed help.txt
+var helpText string
+
+// Command returns the "fork" command.
+func Command(con *imbue.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "fork [--from-source <source>] [repo]",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.MaximumNArgs(1),
+		Short:                 "Fork a remote repository and clone the fork",
+		Long:                  helpText,
+		ValidArgsFunction: completion.Positional(
+			completion.RepoName(con, api.Locality_LOCAL, api.Locality_REMOTE),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query := "."
+			if len(args) != 0 {
+				query = args[0]
+			}
+
+			source := flags.LocalRepoSource(cmd)
+
+			cmd.SilenceUsage = true
+
+			return imbue.Invoke4(
+				cmd.Context(),
+				con,
+				func(
+					ctx context.Context,
+					client api.APIClient,
+					options *api.ClientOptions,
+					exec shell.Executor,
+					format *render.Formatter,
+				) error {
+					if format != nil {
+						cmd.SetOut(cmd.ErrOrStderr())
+					}
+
+					repo, err := localrepo.ResolveAny(
+						ctx,
+						cmd,
+						client,
+						options,
+						query,
+						source,
+					)
+					if err != nil {
+						return err
+					}
+
+					local, err := fork(ctx, cmd, client, options, repo)
+					if err != nil {
+						return err
+					}
+
+					dir := local.GetAbsoluteCloneDir()
+					cmd.Println(render.RelPath(dir))
+
+					if format != nil {
+						if err := format.Write(local); err != nil {
+							return err
+						}
+					}
+
+					return exec("cd", dir)
+				},
+			)
+		},
+	}
+
+	flags.SetupLocalRepoSource(cmd, con)
+
+	return cmd
+}
+
+// fork creates a fork of a remote repository and makes a local clone of the
+// fork.
+func fork(
+	ctx context.Context,
+	cmd *cobra.Command,
+	client api.APIClient,
+	options *api.ClientOptions,
+	repo *api.RemoteRepo,
+) (*api.LocalRepo, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	responses, err := client.ForkRepo(
+		ctx,
+		&api.ForkRepoRequest{
+			ClientOptions: options,
+			Source:        repo.GetSource(),
+			RepoId:        repo.GetId(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	local, err := cloneprogress.Wait(cmd, responses)
+	if err != nil {
+		return nil, err
+	}
+
+	if local == nil {
+		return nil, errors.New("server did not provide information about the local clone")
+	}
+
+	return local, nil
+}
@@ -0,0 +1,2 @@
+// Package fork contains the implementation of the "fork" command.
+package fork
@@ -0,0 +1,132 @@
+package info
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/completion"
+	"github.com/gritcli/grit/cli/internal/flags"
+	"github.com/gritcli/grit/cli/internal/localrepo"
+	"github.com/gritcli/grit/cli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+//go:embed help.txt
+var helpText string
+
+// Command returns the "info" command.
+func Command(con *imbue.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "info [--from-source <source>] [repo]",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.MaximumNArgs(1),
+		Short:                 "Show detailed information about a repository",
+		Long:                  helpText,
+		ValidArgsFunction: completion.Positional(
+			completion.RepoName(con, api.Locality_LOCAL, api.Locality_REMOTE),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query := "."
+			if len(args) != 0 {
+				query = args[0]
+			}
+
+			source := flags.LocalRepoSource(cmd)
+
+			cmd.SilenceUsage = true
+
+			return imbue.Invoke3(
+				cmd.Context(),
+				con,
+				func(
+					ctx context.Context,
+					client api.APIClient,
+					options *api.ClientOptions,
+					format *render.Formatter,
+				) error {
+					if format != nil {
+						cmd.SetOut(cmd.ErrOrStderr())
+					}
+
+					repo, err := localrepo.ResolveAny(
+						ctx,
+						cmd,
+						client,
+						options,
+						query,
+						source,
+					)
+					if err != nil {
+						return err
+					}
+
+					res, err := client.DescribeRepo(ctx, &api.DescribeRepoRequest{
+						Source: repo.GetSource(),
+						RepoId: repo.GetId(),
+					})
+					if err != nil {
+						return err
+					}
+
+					if format != nil {
+						return format.Write(res)
+					}
+
+					return write(cmd.OutOrStdout(), res)
+				},
+			)
+		},
+	}
+
+	flags.SetupLocalRepoSource(cmd, con)
+
+	return cmd
+}
+
+// write renders a human-readable description of a repository to w.
+func write(w io.Writer, res *api.DescribeRepoResponse) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	line := func(label, value string) {
+		if value != "" {
+			fmt.Fprintf(tw, "%s:\t%s\n", label, value)
+		}
+	}
+
+	r := res.GetRemoteRepo()
+	line("Name", r.GetName())
+	line("Source", r.GetSource())
+	line("ID", r.GetId())
+	line("Description", r.GetDescription())
+	line("Web URL", r.GetWebUrl())
+
+	for _, u := range res.GetCloneUrls() {
+		line("Clone URL", fmt.Sprintf("%s (%s)", u.GetUrl(), u.GetProtocol()))
+	}
+
+	if err := res.GetRemoteError(); err != "" {
+		line("Source Error", err)
+	}
+
+	if len(res.GetLocalRepos()) == 0 {
+		line("Local Clone", "(not cloned)")
+	}
+
+	for _, l := range res.GetLocalRepos() {
+		line("Local Clone", render.AbsPath(l.GetLocalRepo().GetAbsoluteCloneDir()))
+
+		if l.GetStatusError() == "" {
+			line("  Branch", render.Branch(l.GetStatus()))
+			line("  HEAD", l.GetStatus().GetHead())
+		}
+
+		line("  Status", render.State(l))
+	}
+
+	return tw.Flush()
+}
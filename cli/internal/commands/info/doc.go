@@ -0,0 +1,2 @@
+// Package info contains the implementation of the "info" command.
+package info
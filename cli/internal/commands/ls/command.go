@@ -0,0 +1,99 @@
+package ls
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/flags"
+	"github.com/gritcli/grit/cli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+//go:embed help.txt
+var helpText string
+
+// Command returns the "ls" command.
+func Command(con *imbue.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "ls [--from-source <source>] [--match <pattern>] [--status]",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+		Short:                 "List local clones",
+		Long:                  helpText,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sources, pattern := flags.LocalRepoFilter(cmd)
+
+			status, err := cmd.Flags().GetBool("status")
+			if err != nil {
+				panic(err)
+			}
+
+			return imbue.Invoke2(
+				cmd.Context(),
+				con,
+				func(
+					ctx context.Context,
+					client api.APIClient,
+					format *render.Formatter,
+				) error {
+					if format != nil {
+						cmd.SetOut(cmd.ErrOrStderr())
+					}
+
+					res, err := client.ListLocalRepos(
+						ctx,
+						&api.ListLocalReposRequest{
+							SourceFilter:  sources,
+							NamePattern:   pattern,
+							IncludeStatus: status,
+						},
+					)
+					if err != nil {
+						return err
+					}
+
+					if format != nil {
+						return render.WriteList(format, res.LocalRepos)
+					}
+
+					for _, l := range res.LocalRepos {
+						r := l.GetLocalRepo()
+
+						if !status {
+							cmd.Printf(
+								"%s\t%s\t%s\n",
+								r.GetRemoteRepo().GetSource(),
+								r.GetRemoteRepo().GetName(),
+								render.AbsPath(r.GetAbsoluteCloneDir()),
+							)
+							continue
+						}
+
+						cmd.Printf(
+							"%s\t%s\t%s\t%s\t%s\n",
+							r.GetRemoteRepo().GetSource(),
+							r.GetRemoteRepo().GetName(),
+							render.AbsPath(r.GetAbsoluteCloneDir()),
+							render.Branch(l.GetStatus()),
+							render.State(l),
+						)
+					}
+
+					return nil
+				},
+			)
+		},
+	}
+
+	flags.SetupLocalRepoFilter(cmd, con)
+
+	cmd.Flags().BoolP(
+		"status", "s",
+		false,
+		"show the checked-out branch and any unpushed changes",
+	)
+
+	return cmd
+}
@@ -0,0 +1,2 @@
+// Package ls contains the implementation of the "ls" command.
+package ls
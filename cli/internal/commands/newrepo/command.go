@@ -0,0 +1,144 @@
+package newrepo
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/cloneprogress"
+	"github.com/gritcli/grit/cli/internal/completion"
+	"github.com/gritcli/grit/cli/internal/render"
+	"github.com/gritcli/grit/cli/internal/shell"
+	"github.com/spf13/cobra"
+)
+
+//go:embed help.txt
+var helpText string
+
+// Command returns the "new" command.
+func Command(con *imbue.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "new [--from-source <source>] [--private] [--template <repo>] <name>",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		Short:                 "Create a new remote repository and clone it",
+		Long:                  helpText,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			if name == "" {
+				return errors.New("<name> argument must not be empty")
+			}
+
+			req := &api.CreateRepoRequest{
+				Name: name,
+			}
+
+			var err error
+
+			req.Source, err = cmd.Flags().GetString("from-source")
+			if err != nil {
+				panic(err)
+			}
+
+			req.Private, err = cmd.Flags().GetBool("private")
+			if err != nil {
+				panic(err)
+			}
+
+			req.Template, err = cmd.Flags().GetString("template")
+			if err != nil {
+				panic(err)
+			}
+
+			cmd.SilenceUsage = true
+
+			return imbue.Invoke4(
+				cmd.Context(),
+				con,
+				func(
+					ctx context.Context,
+					client api.APIClient,
+					options *api.ClientOptions,
+					exec shell.Executor,
+					format *render.Formatter,
+				) error {
+					if format != nil {
+						cmd.SetOut(cmd.ErrOrStderr())
+					}
+
+					req.ClientOptions = options
+
+					local, err := create(ctx, cmd, client, req)
+					if err != nil {
+						return err
+					}
+
+					dir := local.GetAbsoluteCloneDir()
+					cmd.Println(render.RelPath(dir))
+
+					if format != nil {
+						if err := format.Write(local); err != nil {
+							return err
+						}
+					}
+
+					return exec("cd", dir)
+				},
+			)
+		},
+	}
+
+	cmd.Flags().StringP(
+		"from-source", "f",
+		"",
+		"create the repository on a specific `source`",
+	)
+
+	cmd.RegisterFlagCompletionFunc(
+		"from-source",
+		completion.SourceName(con),
+	)
+
+	cmd.Flags().Bool(
+		"private",
+		false,
+		"make the new repository private",
+	)
+
+	cmd.Flags().String(
+		"template",
+		"",
+		"create the new repository from a template `repo`",
+	)
+
+	return cmd
+}
+
+// create creates a new remote repository and makes a local clone of it.
+func create(
+	ctx context.Context,
+	cmd *cobra.Command,
+	client api.APIClient,
+	req *api.CreateRepoRequest,
+) (*api.LocalRepo, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	responses, err := client.CreateRepo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	local, err := cloneprogress.Wait(cmd, responses)
+	if err != nil {
+		return nil, err
+	}
+
+	if local == nil {
+		return nil, errors.New("server did not provide information about the local clone")
+	}
+
+	return local, nil
+}
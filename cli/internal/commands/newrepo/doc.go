@@ -0,0 +1,2 @@
+// Package newrepo contains the implementation of the "new" command.
+package newrepo
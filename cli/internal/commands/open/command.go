@@ -0,0 +1,153 @@
+package open
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/browser"
+	"github.com/gritcli/grit/cli/internal/completion"
+	"github.com/gritcli/grit/cli/internal/flags"
+	"github.com/gritcli/grit/cli/internal/localrepo"
+	"github.com/spf13/cobra"
+)
+
+//go:embed help.txt
+var helpText string
+
+// Command returns the "open" command.
+func Command(con *imbue.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "open [--from-source <source>] [--issues | --pulls] [--print] [repo]",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.MaximumNArgs(1),
+		Short:                 "Open a repository's web page",
+		Long:                  helpText,
+		ValidArgsFunction: completion.Positional(
+			completion.RepoName(con, api.Locality_LOCAL, api.Locality_REMOTE),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := flags.RejectFormat(cmd); err != nil {
+				return err
+			}
+
+			query := "."
+			if len(args) != 0 {
+				query = args[0]
+			}
+
+			source := flags.LocalRepoSource(cmd)
+
+			page, err := selectedPage(cmd)
+			if err != nil {
+				return err
+			}
+
+			printOnly, err := cmd.Flags().GetBool("print")
+			if err != nil {
+				panic(err)
+			}
+
+			cmd.SilenceUsage = true
+
+			return imbue.Invoke3(
+				cmd.Context(),
+				con,
+				func(
+					ctx context.Context,
+					client api.APIClient,
+					options *api.ClientOptions,
+					open browser.Opener,
+				) error {
+					repo, err := localrepo.ResolveAny(
+						ctx,
+						cmd,
+						client,
+						options,
+						query,
+						source,
+					)
+					if err != nil {
+						return err
+					}
+
+					url := page.URL(repo)
+					if url == "" {
+						return fmt.Errorf("%s does not have %s", repo.GetName(), page.Description)
+					}
+
+					if printOnly {
+						cmd.Println(url)
+						return nil
+					}
+
+					if err := open(ctx, url); err != nil {
+						return fmt.Errorf("unable to open %s: %w", url, err)
+					}
+
+					return nil
+				},
+			)
+		},
+	}
+
+	flags.SetupLocalRepoSource(cmd, con)
+
+	cmd.Flags().Bool(
+		"issues",
+		false,
+		"open the repository's issues page",
+	)
+
+	cmd.Flags().Bool(
+		"pulls",
+		false,
+		"open the repository's pull requests page",
+	)
+
+	cmd.Flags().Bool(
+		"print",
+		false,
+		"print the URL instead of opening it",
+	)
+
+	return cmd
+}
+
+// page describes one of the web pages that can be opened.
+type page struct {
+	// Description is a human-readable description of the page, used in error
+	// messages.
+	Description string
+
+	// URL returns the URL of the page for the given repository, or an empty
+	// string if the repository does not have such a page.
+	URL func(*api.RemoteRepo) string
+}
+
+// selectedPage returns the page selected by the --issues or --pulls flags.
+func selectedPage(cmd *cobra.Command) (page, error) {
+	issues, err := cmd.Flags().GetBool("issues")
+	if err != nil {
+		panic(err)
+	}
+
+	pulls, err := cmd.Flags().GetBool("pulls")
+	if err != nil {
+		panic(err)
+	}
+
+	switch {
+	case issues && pulls:
+		return page{}, errors.New("--issues and --pulls can not be used together")
+	case issues:
+		return page{"an issues page", (*api.RemoteRepo).GetIssuesUrl}, nil
+	case pulls:
+		return page{"a pull requests page", (*api.RemoteRepo).GetPullRequestsUrl}, nil
+	default:
+		return page{"a web page", (*api.RemoteRepo).GetWebUrl}, nil
+	}
+}
@@ -0,0 +1,2 @@
+// Package open contains the implementation of the "open" command.
+package open
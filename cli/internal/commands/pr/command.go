@@ -0,0 +1,122 @@
+package pr
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/cloneprogress"
+	"github.com/gritcli/grit/cli/internal/completion"
+	"github.com/gritcli/grit/cli/internal/render"
+	"github.com/gritcli/grit/cli/internal/shell"
+	"github.com/spf13/cobra"
+)
+
+//go:embed help.txt
+var helpText string
+
+// Command returns the "pr" command.
+func Command(con *imbue.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "pr [--from-source <source>] <pull-request>",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		Short:                 "Check out a pull request in a local clone",
+		Long:                  helpText,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query := args[0]
+			if query == "" {
+				return errors.New("<pull-request> argument must not be empty")
+			}
+
+			req := &api.CheckoutPullRequestRequest{
+				Query: query,
+			}
+
+			var err error
+
+			req.Source, err = cmd.Flags().GetString("from-source")
+			if err != nil {
+				panic(err)
+			}
+
+			cmd.SilenceUsage = true
+
+			return imbue.Invoke4(
+				cmd.Context(),
+				con,
+				func(
+					ctx context.Context,
+					client api.APIClient,
+					options *api.ClientOptions,
+					exec shell.Executor,
+					format *render.Formatter,
+				) error {
+					if format != nil {
+						cmd.SetOut(cmd.ErrOrStderr())
+					}
+
+					req.ClientOptions = options
+
+					local, err := checkout(ctx, cmd, client, req)
+					if err != nil {
+						return err
+					}
+
+					dir := local.GetAbsoluteCloneDir()
+					cmd.Println(render.RelPath(dir))
+
+					if format != nil {
+						if err := format.Write(local); err != nil {
+							return err
+						}
+					}
+
+					return exec("cd", dir)
+				},
+			)
+		},
+	}
+
+	cmd.Flags().StringP(
+		"from-source", "f",
+		"",
+		"limit the command to pull requests from a specific `source`",
+	)
+
+	cmd.RegisterFlagCompletionFunc(
+		"from-source",
+		completion.SourceName(con),
+	)
+
+	return cmd
+}
+
+// checkout checks out a pull request, cloning its repository if necessary.
+func checkout(
+	ctx context.Context,
+	cmd *cobra.Command,
+	client api.APIClient,
+	req *api.CheckoutPullRequestRequest,
+) (*api.LocalRepo, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	responses, err := client.CheckoutPullRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	local, err := cloneprogress.Wait(cmd, responses)
+	if err != nil {
+		return nil, err
+	}
+
+	if local == nil {
+		return nil, errors.New("server did not provide information about the local clone")
+	}
+
+	return local, nil
+}
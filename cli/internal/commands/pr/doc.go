@@ -0,0 +1,2 @@
+// Package pr contains the implementation of the "pr" command.
+package pr
@@ -0,0 +1,138 @@
+package pull
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/flags"
+	"github.com/gritcli/grit/cli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+//go:embed help.txt
+var helpText string
+
+// Command returns the "pull" command.
+func Command(con *imbue.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "pull [--from-source <source>] [--match <pattern>] [--jobs <n>]",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+		Short:                 "Fast-forward local clones to match their upstream branches",
+		Long:                  helpText,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sources, pattern := flags.LocalRepoFilter(cmd)
+
+			jobs, err := flags.Concurrency(cmd)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+
+			return imbue.Invoke3(
+				cmd.Context(),
+				con,
+				func(
+					ctx context.Context,
+					client api.APIClient,
+					options *api.ClientOptions,
+					format *render.Formatter,
+				) error {
+					if format != nil {
+						cmd.SetOut(cmd.ErrOrStderr())
+					}
+
+					req := &api.PullReposRequest{
+						ClientOptions: options,
+						SourceFilter:  sources,
+						NamePattern:   pattern,
+						Concurrency:   jobs,
+					}
+
+					responses, err := client.PullRepos(ctx, req)
+					if err != nil {
+						return err
+					}
+
+					var count, updated int
+					var skipped, failures []*api.PullRepoResult
+					var results []*api.PullRepoResult
+
+					for {
+						res, err := responses.Recv()
+						if err == io.EOF {
+							break
+						}
+
+						if err != nil {
+							return err
+						}
+
+						if out := res.GetOutput(); out != nil {
+							cmd.Println(out.Message)
+						} else if r := res.GetResult(); r != nil {
+							results = append(results, r)
+
+							count++
+
+							if r.GetError() != "" {
+								failures = append(failures, r)
+							} else if r.GetSkipReason() != "" {
+								skipped = append(skipped, r)
+							} else if r.GetUpdated() {
+								updated++
+							}
+						}
+					}
+
+					if format != nil {
+						if err := render.WriteList(format, results); err != nil {
+							return err
+						}
+					}
+
+					for _, r := range skipped {
+						cmd.Printf(
+							"skipped %s (%s): %s\n",
+							r.GetLocalRepo().GetRemoteRepo().GetName(),
+							render.RelPath(r.GetLocalRepo().GetAbsoluteCloneDir()),
+							r.GetSkipReason(),
+						)
+					}
+
+					for _, r := range failures {
+						cmd.PrintErrf(
+							"%s (%s): %s\n",
+							r.GetLocalRepo().GetRemoteRepo().GetName(),
+							render.RelPath(r.GetLocalRepo().GetAbsoluteCloneDir()),
+							r.GetError(),
+						)
+					}
+
+					if len(failures) != 0 {
+						return fmt.Errorf("unable to pull %d of %d clone(s)", len(failures), count)
+					}
+
+					cmd.Printf(
+						"updated %d clone(s), skipped %d, %d already up-to-date\n",
+						updated,
+						len(skipped),
+						count-updated-len(skipped),
+					)
+
+					return nil
+				},
+			)
+		},
+	}
+
+	flags.SetupLocalRepoFilter(cmd, con)
+	flags.SetupConcurrency(cmd)
+
+	return cmd
+}
@@ -0,0 +1,2 @@
+// Package pull contains the implementation of the "pull" command.
+package pull
@@ -0,0 +1,151 @@
+package relocate
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+//go:embed help.txt
+var helpText string
+
+// Command returns the "relocate" command.
+func Command(con *imbue.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "relocate [--dry-run]",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+		Short:                 "Move local clones to their configured locations",
+		Long:                  helpText,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				panic(err)
+			}
+
+			cmd.SilenceUsage = true
+
+			return imbue.Invoke3(
+				cmd.Context(),
+				con,
+				func(
+					ctx context.Context,
+					client api.APIClient,
+					options *api.ClientOptions,
+					format *render.Formatter,
+				) error {
+					if format != nil {
+						cmd.SetOut(cmd.ErrOrStderr())
+					}
+
+					responses, err := client.RelocateRepos(
+						ctx,
+						&api.RelocateReposRequest{
+							ClientOptions: options,
+							DryRun:        dryRun,
+						},
+					)
+					if err != nil {
+						return err
+					}
+
+					var (
+						moved     int
+						conflicts int
+						failures  []*api.RelocateRepoResult
+					)
+					var results []*api.RelocateRepoResult
+
+					for {
+						res, err := responses.Recv()
+						if err == io.EOF {
+							break
+						}
+
+						if err != nil {
+							return err
+						}
+
+						if out := res.GetOutput(); out != nil {
+							cmd.Println(out.Message)
+							continue
+						}
+
+						r := res.GetResult()
+						results = append(results, r)
+
+						if r.GetError() != "" {
+							if r.GetConflict() {
+								conflicts++
+							}
+							failures = append(failures, r)
+							continue
+						}
+
+						moved++
+
+						verb := "moved"
+						if dryRun {
+							verb = "would move"
+						}
+
+						cmd.Printf(
+							"%s %s from %s to %s\n",
+							verb,
+							r.GetLocalRepo().GetRemoteRepo().GetName(),
+							render.AbsPath(r.GetPreviousCloneDir()),
+							render.AbsPath(r.GetLocalRepo().GetAbsoluteCloneDir()),
+						)
+					}
+
+					if format != nil {
+						if err := render.WriteList(format, results); err != nil {
+							return err
+						}
+					}
+
+					for _, r := range failures {
+						cmd.PrintErrf(
+							"unable to move %s from %s: %s\n",
+							r.GetLocalRepo().GetRemoteRepo().GetName(),
+							render.AbsPath(r.GetPreviousCloneDir()),
+							r.GetError(),
+						)
+					}
+
+					if len(failures) != 0 {
+						return fmt.Errorf(
+							"unable to relocate %d clone(s), %d due to conflicts",
+							len(failures),
+							conflicts,
+						)
+					}
+
+					if moved == 0 {
+						cmd.Println("all local clones are in their configured locations")
+					} else if dryRun {
+						cmd.Printf("%d clone(s) would be moved\n", moved)
+					} else {
+						cmd.Printf("moved %d clone(s)\n", moved)
+					}
+
+					return nil
+				},
+			)
+		},
+	}
+
+	cmd.Flags().Bool(
+		"dry-run",
+		false,
+		"list the clones that would be moved without moving them",
+	)
+
+	return cmd
+}
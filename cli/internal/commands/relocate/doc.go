@@ -0,0 +1,2 @@
+// Package relocate contains the implementation of the "relocate" command.
+package relocate
@@ -0,0 +1,173 @@
+package rm
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/completion"
+	"github.com/gritcli/grit/cli/internal/flags"
+	"github.com/gritcli/grit/cli/internal/localrepo"
+	"github.com/gritcli/grit/cli/internal/render"
+	"github.com/gritcli/grit/cli/internal/shell"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//go:embed help.txt
+var helpText string
+
+// Command returns the "rm" command.
+func Command(con *imbue.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "rm [--from-source <source>] [--force] <repo>",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		Short:                 "Remove a local clone",
+		Long:                  helpText,
+		ValidArgsFunction: completion.Positional(
+			completion.RepoName(con, api.Locality_LOCAL),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			source := flags.LocalRepoSource(cmd)
+
+			force, err := cmd.Flags().GetBool("force")
+			if err != nil {
+				panic(err)
+			}
+
+			cmd.SilenceUsage = true
+
+			return imbue.Invoke4(
+				cmd.Context(),
+				con,
+				func(
+					ctx context.Context,
+					client api.APIClient,
+					options *api.ClientOptions,
+					exec shell.Executor,
+					format *render.Formatter,
+				) error {
+					if format != nil {
+						cmd.SetOut(cmd.ErrOrStderr())
+					}
+
+					repo, err := localrepo.Resolve(
+						ctx,
+						cmd,
+						client,
+						options,
+						args[0],
+						source,
+					)
+					if err != nil {
+						return err
+					}
+
+					dir := repo.GetAbsoluteCloneDir()
+
+					if err := remove(ctx, cmd, client, options, dir, force); err != nil {
+						if s, ok := status.FromError(err); ok && s.Code() == codes.FailedPrecondition {
+							return fmt.Errorf("%s, use --force to remove it anyway", s.Message())
+						}
+						return err
+					}
+
+					cmd.Printf("removed %s\n", render.AbsPath(dir))
+
+					if format != nil {
+						if err := format.Write(repo); err != nil {
+							return err
+						}
+					}
+
+					// If the current working directory was within the removed
+					// clone, move the shell to the nearest directory that still
+					// exists.
+					if cwd, err := os.Getwd(); err != nil {
+						return exec("cd", existingParent(dir))
+					} else if rel, err := filepath.Rel(dir, cwd); err == nil && filepath.IsLocal(rel) {
+						return exec("cd", existingParent(dir))
+					}
+
+					return nil
+				},
+			)
+		},
+	}
+
+	flags.SetupLocalRepoSource(cmd, con)
+
+	cmd.Flags().Bool(
+		"force",
+		false,
+		"remove the clone even if it has changes that have not been pushed, along with its worktrees",
+	)
+
+	return cmd
+}
+
+// remove removes the local clone in the given directory.
+func remove(
+	ctx context.Context,
+	cmd *cobra.Command,
+	client api.APIClient,
+	options *api.ClientOptions,
+	dir string,
+	force bool,
+) error {
+	responses, err := client.RemoveRepo(
+		ctx,
+		&api.RemoveRepoRequest{
+			ClientOptions:    options,
+			AbsoluteCloneDir: dir,
+			Force:            force,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	removed := false
+
+	for {
+		res, err := responses.Recv()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if out := res.GetOutput(); out != nil {
+			cmd.Println(out.Message)
+		} else if res.GetLocalRepo() != nil {
+			removed = true
+		}
+	}
+
+	if !removed {
+		return errors.New("server did not confirm removal of the local clone")
+	}
+
+	return nil
+}
+
+// existingParent returns the nearest parent of dir that still exists.
+func existingParent(dir string) string {
+	for {
+		dir = filepath.Dir(dir)
+
+		if _, err := os.Stat(dir); err == nil {
+			return dir
+		}
+	}
+}
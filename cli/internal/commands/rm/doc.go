@@ -0,0 +1,2 @@
+// Package rm contains the implementation of the "rm" command.
+package rm
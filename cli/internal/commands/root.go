@@ -4,10 +4,25 @@ import (
 	"os"
 
 	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/cli/internal/commands/adopt"
+	"github.com/gritcli/grit/cli/internal/commands/archive"
 	"github.com/gritcli/grit/cli/internal/commands/clone"
+	"github.com/gritcli/grit/cli/internal/commands/fetch"
+	"github.com/gritcli/grit/cli/internal/commands/foreach"
+	"github.com/gritcli/grit/cli/internal/commands/fork"
+	"github.com/gritcli/grit/cli/internal/commands/info"
+	"github.com/gritcli/grit/cli/internal/commands/ls"
+	"github.com/gritcli/grit/cli/internal/commands/newrepo"
+	"github.com/gritcli/grit/cli/internal/commands/open"
+	"github.com/gritcli/grit/cli/internal/commands/pr"
+	"github.com/gritcli/grit/cli/internal/commands/pull"
+	"github.com/gritcli/grit/cli/internal/commands/relocate"
+	"github.com/gritcli/grit/cli/internal/commands/rm"
 	"github.com/gritcli/grit/cli/internal/commands/setupshell"
 	"github.com/gritcli/grit/cli/internal/commands/source"
+	"github.com/gritcli/grit/cli/internal/commands/unarchive"
 	"github.com/gritcli/grit/cli/internal/commands/version"
+	"github.com/gritcli/grit/cli/internal/commands/worktree"
 	"github.com/gritcli/grit/cli/internal/flags"
 	"github.com/spf13/cobra"
 )
@@ -46,15 +61,31 @@ func Root(con *imbue.Container, ver string) *cobra.Command {
 	cmd.SetErr(os.Stderr)
 
 	flags.SetupVerbose(cmd)
+	flags.SetupFormat(cmd)
 	flags.SetupNoInteractive(cmd)
 	flags.SetupSocket(cmd)
 	flags.SetupShellExecutorOutput(cmd)
 
 	cmd.AddCommand(
+		adopt.Command(con),
+		archive.Command(con),
 		clone.Command(con),
+		fetch.Command(con),
+		foreach.Command(con),
+		fork.Command(con),
+		info.Command(con),
+		ls.Command(con),
+		newrepo.Command(con),
+		open.Command(con),
+		pr.Command(con),
+		pull.Command(con),
+		relocate.Command(con),
+		rm.Command(con),
 		setupshell.Command(con),
 		source.Command(con),
+		unarchive.Command(con),
 		version.Command(con, ver),
+		worktree.Command(con),
 	)
 
 	return cmd
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/cli/internal/flags"
 	"github.com/gritcli/grit/cli/internal/shell"
 	"github.com/spf13/cobra"
 )
@@ -32,6 +33,10 @@ func Command(con *imbue.Container) *cobra.Command {
 				DisableFlagsInUseLine: true,
 				Short:                 fmt.Sprintf("Generate integration code for %s", sh),
 				RunE: func(cmd *cobra.Command, args []string) error {
+					if err := flags.RejectFormat(cmd); err != nil {
+						return err
+					}
+
 					f, err := installers.Open("install." + sh)
 					if err != nil {
 						return err
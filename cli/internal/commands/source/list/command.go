@@ -23,18 +23,27 @@ func Command(con *imbue.Container) *cobra.Command {
 		Short:                 "List the configured repository sources",
 		Long:                  helpText,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return imbue.Invoke1(
+			return imbue.Invoke2(
 				cmd.Context(),
 				con,
 				func(
 					ctx context.Context,
 					client api.APIClient,
+					format *render.Formatter,
 				) error {
+					if format != nil {
+						cmd.SetOut(cmd.ErrOrStderr())
+					}
+
 					res, err := client.ListSources(ctx, &api.ListSourcesRequest{})
 					if err != nil {
 						return err
 					}
 
+					if format != nil {
+						return render.WriteList(format, res.Sources)
+					}
+
 					for _, src := range res.Sources {
 						cmd.Printf(
 							"%s\t%s\t%s\t%s\n",
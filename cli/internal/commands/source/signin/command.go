@@ -29,6 +29,10 @@ func Command(con *imbue.Container) *cobra.Command {
 			completion.SourceName(con),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := flags.RejectFormat(cmd); err != nil {
+				return err
+			}
+
 			if !flags.IsInteractive(cmd) {
 				return errors.New("non-interactive mode is not supported")
 			}
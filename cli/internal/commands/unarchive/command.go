@@ -0,0 +1,107 @@
+package unarchive
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"io"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/flags"
+	"github.com/gritcli/grit/cli/internal/render"
+	"github.com/gritcli/grit/cli/internal/shell"
+	"github.com/spf13/cobra"
+)
+
+//go:embed help.txt
+var helpText string
+
+// Command returns the "unarchive" command.
+func Command(con *imbue.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "unarchive [--from-source <source>] <repo>",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		Short:                 "Restore an archived clone",
+		Long:                  helpText,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query := args[0]
+
+			if query == "" {
+				return errors.New("<repo> argument must not be empty")
+			}
+
+			req := &api.UnarchiveRepoRequest{
+				Query: query,
+			}
+
+			if source := flags.LocalRepoSource(cmd); source != "" {
+				req.SourceFilter = []string{source}
+			}
+
+			cmd.SilenceUsage = true
+
+			return imbue.Invoke4(
+				cmd.Context(),
+				con,
+				func(
+					ctx context.Context,
+					client api.APIClient,
+					options *api.ClientOptions,
+					exec shell.Executor,
+					format *render.Formatter,
+				) error {
+					if format != nil {
+						cmd.SetOut(cmd.ErrOrStderr())
+					}
+
+					req.ClientOptions = options
+
+					responses, err := client.UnarchiveRepo(ctx, req)
+					if err != nil {
+						return err
+					}
+
+					var local *api.LocalRepo
+
+					for {
+						res, err := responses.Recv()
+						if err == io.EOF {
+							break
+						}
+
+						if err != nil {
+							return err
+						}
+
+						if out := res.GetOutput(); out != nil {
+							cmd.Println(out.Message)
+						} else if r := res.GetLocalRepo(); r != nil {
+							local = r
+						}
+					}
+
+					if local == nil {
+						return errors.New("server did not provide information about the local clone")
+					}
+
+					dir := local.GetAbsoluteCloneDir()
+					cmd.Println(render.RelPath(dir))
+
+					if format != nil {
+						if err := format.Write(local); err != nil {
+							return err
+						}
+					}
+
+					return exec("cd", dir)
+				},
+			)
+		},
+	}
+
+	flags.SetupLocalRepoSource(cmd, con)
+
+	return cmd
+}
@@ -0,0 +1,2 @@
+// Package unarchive contains the implementation of the "unarchive" command.
+package unarchive
@@ -5,6 +5,7 @@ import (
 
 	"github.com/dogmatiq/imbue"
 	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/flags"
 	"github.com/spf13/cobra"
 )
 
@@ -16,6 +17,10 @@ func Command(con *imbue.Container, ver string) *cobra.Command {
 		Args:                  cobra.NoArgs,
 		Short:                 "Show version information",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := flags.RejectFormat(cmd); err != nil {
+				return err
+			}
+
 			return imbue.Invoke1(
 				cmd.Context(),
 				con,
@@ -0,0 +1,122 @@
+package add
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"io"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/completion"
+	"github.com/gritcli/grit/cli/internal/flags"
+	"github.com/gritcli/grit/cli/internal/localrepo"
+	"github.com/gritcli/grit/cli/internal/render"
+	"github.com/gritcli/grit/cli/internal/shell"
+	"github.com/spf13/cobra"
+)
+
+//go:embed help.txt
+var helpText string
+
+// Command returns the "worktree add" command.
+func Command(con *imbue.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "add [--from-source <source>] <repo> <branch>",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		Short:                 "Check out a branch in an additional working tree",
+		Long:                  helpText,
+		ValidArgsFunction: completion.Positional(
+			completion.RepoName(con, api.Locality_LOCAL),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			source := flags.LocalRepoSource(cmd)
+			branch := args[1]
+
+			if branch == "" {
+				return errors.New("<branch> argument must not be empty")
+			}
+
+			cmd.SilenceUsage = true
+
+			return imbue.Invoke4(
+				cmd.Context(),
+				con,
+				func(
+					ctx context.Context,
+					client api.APIClient,
+					options *api.ClientOptions,
+					exec shell.Executor,
+					format *render.Formatter,
+				) error {
+					if format != nil {
+						cmd.SetOut(cmd.ErrOrStderr())
+					}
+
+					repo, err := localrepo.Resolve(
+						ctx,
+						cmd,
+						client,
+						options,
+						args[0],
+						source,
+					)
+					if err != nil {
+						return err
+					}
+
+					responses, err := client.AddWorktree(
+						ctx,
+						&api.AddWorktreeRequest{
+							ClientOptions:    options,
+							AbsoluteCloneDir: repo.GetAbsoluteCloneDir(),
+							Branch:           branch,
+						},
+					)
+					if err != nil {
+						return err
+					}
+
+					var worktree *api.Worktree
+
+					for {
+						res, err := responses.Recv()
+						if err == io.EOF {
+							break
+						}
+
+						if err != nil {
+							return err
+						}
+
+						if out := res.GetOutput(); out != nil {
+							cmd.Println(out.Message)
+						} else if wt := res.GetWorktree(); wt != nil {
+							worktree = wt
+						}
+					}
+
+					if worktree == nil {
+						return errors.New("server did not provide information about the worktree")
+					}
+
+					dir := worktree.GetAbsoluteDir()
+					cmd.Println(render.RelPath(dir))
+
+					if format != nil {
+						if err := format.Write(worktree); err != nil {
+							return err
+						}
+					}
+
+					return exec("cd", dir)
+				},
+			)
+		},
+	}
+
+	flags.SetupLocalRepoSource(cmd, con)
+
+	return cmd
+}
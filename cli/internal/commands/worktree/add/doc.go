@@ -0,0 +1,2 @@
+// Package add contains the implementation of the "worktree add" command.
+package add
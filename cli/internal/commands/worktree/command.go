@@ -0,0 +1,26 @@
+package worktree
+
+import (
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/cli/internal/commands/worktree/add"
+	"github.com/gritcli/grit/cli/internal/commands/worktree/list"
+	"github.com/gritcli/grit/cli/internal/commands/worktree/remove"
+	"github.com/spf13/cobra"
+)
+
+// Command returns the "worktree" command.
+func Command(con *imbue.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "worktree",
+		DisableFlagsInUseLine: true,
+		Short:                 "Manage additional working trees of local clones",
+	}
+
+	cmd.AddCommand(
+		add.Command(con),
+		list.Command(con),
+		remove.Command(con),
+	)
+
+	return cmd
+}
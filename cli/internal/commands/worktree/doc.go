@@ -0,0 +1,2 @@
+// Package worktree contains the implementation of the "worktree" command.
+package worktree
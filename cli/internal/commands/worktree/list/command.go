@@ -0,0 +1,115 @@
+package list
+
+import (
+	"context"
+	_ "embed"
+	"strings"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/completion"
+	"github.com/gritcli/grit/cli/internal/flags"
+	"github.com/gritcli/grit/cli/internal/localrepo"
+	"github.com/gritcli/grit/cli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+//go:embed help.txt
+var helpText string
+
+// Command returns the "worktree list" command.
+func Command(con *imbue.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "list [--from-source <source>] [<repo>]",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.MaximumNArgs(1),
+		Aliases:               []string{"ls"},
+		Short:                 "List additional working trees of local clones",
+		Long:                  helpText,
+		ValidArgsFunction: completion.Positional(
+			completion.RepoName(con, api.Locality_LOCAL),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			source := flags.LocalRepoSource(cmd)
+
+			cmd.SilenceUsage = true
+
+			return imbue.Invoke3(
+				cmd.Context(),
+				con,
+				func(
+					ctx context.Context,
+					client api.APIClient,
+					options *api.ClientOptions,
+					format *render.Formatter,
+				) error {
+					if format != nil {
+						cmd.SetOut(cmd.ErrOrStderr())
+					}
+
+					req := &api.ListWorktreesRequest{}
+
+					if len(args) != 0 {
+						repo, err := localrepo.Resolve(
+							ctx,
+							cmd,
+							client,
+							options,
+							args[0],
+							source,
+						)
+						if err != nil {
+							return err
+						}
+
+						req.AbsoluteCloneDir = repo.GetAbsoluteCloneDir()
+					}
+
+					res, err := client.ListWorktrees(ctx, req)
+					if err != nil {
+						return err
+					}
+
+					worktrees := res.Worktrees
+
+					// When listing the worktrees of all clones, --from-source
+					// filters the results rather than the resolution of <repo>.
+					if len(args) == 0 && source != "" {
+						worktrees = nil
+						for _, wt := range res.Worktrees {
+							if strings.EqualFold(wt.GetLocalRepo().GetRemoteRepo().GetSource(), source) {
+								worktrees = append(worktrees, wt)
+							}
+						}
+					}
+
+					if format != nil {
+						return render.WriteList(format, worktrees)
+					}
+
+					for _, wt := range worktrees {
+						state := "clean"
+						if wt.GetHasUncommittedChanges() {
+							state = "uncommitted changes"
+						}
+
+						cmd.Printf(
+							"%s\t%s\t%s\t%s\t%s\n",
+							wt.GetLocalRepo().GetRemoteRepo().GetSource(),
+							wt.GetLocalRepo().GetRemoteRepo().GetName(),
+							wt.GetBranch(),
+							render.AbsPath(wt.GetAbsoluteDir()),
+							state,
+						)
+					}
+
+					return nil
+				},
+			)
+		},
+	}
+
+	flags.SetupLocalRepoSource(cmd, con)
+
+	return cmd
+}
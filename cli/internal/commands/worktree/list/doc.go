@@ -0,0 +1,2 @@
+// Package list contains the implementation of the "worktree list" command.
+package list
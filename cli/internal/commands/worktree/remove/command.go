@@ -0,0 +1,168 @@
+package remove
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/completion"
+	"github.com/gritcli/grit/cli/internal/flags"
+	"github.com/gritcli/grit/cli/internal/localrepo"
+	"github.com/gritcli/grit/cli/internal/render"
+	"github.com/gritcli/grit/cli/internal/shell"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//go:embed help.txt
+var helpText string
+
+// Command returns the "worktree remove" command.
+func Command(con *imbue.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "remove [--from-source <source>] [--force] <repo> <branch>",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		Aliases:               []string{"rm"},
+		Short:                 "Remove an additional working tree of a local clone",
+		Long:                  helpText,
+		ValidArgsFunction: completion.Positional(
+			completion.RepoName(con, api.Locality_LOCAL),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			source := flags.LocalRepoSource(cmd)
+			branch := args[1]
+
+			if branch == "" {
+				return errors.New("<branch> argument must not be empty")
+			}
+
+			force, err := cmd.Flags().GetBool("force")
+			if err != nil {
+				panic(err)
+			}
+
+			cmd.SilenceUsage = true
+
+			return imbue.Invoke4(
+				cmd.Context(),
+				con,
+				func(
+					ctx context.Context,
+					client api.APIClient,
+					options *api.ClientOptions,
+					exec shell.Executor,
+					format *render.Formatter,
+				) error {
+					if format != nil {
+						cmd.SetOut(cmd.ErrOrStderr())
+					}
+
+					repo, err := localrepo.Resolve(
+						ctx,
+						cmd,
+						client,
+						options,
+						args[0],
+						source,
+					)
+					if err != nil {
+						return err
+					}
+
+					worktree, err := remove(
+						ctx,
+						cmd,
+						client,
+						&api.RemoveWorktreeRequest{
+							ClientOptions:    options,
+							AbsoluteCloneDir: repo.GetAbsoluteCloneDir(),
+							Branch:           branch,
+							Force:            force,
+						},
+					)
+					if err != nil {
+						if s, ok := status.FromError(err); ok && s.Code() == codes.FailedPrecondition {
+							return fmt.Errorf("%s, use --force to remove it anyway", s.Message())
+						}
+						return err
+					}
+
+					dir := worktree.GetAbsoluteDir()
+					cmd.Printf("removed %s\n", render.AbsPath(dir))
+
+					if format != nil {
+						if err := format.Write(worktree); err != nil {
+							return err
+						}
+					}
+
+					// If the current working directory was within the removed
+					// worktree, move the shell to the clone itself.
+					if cwd, err := os.Getwd(); err != nil {
+						return exec("cd", repo.GetAbsoluteCloneDir())
+					} else if rel, err := filepath.Rel(dir, cwd); err == nil && filepath.IsLocal(rel) {
+						return exec("cd", repo.GetAbsoluteCloneDir())
+					}
+
+					return nil
+				},
+			)
+		},
+	}
+
+	flags.SetupLocalRepoSource(cmd, con)
+
+	cmd.Flags().Bool(
+		"force",
+		false,
+		"remove the worktree even if it has uncommitted changes",
+	)
+
+	return cmd
+}
+
+// remove removes a worktree and returns information about it.
+func remove(
+	ctx context.Context,
+	cmd *cobra.Command,
+	client api.APIClient,
+	req *api.RemoveWorktreeRequest,
+) (*api.Worktree, error) {
+	responses, err := client.RemoveWorktree(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var worktree *api.Worktree
+
+	for {
+		res, err := responses.Recv()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if out := res.GetOutput(); out != nil {
+			cmd.Println(out.Message)
+		} else if wt := res.GetWorktree(); wt != nil {
+			worktree = wt
+		}
+	}
+
+	if worktree == nil {
+		return nil, errors.New("server did not confirm removal of the worktree")
+	}
+
+	return worktree, nil
+}
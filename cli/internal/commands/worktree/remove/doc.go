@@ -0,0 +1,2 @@
+// Package remove contains the implementation of the "worktree remove" command.
+package remove
@@ -0,0 +1,34 @@
+package flags
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+)
+
+// defaultConcurrency is the default value of the --jobs flag.
+const defaultConcurrency = 4
+
+// SetupConcurrency sets up the --jobs flag used by commands that operate on
+// multiple repositories in parallel.
+func SetupConcurrency(cmd *cobra.Command) {
+	cmd.Flags().UintP(
+		"jobs", "j",
+		defaultConcurrency,
+		"operate on at most `n` repositories in parallel",
+	)
+}
+
+// Concurrency returns the number of parallel operations requested via --jobs.
+func Concurrency(cmd *cobra.Command) (uint32, error) {
+	n, err := cmd.Flags().GetUint("jobs")
+	if err != nil {
+		panic(err)
+	}
+
+	if n == 0 {
+		return 0, errors.New("--jobs must be greater than zero")
+	}
+
+	return uint32(n), nil
+}
@@ -0,0 +1,46 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// SetupFormat sets up the --format flag on the root command.
+func SetupFormat(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(
+		"format",
+		"text",
+		"set the output `format`, one of 'text', 'json', 'yaml' or a Go template",
+	)
+
+	cmd.RegisterFlagCompletionFunc(
+		"format",
+		cobra.FixedCompletions(
+			[]string{"text", "json", "yaml"},
+			cobra.ShellCompDirectiveNoFileComp,
+		),
+	)
+}
+
+// Format returns the output format passed via the --format flag.
+func Format(cmd *cobra.Command) string {
+	format, err := cmd.Flags().GetString("format")
+	if err != nil {
+		panic(err)
+	}
+
+	return format
+}
+
+// RejectFormat returns an error if an output format other than "text" was
+// passed via the --format flag.
+//
+// It is used by commands that do not produce machine-readable output.
+func RejectFormat(cmd *cobra.Command) error {
+	if f := Format(cmd); f != "" && f != "text" {
+		return fmt.Errorf("%s does not support the --format flag", cmd.CommandPath())
+	}
+
+	return nil
+}
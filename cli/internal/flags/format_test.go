@@ -0,0 +1,35 @@
+package flags_test
+
+import (
+	. "github.com/gritcli/grit/cli/internal/flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+)
+
+var _ = Describe("func RejectFormat()", func() {
+	var root, cmd *cobra.Command
+
+	BeforeEach(func() {
+		root = &cobra.Command{Use: "grit"}
+		cmd = &cobra.Command{Use: "version"}
+		root.AddCommand(cmd)
+
+		SetupFormat(root)
+	})
+
+	It("does not return an error if no format is specified", func() {
+		Expect(cmd.ParseFlags(nil)).To(Succeed())
+		Expect(RejectFormat(cmd)).To(Succeed())
+	})
+
+	It("does not return an error if the text format is specified", func() {
+		Expect(cmd.ParseFlags([]string{"--format", "text"})).To(Succeed())
+		Expect(RejectFormat(cmd)).To(Succeed())
+	})
+
+	It("returns an error if any other format is specified", func() {
+		Expect(cmd.ParseFlags([]string{"--format", "json"})).To(Succeed())
+		Expect(RejectFormat(cmd)).To(MatchError("grit version does not support the --format flag"))
+	})
+})
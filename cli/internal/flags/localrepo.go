@@ -0,0 +1,70 @@
+package flags
+
+import (
+	"github.com/dogmatiq/imbue"
+	"github.com/gritcli/grit/cli/internal/completion"
+	"github.com/spf13/cobra"
+)
+
+// SetupLocalRepoFilter sets up the --from-source and --match flags used by
+// commands that operate on multiple local clones.
+func SetupLocalRepoFilter(cmd *cobra.Command, con *imbue.Container) {
+	cmd.Flags().StringSliceP(
+		"from-source", "f",
+		nil,
+		"limit the operation to clones from the given `source`, may be repeated",
+	)
+
+	cmd.Flags().StringP(
+		"match", "m",
+		"",
+		"limit the operation to clones with names that match a glob `pattern`",
+	)
+
+	cmd.RegisterFlagCompletionFunc(
+		"from-source",
+		completion.SourceName(con),
+	)
+}
+
+// LocalRepoFilter returns the source names passed via --from-source and the
+// name pattern passed via --match.
+func LocalRepoFilter(cmd *cobra.Command) (sources []string, pattern string) {
+	sources, err := cmd.Flags().GetStringSlice("from-source")
+	if err != nil {
+		panic(err)
+	}
+
+	pattern, err = cmd.Flags().GetString("match")
+	if err != nil {
+		panic(err)
+	}
+
+	return sources, pattern
+}
+
+// SetupLocalRepoSource sets up the --from-source flag used by commands that
+// resolve a query to a single repository without cloning it.
+func SetupLocalRepoSource(cmd *cobra.Command, con *imbue.Container) {
+	cmd.Flags().StringP(
+		"from-source", "f",
+		"",
+		"limit resolution of <repo> to a single `source`",
+	)
+
+	cmd.RegisterFlagCompletionFunc(
+		"from-source",
+		completion.SourceName(con),
+	)
+}
+
+// LocalRepoSource returns the source name passed via --from-source. It returns
+// an empty string if --from-source is omitted.
+func LocalRepoSource(cmd *cobra.Command) string {
+	source, err := cmd.Flags().GetString("from-source")
+	if err != nil {
+		panic(err)
+	}
+
+	return source
+}
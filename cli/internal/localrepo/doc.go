@@ -0,0 +1,3 @@
+// Package localrepo contains utilities for resolving user-supplied queries to
+// local clones and remote repositories.
+package localrepo
@@ -0,0 +1,115 @@
+package localrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/cli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+// Resolve resolves a query to a single local clone.
+//
+// The query may be a repository name (or the last component thereof), a
+// unique ID, or a path to a directory within a local clone. If source is
+// non-empty, only clones from that source are considered.
+//
+// If the query is ambiguous, the matching clones are printed to the command's
+// error output and an error is returned.
+func Resolve(
+	ctx context.Context,
+	cmd *cobra.Command,
+	client api.APIClient,
+	options *api.ClientOptions,
+	query string,
+	source string,
+) (*api.LocalRepo, error) {
+	if query == "" {
+		return nil, errors.New("<repo> argument must not be empty")
+	}
+
+	if IsPath(query) {
+		abs, err := filepath.Abs(query)
+		if err != nil {
+			return nil, err
+		}
+		query = abs
+	}
+
+	req := &api.ResolveRepoRequest{
+		ClientOptions: options,
+		Query:         query,
+		LocalityFilter: []api.Locality{
+			api.Locality_LOCAL,
+		},
+	}
+
+	if source != "" {
+		req.SourceFilter = []string{source}
+	}
+
+	responses, err := client.ResolveRepo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var repos []*api.LocalRepo
+
+	for {
+		res, err := responses.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if out := res.GetOutput(); out != nil {
+			cmd.Println(out.GetMessage())
+		} else if r := res.GetLocalRepo(); r != nil {
+			repos = append(repos, r)
+		}
+	}
+
+	switch len(repos) {
+	case 1:
+		return repos[0], nil
+	case 0:
+		return nil, fmt.Errorf("no local clones match '%s'", query)
+	default:
+		cmd.PrintErrf(
+			"Multiple local clones match '%s'. Use the clone's path or --from-source instead:\n\n",
+			query,
+		)
+
+		for n, r := range repos {
+			cmd.PrintErrf(
+				"%d) %s (%s) in %s\n",
+				n+1,
+				r.GetRemoteRepo().GetName(),
+				r.GetRemoteRepo().GetSource(),
+				render.AbsPath(r.GetAbsoluteCloneDir()),
+			)
+		}
+
+		cmd.PrintErrln("")
+
+		return nil, errors.New("multiple matching local clones")
+	}
+}
+
+// IsPath returns true if the query should be interpreted as a filesystem path
+// rather than a repository name.
+func IsPath(query string) bool {
+	if query == "." || query == ".." || filepath.IsAbs(query) {
+		return true
+	}
+
+	return strings.HasPrefix(query, "."+string(filepath.Separator)) ||
+		strings.HasPrefix(query, ".."+string(filepath.Separator))
+}
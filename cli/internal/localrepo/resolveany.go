@@ -0,0 +1,111 @@
+package localrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gritcli/grit/api"
+	"github.com/spf13/cobra"
+)
+
+// ResolveAny resolves a query to a single repository, which may or may not have
+// been cloned.
+//
+// Queries that refer to filesystem paths are resolved to the local clone that
+// contains that path. If source is non-empty, only repositories from that
+// source are considered.
+func ResolveAny(
+	ctx context.Context,
+	cmd *cobra.Command,
+	client api.APIClient,
+	options *api.ClientOptions,
+	query string,
+	source string,
+) (*api.RemoteRepo, error) {
+	if IsPath(query) {
+		r, err := Resolve(ctx, cmd, client, options, query, source)
+		if err != nil {
+			return nil, err
+		}
+
+		return r.GetRemoteRepo(), nil
+	}
+
+	req := &api.ResolveRepoRequest{
+		ClientOptions: options,
+		Query:         query,
+		LocalityFilter: []api.Locality{
+			api.Locality_LOCAL,
+			api.Locality_REMOTE,
+		},
+	}
+
+	if source != "" {
+		req.SourceFilter = []string{source}
+	}
+
+	responses, err := client.ResolveRepo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// A local clone and its remote repository may both match the query, so
+	// repositories are de-duplicated by their source and ID.
+	type key struct{ source, id string }
+	seen := map[key]struct{}{}
+	var repos []*api.RemoteRepo
+
+	for {
+		res, err := responses.Recv()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if out := res.GetOutput(); out != nil {
+			cmd.Println(out.GetMessage())
+			continue
+		}
+
+		r := res.GetRemoteRepo()
+		if l := res.GetLocalRepo(); l != nil {
+			r = l.GetRemoteRepo()
+		}
+
+		k := key{r.GetSource(), r.GetId()}
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			repos = append(repos, r)
+		}
+	}
+
+	switch len(repos) {
+	case 1:
+		return repos[0], nil
+	case 0:
+		return nil, fmt.Errorf("no repositories match '%s'", query)
+	default:
+		cmd.PrintErrf(
+			"Multiple repositories match '%s'. Use a fully-qualified name or --from-source instead:\n\n",
+			query,
+		)
+
+		for n, r := range repos {
+			cmd.PrintErrf(
+				"%d) %s (%s)\n",
+				n+1,
+				r.GetName(),
+				r.GetSource(),
+			)
+		}
+
+		cmd.PrintErrln("")
+
+		return nil, errors.New("multiple matching repositories")
+	}
+}
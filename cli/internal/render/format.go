@@ -0,0 +1,159 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// Formatter renders API messages in a machine-readable format.
+type Formatter struct {
+	out  io.Writer
+	kind string
+	tmpl *template.Template
+}
+
+// NewFormatter returns a formatter that writes messages to w.
+//
+// format is "json", "yaml", or a Go text/template that is executed once for
+// each message. Templates are executed against the JSON representation of the
+// message, such that the fields available within the template have the same
+// names as in the JSON output.
+//
+// It returns nil if format is empty or "text", which indicates that the
+// default human-readable output should be used. Commands that use a non-nil
+// formatter should send any human-readable output to stderr so that it does
+// not interfere with the formatted output.
+func NewFormatter(format string, w io.Writer) (*Formatter, error) {
+	switch format {
+	case "", "text":
+		return nil, nil
+	case "json", "yaml":
+		return &Formatter{out: w, kind: format}, nil
+	}
+
+	if !strings.Contains(format, "{{") {
+		return nil, fmt.Errorf("unsupported output format (%s), expected 'text', 'json', 'yaml' or a Go template", format)
+	}
+
+	tmpl, err := template.New("format").Parse(format)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse output template: %w", err)
+	}
+
+	return &Formatter{out: w, kind: "template", tmpl: tmpl}, nil
+}
+
+// Write renders a single message.
+func (f *Formatter) Write(m proto.Message) error {
+	data, err := marshalJSON(m)
+	if err != nil {
+		return err
+	}
+
+	return f.write(data)
+}
+
+// WriteList renders a list of messages.
+//
+// When using the JSON or YAML formats the messages are rendered as a single
+// array, even if the list is empty.
+func WriteList[T proto.Message](f *Formatter, messages []T) error {
+	items := make([][]byte, len(messages))
+
+	for i, m := range messages {
+		data, err := marshalJSON(m)
+		if err != nil {
+			return err
+		}
+
+		items[i] = data
+	}
+
+	if f.kind == "template" {
+		for _, data := range items {
+			if err := f.write(data); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	data := append([]byte("["), bytes.Join(items, []byte(","))...)
+	data = append(data, ']')
+
+	return f.write(data)
+}
+
+// write renders the JSON representation of a message, or list of messages.
+func (f *Formatter) write(data []byte) error {
+	switch f.kind {
+	case "json":
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, data, "", "  "); err != nil {
+			return err
+		}
+		buf.WriteByte('\n')
+
+		_, err := buf.WriteTo(f.out)
+		return err
+
+	case "yaml":
+		// JSON is a subset of YAML, so parsing the JSON into a YAML node
+		// preserves the field order of the message.
+		var node yaml.Node
+		if err := yaml.Unmarshal(data, &node); err != nil {
+			return err
+		}
+		resetStyle(&node)
+
+		enc := yaml.NewEncoder(f.out)
+		enc.SetIndent(2)
+
+		if err := enc.Encode(&node); err != nil {
+			return err
+		}
+
+		return enc.Close()
+
+	default:
+		var v any
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+
+		if err := f.tmpl.Execute(f.out, v); err != nil {
+			return err
+		}
+
+		_, err := io.WriteString(f.out, "\n")
+		return err
+	}
+}
+
+// marshalJSON returns the JSON representation of m.
+func marshalJSON(m proto.Message) ([]byte, error) {
+	return protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+	}.Marshal(m)
+}
+
+// resetStyle recursively resets the style of a YAML node so that it is
+// rendered in YAML's default block style, rather than the JSON-like style in
+// which it was parsed.
+func resetStyle(n *yaml.Node) {
+	n.Style = 0
+
+	for _, c := range n.Content {
+		resetStyle(c)
+	}
+}
@@ -0,0 +1,155 @@
+package render_test
+
+import (
+	"strings"
+
+	"github.com/gritcli/grit/api"
+	. "github.com/gritcli/grit/cli/internal/render"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Formatter", func() {
+	var (
+		buf   *strings.Builder
+		repoA *api.RemoteRepo
+		repoB *api.RemoteRepo
+	)
+
+	BeforeEach(func() {
+		buf = &strings.Builder{}
+
+		repoA = &api.RemoteRepo{
+			Id:     "<id-a>",
+			Source: "<source>",
+			Name:   "owner/a",
+		}
+
+		repoB = &api.RemoteRepo{
+			Id:     "<id-b>",
+			Source: "<source>",
+			Name:   "owner/b",
+		}
+	})
+
+	Describe("func NewFormatter()", func() {
+		DescribeTable(
+			"it returns nil for the human-readable format",
+			func(format string) {
+				f, err := NewFormatter(format, buf)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(f).To(BeNil())
+			},
+			Entry("empty", ""),
+			Entry("text", "text"),
+		)
+
+		It("returns an error if the format is not recognized", func() {
+			_, err := NewFormatter("xml", buf)
+			Expect(err).To(MatchError("unsupported output format (xml), expected 'text', 'json', 'yaml' or a Go template"))
+		})
+
+		It("returns an error if the template is invalid", func() {
+			_, err := NewFormatter("{{.name", buf)
+			Expect(err).To(MatchError(ContainSubstring("unable to parse output template")))
+		})
+	})
+
+	When("using the JSON format", func() {
+		var f *Formatter
+
+		BeforeEach(func() {
+			var err error
+			f, err = NewFormatter("json", buf)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("renders a single message as an object", func() {
+			err := f.Write(repoA)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(Equal(`{
+  "id": "<id-a>",
+  "source": "<source>",
+  "name": "owner/a",
+  "description": "",
+  "web_url": "",
+  "issues_url": "",
+  "pull_requests_url": ""
+}
+`))
+		})
+
+		It("renders a list of messages as an array", func() {
+			err := WriteList(f, []*api.RemoteRepo{repoA, repoB})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(Equal(`[
+  {
+    "id": "<id-a>",
+    "source": "<source>",
+    "name": "owner/a",
+    "description": "",
+    "web_url": "",
+    "issues_url": "",
+    "pull_requests_url": ""
+  },
+  {
+    "id": "<id-b>",
+    "source": "<source>",
+    "name": "owner/b",
+    "description": "",
+    "web_url": "",
+    "issues_url": "",
+    "pull_requests_url": ""
+  }
+]
+`))
+		})
+
+		It("renders an empty list as an empty array", func() {
+			err := WriteList(f, []*api.RemoteRepo{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(Equal("[]\n"))
+		})
+	})
+
+	When("using the YAML format", func() {
+		var f *Formatter
+
+		BeforeEach(func() {
+			var err error
+			f, err = NewFormatter("yaml", buf)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("renders nested messages in block style", func() {
+			err := WriteList(f, []*api.LocalRepo{
+				{
+					RemoteRepo:       repoA,
+					AbsoluteCloneDir: "/path/to/clone",
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(Equal(`- remote_repo:
+    id: <id-a>
+    source: <source>
+    name: owner/a
+    description: ""
+    web_url: ""
+    issues_url: ""
+    pull_requests_url: ""
+  absolute_clone_dir: /path/to/clone
+`))
+		})
+	})
+
+	When("using a template", func() {
+		It("executes the template once for each message", func() {
+			f, err := NewFormatter("{{.source}} {{.name}}", buf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = WriteList(f, []*api.RemoteRepo{repoA, repoB})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(Equal("<source> owner/a\n<source> owner/b\n"))
+		})
+	})
+})
@@ -0,0 +1,50 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/gritcli/grit/api"
+)
+
+// Branch returns a human-readable description of the checked-out
+// branch.
+func Branch(s *api.LocalRepoStatus) string {
+	if b := s.GetBranch(); b != "" {
+		return b
+	}
+
+	if h := s.GetHead(); len(h) >= 7 {
+		return "(detached at " + h[:7] + ")"
+	}
+
+	return "-"
+}
+
+// State returns a human-readable summary of the changes in a local clone
+// that have not been pushed to a remote repository.
+func State(l *api.LocalRepoListing) string {
+	if err := l.GetStatusError(); err != "" {
+		return "error: " + err
+	}
+
+	s := l.GetStatus()
+	var changes []string
+
+	if s.GetHasUncommittedChanges() {
+		changes = append(changes, "uncommitted changes")
+	}
+
+	if b := s.GetUnpushedBranches(); len(b) != 0 {
+		changes = append(changes, "unpushed commits ("+strings.Join(b, ", ")+")")
+	}
+
+	if s.GetHasStashes() {
+		changes = append(changes, "stashed changes")
+	}
+
+	if len(changes) == 0 {
+		return "clean"
+	}
+
+	return strings.Join(changes, ", ")
+}
@@ -0,0 +1,51 @@
+package render_test
+
+import (
+	"github.com/gritcli/grit/api"
+	. "github.com/gritcli/grit/cli/internal/render"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func Branch()", func() {
+	It("returns the branch name", func() {
+		Expect(Branch(&api.LocalRepoStatus{
+			Branch: "main",
+			Head:   "0123456789abcdef",
+		})).To(Equal("main"))
+	})
+
+	It("returns the abbreviated commit hash when the HEAD is detached", func() {
+		Expect(Branch(&api.LocalRepoStatus{
+			Head: "0123456789abcdef",
+		})).To(Equal("(detached at 0123456)"))
+	})
+
+	It("returns a placeholder when the status is unknown", func() {
+		Expect(Branch(nil)).To(Equal("-"))
+	})
+})
+
+var _ = Describe("func State()", func() {
+	It("returns the status error", func() {
+		Expect(State(&api.LocalRepoListing{
+			StatusError: "<error>",
+		})).To(Equal("error: <error>"))
+	})
+
+	It("describes a clean clone", func() {
+		Expect(State(&api.LocalRepoListing{
+			Status: &api.LocalRepoStatus{},
+		})).To(Equal("clean"))
+	})
+
+	It("describes all unpushed changes", func() {
+		Expect(State(&api.LocalRepoListing{
+			Status: &api.LocalRepoStatus{
+				HasUncommittedChanges: true,
+				UnpushedBranches:      []string{"main", "feature"},
+				HasStashes:            true,
+			},
+		})).To(Equal("uncommitted changes, unpushed commits (main, feature), stashed changes"))
+	})
+})
@@ -8,6 +8,11 @@ daemon {
   # communication between the Grit CLI and the daemon. It defaults to
   # "~/grit/daemon.socket".
   socket = "/path/to/socket"
+
+  # The "data_dir" attribute is the path to a directory in which the daemon
+  # stores its persistent state, such as the index of local clones. It
+  # defaults to "~/grit/.data".
+  data_dir = "/path/to/data"
 }
 
 # The "clones" block configures Grit behaves with working with local clones of
@@ -19,6 +24,35 @@ clones {
   # The "dir" attribute is the path to a directory into which local clones are
   # placed. It defaults to "~/grit".
   dir = "/path/to/clones"
+
+  # The "archive_dir" attribute is the path to a directory into which archived
+  # clones are placed by the "grit archive" command. It defaults to
+  # "~/grit/.archive".
+  archive_dir = "/path/to/archive"
+
+  # The "archive_after" attribute is the period of inactivity after which a
+  # clone is eligible to be archived. It accepts Go duration syntax, such as
+  # "720h", or a number of days, such as "90d". It defaults to "365d".
+  archive_after = "365d"
+
+  # The "layout" attribute is a template for the path of each clone within its
+  # source's clone directory. Each "{field}" placeholder is replaced with
+  # information about the repository.
+  #
+  # The "source", "id" and "dir" fields are available for every source, where
+  # "dir" is the directory chosen by the source's driver. Drivers may provide
+  # additional fields, for example the "github" driver provides "domain",
+  # "owner" and "name".
+  #
+  # For example, "{domain}/{owner}/{name}" produces a GOPATH-like tree, whereas
+  # "{name}" places every clone directly within the clone directory. Grit
+  # refuses to clone a repository into a directory that is already used by a
+  # clone of a different repository. Use "grit relocate" to move existing
+  # clones after changing the layout.
+  #
+  # By default each clone is placed in the directory chosen by the driver,
+  # which is equivalent to "{dir}".
+  layout = "{dir}"
 }
 
 # The "git" block configures how Grit's default behavior when working with Git
@@ -32,6 +66,37 @@ git {
   # defaults to false.
   prefer_http = false
 
+  # The "depth" attribute limits the history of new clones to the given number
+  # of commits, producing a "shallow" clone. It defaults to 0, which clones the
+  # full history.
+  depth = 0
+
+  # The "single_branch" attribute instructs Grit to clone only the repository's
+  # default branch. It defaults to false.
+  single_branch = false
+
+  # The "user_name" and "user_email" attributes are written into the
+  # configuration of each new clone and used as the author and committer of
+  # commits made within it. By default Git's global configuration is used.
+  user_name  = "Jane Doe"
+  user_email = "jane@example.org"
+
+  # The "signing_key" attribute is the key used to sign commits and tags made
+  # within new clones. When it is set, commit and tag signing is enabled in
+  # each new clone. By default Git's global configuration is used.
+  signing_key = "<key id or path to ssh public key>"
+
+  # The "signing_format" attribute is the format of the signing key, one of
+  # "openpgp", "x509" or "ssh". By default Git's global configuration is used.
+  signing_format = "ssh"
+
+  # The "mirror_dir" attribute is a directory in which Grit keeps a bare mirror
+  # of each repository it clones. New clones borrow objects from the mirror
+  # instead of downloading them again, and the daemon refreshes the mirrors
+  # periodically. Mirrors must not be removed while clones still refer to them.
+  # Mirrors are not used for shallow clones. By default no mirrors are kept.
+  mirror_dir = "~/.cache/grit/mirrors"
+
   # The "ssh_key" block explicitly defines an SSH key to use for Git
   # operations.
   #
@@ -48,6 +113,30 @@ git {
   }
 }
 
+# A "hook" block defines a command that Grit runs in response to an event.
+#
+# A configuration may contain any number of "hook" blocks, which may also be
+# placed within a "source" block to apply only to repositories from that source.
+# Hooks are run in the order they are defined, with the global hooks running
+# before any source-specific hooks.
+#
+# The first parameter is the name of the event that triggers the hook. The only
+# supported event is "post_clone", which occurs after a repository is cloned.
+#
+# The command is run within the clone directory. Its output is shown to the
+# user and information about the repository is available in the GRIT_SOURCE,
+# GRIT_REPO_ID, GRIT_REPO_NAME, GRIT_REPO_DESCRIPTION, GRIT_REPO_WEB_URL and
+# GRIT_CLONE_DIR environment variables.
+hook "post_clone" {
+  # The "command" attribute is the command to run and its arguments.
+  command = ["go", "mod", "download"]
+
+  # The "required" attribute controls whether a failure of the hook causes the
+  # clone to fail, in which case the new clone is removed. Otherwise, the
+  # failure is only reported. It defaults to false.
+  required = false
+}
+
 # A "source" block defines a repository source that hosts the repositories that
 # may be cloned by Grit.
 #
@@ -81,6 +170,27 @@ source "example_source" "some_driver" {
     # For example, if the top-level "git" block uses a "dir" of "~/clones", the
     # default for this source would be "~/clones/example_source".
     dir = "/path/to/somewhere/else"
+
+    # The "archive_dir" attribute is the path to a directory into which
+    # archived clones from this source are placed.
+    #
+    # By default, archives from this source are kept in a sub-directory of the
+    # top-level archive directory, named the same as the source.
+    archive_dir = "/path/to/somewhere/else/.archive"
+
+    # The "archive_after" attribute overrides the top-level "archive_after"
+    # period for clones from this source.
+    archive_after = "90d"
+
+    # The "layout" attribute overrides the top-level clones layout for clones
+    # from this source.
+    layout = "{owner}/{name}"
+  }
+
+  # The "hook" blocks within a source are run only for repositories from this
+  # source, after any global hooks.
+  hook "post_clone" {
+    command = ["direnv", "allow"]
   }
 
   # Each source may contain additional options that are specific to the chosen
@@ -24,28 +24,73 @@ func init() {
 		},
 	)
 
-	imbue.Decorate5(
+	imbue.With4(
 		catalog,
 		func(
 			ctx imbue.Context,
-			svr *grpc.Server,
 			ver imbue.ByName[version, string],
 			sources source.List,
+			x *source.Index,
+			log logs.Log,
+		) (*apiserver.Server, error) {
+			return &apiserver.Server{
+				Version:    ver.Value(),
+				PID:        os.Getpid(),
+				SourceList: sources,
+				Index:      x,
+				Log:        log.WithPrefix("api: "),
+			}, nil
+		},
+	)
+
+	// Provide the API server with the services that perform operations on
+	// repositories.
+	imbue.Decorate8(
+		catalog,
+		func(
+			ctx imbue.Context,
+			svr *apiserver.Server,
 			c *source.Cloner,
+			u *source.Updater,
+			r *source.Remover,
+			a *source.Archiver,
+			ad *source.Adopter,
+			rl *source.Relocator,
 			s *source.Suggester,
-			log logs.Log,
+			w *source.WorktreeManager,
+		) (*apiserver.Server, error) {
+			svr.Cloner = c
+			svr.Updater = u
+			svr.Remover = r
+			svr.Archiver = a
+			svr.Adopter = ad
+			svr.Relocator = rl
+			svr.Suggester = s
+			svr.Worktrees = w
+			return svr, nil
+		},
+	)
+
+	imbue.Decorate1(
+		catalog,
+		func(
+			ctx imbue.Context,
+			svr *apiserver.Server,
+			pr *source.PullRequestManager,
+		) (*apiserver.Server, error) {
+			svr.PullRequests = pr
+			return svr, nil
+		},
+	)
+
+	imbue.Decorate1(
+		catalog,
+		func(
+			ctx imbue.Context,
+			svr *grpc.Server,
+			s *apiserver.Server,
 		) (*grpc.Server, error) {
-			api.RegisterAPIServer(
-				svr,
-				&apiserver.Server{
-					Version:    ver.Value(),
-					PID:        os.Getpid(),
-					SourceList: sources,
-					Cloner:     c,
-					Suggester:  s,
-					Log:        log.WithPrefix("api: "),
-				},
-			)
+			api.RegisterAPIServer(svr, s)
 			return svr, nil
 		},
 	)
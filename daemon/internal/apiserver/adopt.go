@@ -0,0 +1,39 @@
+package apiserver
+
+import (
+	"github.com/gritcli/grit/api"
+	"google.golang.org/protobuf/proto"
+)
+
+// AdoptRepo moves an existing clone from outside of Grit's clone directory into
+// the location Grit would use if it had cloned it.
+func (s *Server) AdoptRepo(
+	req *api.AdoptRepoRequest,
+	stream api.API_AdoptRepoServer,
+) error {
+	local, err := s.Adopter.Adopt(
+		stream.Context(),
+		req.AbsoluteDir,
+		req.Symlink,
+		s.newClientLog(
+			stream,
+			req.ClientOptions,
+			func(out *api.ClientOutput) proto.Message {
+				return &api.AdoptRepoResponse{
+					Response: &api.AdoptRepoResponse_Output{
+						Output: out,
+					},
+				}
+			},
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&api.AdoptRepoResponse{
+		Response: &api.AdoptRepoResponse_LocalRepo{
+			LocalRepo: marshalLocalRepo(local),
+		},
+	})
+}
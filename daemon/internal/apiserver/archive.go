@@ -0,0 +1,132 @@
+package apiserver
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/daemon/internal/config"
+	"github.com/gritcli/grit/daemon/internal/source"
+	"google.golang.org/protobuf/proto"
+)
+
+// ArchiveRepos moves local clones that have not been used recently into
+// compressed archives.
+func (s *Server) ArchiveRepos(
+	req *api.ArchiveReposRequest,
+	stream api.API_ArchiveReposServer,
+) error {
+	var inactiveFor time.Duration
+	if req.InactiveFor != "" {
+		d, err := config.ParseDuration(req.InactiveFor)
+		if err != nil {
+			return fmt.Errorf("unable to parse inactivity period: %w", err)
+		}
+		inactiveFor = d
+	}
+
+	repos, err := s.filterLocalRepos(req.SourceFilter, req.NamePattern)
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	out := &syncStream{ServerStream: stream}
+
+	return forEachLocalRepo(
+		repos,
+		req.Concurrency,
+		func(r source.LocalRepo) error {
+			log := s.newClientLog(
+				out,
+				req.ClientOptions,
+				func(out *api.ClientOutput) proto.Message {
+					return &api.ArchiveReposResponse{
+						Response: &api.ArchiveReposResponse_Output{
+							Output: out,
+						},
+					}
+				},
+			).WithPrefix("%s: ", r.Name)
+
+			result := &api.ArchiveRepoResult{
+				LocalRepo: marshalLocalRepo(r),
+			}
+
+			if res, err := s.Archiver.Archive(ctx, r, inactiveFor, req.DryRun, log); err != nil {
+				result.Error = err.Error()
+			} else {
+				result.Eligible = res.Eligible
+				result.ArchiveFile = res.File
+				result.SkipReason = res.SkipReason
+			}
+
+			return out.SendMsg(&api.ArchiveReposResponse{
+				Response: &api.ArchiveReposResponse_Result{
+					Result: result,
+				},
+			})
+		},
+	)
+}
+
+// UnarchiveRepo restores an archived clone to its original location.
+func (s *Server) UnarchiveRepo(
+	req *api.UnarchiveRepoRequest,
+	stream api.API_UnarchiveRepoServer,
+) error {
+	archives, err := s.Archiver.Resolve(req.Query)
+	if err != nil {
+		return err
+	}
+
+	var matches []source.Archive
+	for _, a := range archives {
+		if hasSource(req.SourceFilter, a.Source.Name) {
+			matches = append(matches, a)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return fmt.Errorf("no archived clones match '%s'", req.Query)
+	case 1:
+	default:
+		var names []string
+		for _, a := range matches {
+			names = append(names, fmt.Sprintf("%s (%s)", a.Name, a.Source.Name))
+		}
+
+		return fmt.Errorf(
+			"multiple archived clones match '%s': %s",
+			req.Query,
+			strings.Join(names, ", "),
+		)
+	}
+
+	repo, err := s.Archiver.Unarchive(
+		stream.Context(),
+		matches[0],
+		s.newClientLog(
+			stream,
+			req.ClientOptions,
+			func(out *api.ClientOutput) proto.Message {
+				return &api.UnarchiveRepoResponse{
+					Response: &api.UnarchiveRepoResponse_Output{
+						Output: out,
+					},
+				}
+			},
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&api.UnarchiveRepoResponse{
+		Response: &api.UnarchiveRepoResponse_LocalRepo{
+			LocalRepo: marshalLocalRepo(repo),
+		},
+	})
+}
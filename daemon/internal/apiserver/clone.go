@@ -2,6 +2,10 @@ package apiserver
 
 import (
 	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	"github.com/gritcli/grit/daemon/internal/source"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -14,16 +18,20 @@ func (s *Server) CloneRepo(
 		stream.Context(),
 		req.Source,
 		req.RepoId,
-		s.newClientLog(
+		unmarshalCloneOptions(req.CloneOptions),
+		s.newCloneLog(
 			stream,
-			req.ClientOptions,
-			func(out *api.ClientOutput) proto.Message {
-				return &api.CloneRepoResponse{
-					Response: &api.CloneRepoResponse_Output{
-						Output: out,
-					},
-				}
-			},
+			s.newClientLog(
+				stream,
+				req.ClientOptions,
+				func(out *api.ClientOutput) proto.Message {
+					return &api.CloneRepoResponse{
+						Response: &api.CloneRepoResponse_Output{
+							Output: out,
+						},
+					}
+				},
+			),
 		),
 	)
 	if err != nil {
@@ -36,3 +44,140 @@ func (s *Server) CloneRepo(
 		},
 	})
 }
+
+// newCloneLog returns a logs.Log that sends progress information to the gRPC
+// client as structured progress messages, and all other messages to log.
+func (s *Server) newCloneLog(
+	stream api.API_CloneRepoServer,
+	log logs.Log,
+) logs.Log {
+	return func(m logs.Message) {
+		if m.Progress == nil {
+			log(m)
+			return
+		}
+
+		if err := stream.Send(&api.CloneRepoResponse{
+			Response: &api.CloneRepoResponse_Progress{
+				Progress: &api.CloneProgress{
+					Phase:   m.Progress.Phase,
+					Current: m.Progress.Current,
+					Total:   m.Progress.Total,
+					Bytes:   m.Progress.Bytes,
+				},
+			},
+		}); err != nil {
+			s.Log.Write("unable to write message to stream: %s", err)
+		}
+	}
+}
+
+// CloneRepos makes local clones of multiple repositories in parallel, skipping
+// any that have already been cloned.
+func (s *Server) CloneRepos(
+	req *api.CloneReposRequest,
+	stream api.API_CloneReposServer,
+) error {
+	repos, err := s.Index.List()
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	out := &syncStream{ServerStream: stream}
+
+	g := &errgroup.Group{}
+	g.SetLimit(concurrency(req.Concurrency))
+
+	for _, t := range req.Targets {
+		t := t // capture loop variable
+
+		g.Go(func() error {
+			result := &api.CloneRepoResult{
+				Target: t,
+			}
+
+			if r, ok := findLocalRepo(repos, t.Source, t.RepoId); ok {
+				result.LocalRepo = marshalLocalRepo(r)
+				result.AlreadyCloned = true
+			} else {
+				log := s.newClientLog(
+					out,
+					req.ClientOptions,
+					func(out *api.ClientOutput) proto.Message {
+						return &api.CloneReposResponse{
+							Response: &api.CloneReposResponse_Output{
+								Output: out,
+							},
+						}
+					},
+				).WithPrefix("%s/%s: ", t.Source, t.RepoId)
+
+				// Progress information is not sent to the client when cloning
+				// multiple repositories, as the interleaved progress of each
+				// clone is too noisy to be useful.
+				r, err := s.Cloner.Clone(
+					ctx,
+					t.Source,
+					t.RepoId,
+					unmarshalCloneOptions(req.CloneOptions),
+					func(m logs.Message) {
+						if m.Progress == nil {
+							log(m)
+						}
+					},
+				)
+				if err != nil {
+					result.Error = err.Error()
+				} else {
+					result.LocalRepo = marshalLocalRepo(r)
+				}
+			}
+
+			return out.SendMsg(&api.CloneReposResponse{
+				Response: &api.CloneReposResponse_Result{
+					Result: result,
+				},
+			})
+		})
+	}
+
+	return g.Wait()
+}
+
+// unmarshalCloneOptions unmarshals clone options from their API
+// representation.
+//
+// If o is nil, the zero-value is returned, such that the source's
+// configuration is used.
+func unmarshalCloneOptions(o *api.CloneOptions) sourcedriver.CloneOptions {
+	if o == nil {
+		return sourcedriver.CloneOptions{}
+	}
+
+	opts := sourcedriver.CloneOptions{
+		SingleBranch: o.SingleBranch,
+	}
+
+	if o.Depth != nil {
+		depth := int(*o.Depth)
+		opts.Depth = &depth
+	}
+
+	return opts
+}
+
+// findLocalRepo returns the local clone of the repository with the given
+// source and ID, if any.
+func findLocalRepo(
+	repos []source.LocalRepo,
+	sourceName, repoID string,
+) (source.LocalRepo, bool) {
+	for _, r := range repos {
+		if r.Source.Name == sourceName && r.ID == repoID {
+			return r, true
+		}
+	}
+
+	return source.LocalRepo{}, false
+}
@@ -0,0 +1,47 @@
+package apiserver
+
+import (
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"google.golang.org/protobuf/proto"
+)
+
+// CreateRepo creates a new repository on a source, then makes a local clone of
+// it.
+func (s *Server) CreateRepo(
+	req *api.CreateRepoRequest,
+	stream api.API_CreateRepoServer,
+) error {
+	repo, err := s.Cloner.Create(
+		stream.Context(),
+		req.Source,
+		req.Name,
+		sourcedriver.CreateOptions{
+			Private:  req.Private,
+			Template: req.Template,
+		},
+		s.newCloneLog(
+			stream,
+			s.newClientLog(
+				stream,
+				req.ClientOptions,
+				func(out *api.ClientOutput) proto.Message {
+					return &api.CloneRepoResponse{
+						Response: &api.CloneRepoResponse_Output{
+							Output: out,
+						},
+					}
+				},
+			),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&api.CloneRepoResponse{
+		Response: &api.CloneRepoResponse_LocalRepo{
+			LocalRepo: marshalLocalRepo(repo),
+		},
+	})
+}
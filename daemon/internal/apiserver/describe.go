@@ -0,0 +1,82 @@
+package apiserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/source"
+)
+
+// DescribeRepo returns detailed information about a single repository,
+// including the state of any local clones.
+func (s *Server) DescribeRepo(
+	ctx context.Context,
+	req *api.DescribeRepoRequest,
+) (*api.DescribeRepoResponse, error) {
+	src, ok := s.SourceList.ByName(req.GetSource())
+	if !ok {
+		return nil, fmt.Errorf("unrecognized source (%s)", req.GetSource())
+	}
+
+	log := src.Log(s.Log)
+
+	all, err := s.Index.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var repos []source.LocalRepo
+	for _, r := range all {
+		if r.Source.Name == src.Name && r.ID == req.GetRepoId() {
+			repos = append(repos, r)
+		}
+	}
+
+	res := &api.DescribeRepoResponse{
+		LocalRepos: make([]*api.LocalRepoListing, len(repos)),
+	}
+
+	for i, r := range repos {
+		listing := &api.LocalRepoListing{
+			LocalRepo: marshalLocalRepo(r),
+		}
+
+		status, err := r.Status(ctx, log)
+		if err != nil {
+			listing.StatusError = err.Error()
+		} else {
+			listing.Status = marshalLocalStatus(status)
+		}
+
+		res.LocalRepos[i] = listing
+	}
+
+	cloner, repo, err := src.Driver.Cloner(ctx, req.GetRepoId(), log)
+	if err != nil {
+		// If the source can not be reached we can still describe the
+		// repository using the information recorded when it was cloned.
+		if len(repos) == 0 {
+			return nil, err
+		}
+
+		res.RemoteRepo = marshalRemoteRepo(src.Name, repos[0].RemoteRepo)
+		res.RemoteError = err.Error()
+
+		return res, nil
+	}
+
+	res.RemoteRepo = marshalRemoteRepo(src.Name, repo)
+
+	if l, ok := cloner.(sourcedriver.CloneURLLister); ok {
+		for _, u := range l.CloneURLs() {
+			res.CloneUrls = append(res.CloneUrls, &api.CloneURL{
+				Protocol: u.Protocol,
+				Url:      u.URL,
+			})
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,234 @@
+package apiserver_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/gritcli/grit/api"
+	. "github.com/gritcli/grit/daemon/internal/apiserver"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	"github.com/gritcli/grit/daemon/internal/source"
+	"github.com/gritcli/grit/daemon/internal/stubs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// cloneURLCloner is a sourcedriver.Cloner that lists its clone URLs.
+type cloneURLCloner struct {
+	stubs.SourceCloner
+	URLs []sourcedriver.CloneURL
+}
+
+func (c *cloneURLCloner) CloneURLs() []sourcedriver.CloneURL {
+	return c.URLs
+}
+
+var _ = Describe("func Server.DescribeRepo()", func() {
+	var (
+		tempDir string
+		remote  sourcedriver.RemoteRepo
+		driver  *stubs.Source
+		src     source.Source
+		index   *source.Index
+		server  *Server
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = os.MkdirTemp("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(func() {
+			os.RemoveAll(tempDir)
+		})
+
+		remote = sourcedriver.RemoteRepo{
+			ID:               "<id>",
+			Name:             "owner/repo",
+			Description:      "<description>",
+			WebURL:           "<web-url>",
+			RelativeCloneDir: "owner/repo",
+		}
+
+		driver = &stubs.Source{
+			ClonerFunc: func(
+				context.Context,
+				string,
+				logs.Log,
+			) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error) {
+				return &cloneURLCloner{
+					URLs: []sourcedriver.CloneURL{
+						{Protocol: "https", URL: "<https-url>"},
+						{Protocol: "ssh", URL: "<ssh-url>"},
+					},
+				}, remote, nil
+			},
+			LocalCloneFunc: func(
+				context.Context,
+				string,
+				logs.Log,
+			) (sourcedriver.LocalClone, error) {
+				return &stubs.LocalClone{
+					StatusFunc: func(context.Context, logs.Log) (sourcedriver.LocalStatus, error) {
+						return sourcedriver.LocalStatus{
+							Branch:                "main",
+							Head:                  "<head>",
+							HasUncommittedChanges: true,
+						}, nil
+					},
+				}, nil
+			},
+		}
+
+		src = source.Source{
+			Name:         "<source>",
+			BaseCloneDir: filepath.Join(tempDir, "clones"),
+			Driver:       driver,
+		}
+
+		index = &source.Index{
+			File:    filepath.Join(tempDir, "data", "clones.json"),
+			Sources: source.List{src},
+		}
+
+		server = &Server{
+			SourceList: source.List{src},
+			Index:      index,
+			Log:        logs.Discard,
+		}
+	})
+
+	// addClone adds a local clone of the remote repository to the index.
+	addClone := func() string {
+		dir := filepath.Join(src.BaseCloneDir, "owner", "repo")
+		Expect(os.MkdirAll(dir, 0700)).To(Succeed())
+
+		err := index.Add(source.LocalRepo{
+			RemoteRepo:       remote,
+			Source:           src,
+			AbsoluteCloneDir: dir,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		return dir
+	}
+
+	describe := func() (*api.DescribeRepoResponse, error) {
+		return server.DescribeRepo(
+			context.Background(),
+			&api.DescribeRepoRequest{
+				Source: "<source>",
+				RepoId: "<id>",
+			},
+		)
+	}
+
+	It("describes a repository that has not been cloned", func() {
+		res, err := describe()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		r := res.GetRemoteRepo()
+		Expect(r.GetId()).To(Equal("<id>"))
+		Expect(r.GetSource()).To(Equal("<source>"))
+		Expect(r.GetName()).To(Equal("owner/repo"))
+		Expect(r.GetDescription()).To(Equal("<description>"))
+		Expect(r.GetWebUrl()).To(Equal("<web-url>"))
+
+		var urls []string
+		for _, u := range res.GetCloneUrls() {
+			urls = append(urls, u.GetProtocol()+" "+u.GetUrl())
+		}
+		Expect(urls).To(Equal([]string{"https <https-url>", "ssh <ssh-url>"}))
+
+		Expect(res.GetLocalRepos()).To(BeEmpty())
+		Expect(res.GetRemoteError()).To(BeEmpty())
+	})
+
+	It("describes a repository that has been cloned", func() {
+		dir := addClone()
+
+		res, err := describe()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(res.GetRemoteRepo().GetName()).To(Equal("owner/repo"))
+		Expect(res.GetCloneUrls()).To(HaveLen(2))
+		Expect(res.GetRemoteError()).To(BeEmpty())
+
+		Expect(res.GetLocalRepos()).To(HaveLen(1))
+		l := res.GetLocalRepos()[0]
+		Expect(l.GetLocalRepo().GetAbsoluteCloneDir()).To(Equal(dir))
+		Expect(l.GetLocalRepo().GetRemoteRepo().GetId()).To(Equal("<id>"))
+		Expect(l.GetStatus().GetBranch()).To(Equal("main"))
+		Expect(l.GetStatus().GetHead()).To(Equal("<head>"))
+		Expect(l.GetStatus().GetHasUncommittedChanges()).To(BeTrue())
+		Expect(l.GetStatusError()).To(BeEmpty())
+	})
+
+	It("describes a cloned repository using the index if the source can not be reached", func() {
+		dir := addClone()
+
+		driver.ClonerFunc = func(
+			context.Context,
+			string,
+			logs.Log,
+		) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error) {
+			return nil, sourcedriver.RemoteRepo{}, errors.New("<error>")
+		}
+
+		res, err := describe()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(res.GetRemoteRepo().GetName()).To(Equal("owner/repo"))
+		Expect(res.GetRemoteRepo().GetWebUrl()).To(Equal("<web-url>"))
+		Expect(res.GetRemoteError()).To(Equal("<error>"))
+		Expect(res.GetCloneUrls()).To(BeEmpty())
+
+		Expect(res.GetLocalRepos()).To(HaveLen(1))
+		Expect(res.GetLocalRepos()[0].GetLocalRepo().GetAbsoluteCloneDir()).To(Equal(dir))
+	})
+
+	It("reports errors determining the status of a local clone", func() {
+		addClone()
+
+		driver.LocalCloneFunc = func(
+			context.Context,
+			string,
+			logs.Log,
+		) (sourcedriver.LocalClone, error) {
+			return nil, errors.New("<error>")
+		}
+
+		res, err := describe()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(res.GetLocalRepos()).To(HaveLen(1))
+		Expect(res.GetLocalRepos()[0].GetStatus()).To(BeNil())
+		Expect(res.GetLocalRepos()[0].GetStatusError()).To(Equal("unable to open local clone: <error>"))
+	})
+
+	It("returns an error if the repository has not been cloned and the source can not be reached", func() {
+		driver.ClonerFunc = func(
+			context.Context,
+			string,
+			logs.Log,
+		) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error) {
+			return nil, sourcedriver.RemoteRepo{}, errors.New("<error>")
+		}
+
+		_, err := describe()
+		Expect(err).To(MatchError("<error>"))
+	})
+
+	It("returns an error if the source is not recognized", func() {
+		_, err := server.DescribeRepo(
+			context.Background(),
+			&api.DescribeRepoRequest{
+				Source: "<unknown>",
+				RepoId: "<id>",
+			},
+		)
+		Expect(err).To(MatchError("unrecognized source (<unknown>)"))
+	})
+})
@@ -0,0 +1,54 @@
+package apiserver
+
+import (
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/daemon/internal/source"
+	"google.golang.org/protobuf/proto"
+)
+
+// FetchRepos fetches changes from the remote repositories of existing local
+// clones without modifying their working trees.
+func (s *Server) FetchRepos(
+	req *api.FetchReposRequest,
+	stream api.API_FetchReposServer,
+) error {
+	repos, err := s.filterLocalRepos(req.SourceFilter, req.NamePattern)
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	out := &syncStream{ServerStream: stream}
+
+	return forEachLocalRepo(
+		repos,
+		req.Concurrency,
+		func(r source.LocalRepo) error {
+			log := s.newClientLog(
+				out,
+				req.ClientOptions,
+				func(out *api.ClientOutput) proto.Message {
+					return &api.FetchReposResponse{
+						Response: &api.FetchReposResponse_Output{
+							Output: out,
+						},
+					}
+				},
+			).WithPrefix("%s: ", r.Name)
+
+			result := &api.FetchRepoResult{
+				LocalRepo: marshalLocalRepo(r),
+			}
+
+			if err := s.Updater.Fetch(ctx, r, log); err != nil {
+				result.Error = err.Error()
+			}
+
+			return out.SendMsg(&api.FetchReposResponse{
+				Response: &api.FetchReposResponse_Result{
+					Result: result,
+				},
+			})
+		},
+	)
+}
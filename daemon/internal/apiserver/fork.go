@@ -0,0 +1,43 @@
+package apiserver
+
+import (
+	"github.com/gritcli/grit/api"
+	"google.golang.org/protobuf/proto"
+)
+
+// ForkRepo creates a fork of a repository from a source, then makes a local
+// clone of the fork.
+func (s *Server) ForkRepo(
+	req *api.ForkRepoRequest,
+	stream api.API_ForkRepoServer,
+) error {
+	repo, err := s.Cloner.Fork(
+		stream.Context(),
+		req.Source,
+		req.RepoId,
+		unmarshalCloneOptions(req.CloneOptions),
+		s.newCloneLog(
+			stream,
+			s.newClientLog(
+				stream,
+				req.ClientOptions,
+				func(out *api.ClientOutput) proto.Message {
+					return &api.CloneRepoResponse{
+						Response: &api.CloneRepoResponse_Output{
+							Output: out,
+						},
+					}
+				},
+			),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&api.CloneRepoResponse{
+		Response: &api.CloneRepoResponse_LocalRepo{
+			LocalRepo: marshalLocalRepo(repo),
+		},
+	})
+}
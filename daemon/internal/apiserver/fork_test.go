@@ -0,0 +1,145 @@
+package apiserver_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/gritcli/grit/api"
+	. "github.com/gritcli/grit/daemon/internal/apiserver"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	"github.com/gritcli/grit/daemon/internal/source"
+	"github.com/gritcli/grit/daemon/internal/stubs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// cloneStream is an api.API_ForkRepoServer that records the messages sent to
+// the client.
+type cloneStream struct {
+	grpc.ServerStream
+	Responses []*api.CloneRepoResponse
+}
+
+func (s *cloneStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *cloneStream) Send(res *api.CloneRepoResponse) error {
+	s.Responses = append(s.Responses, res)
+	return nil
+}
+
+func (s *cloneStream) SendMsg(m any) error {
+	return s.Send(m.(*api.CloneRepoResponse))
+}
+
+var _ = Describe("func Server.ForkRepo()", func() {
+	var (
+		tempDir string
+		remote  sourcedriver.RemoteRepo
+		opts    *sourcedriver.CloneOptions
+		server  *Server
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = os.MkdirTemp("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(func() {
+			os.RemoveAll(tempDir)
+		})
+
+		remote = sourcedriver.RemoteRepo{
+			ID:               "<fork-id>",
+			Name:             "user/repo",
+			RelativeCloneDir: "user/repo",
+		}
+
+		opts = nil
+
+		driver := &stubs.ForkingSource{
+			ForkFunc: func(
+				context.Context,
+				string,
+				logs.Log,
+			) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error) {
+				return &stubs.SourceCloner{
+					CloneFunc: func(
+						_ context.Context,
+						dir string,
+						o sourcedriver.CloneOptions,
+						_ logs.Log,
+					) error {
+						opts = &o
+						return os.MkdirAll(dir, 0700)
+					},
+				}, remote, nil
+			},
+		}
+
+		src := source.Source{
+			Name:         "<source>",
+			BaseCloneDir: filepath.Join(tempDir, "clones"),
+			Driver:       driver,
+		}
+
+		index := &source.Index{
+			File:    filepath.Join(tempDir, "data", "clones.json"),
+			Sources: source.List{src},
+		}
+
+		server = &Server{
+			SourceList: source.List{src},
+			Index:      index,
+			Cloner: &source.Cloner{
+				Sources: source.List{src},
+				Index:   index,
+				Log:     logs.Discard,
+			},
+			Log: logs.Discard,
+		}
+	})
+
+	It("forks and clones the repository when the request has no clone options", func() {
+		stream := &cloneStream{}
+
+		err := server.ForkRepo(
+			&api.ForkRepoRequest{
+				Source: "<source>",
+				RepoId: "<id>",
+			},
+			stream,
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(opts).To(Equal(&sourcedriver.CloneOptions{}))
+
+		Expect(stream.Responses).NotTo(BeEmpty())
+		local := stream.Responses[len(stream.Responses)-1].GetLocalRepo()
+		Expect(local.GetRemoteRepo().GetId()).To(Equal("<fork-id>"))
+		Expect(local.GetAbsoluteCloneDir()).To(Equal(
+			filepath.Join(tempDir, "clones", "user", "repo"),
+		))
+	})
+
+	It("passes the requested clone options to the source", func() {
+		err := server.ForkRepo(
+			&api.ForkRepoRequest{
+				Source: "<source>",
+				RepoId: "<id>",
+				CloneOptions: &api.CloneOptions{
+					Depth: proto.Uint32(1),
+				},
+			},
+			&cloneStream{},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(opts.Depth).To(HaveValue(Equal(1)))
+		Expect(opts.SingleBranch).To(BeNil())
+	})
+})
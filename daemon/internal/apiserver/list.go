@@ -0,0 +1,74 @@
+package apiserver
+
+import (
+	"context"
+
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/source"
+)
+
+// ListLocalRepos lists the local clones that match a filter.
+func (s *Server) ListLocalRepos(
+	ctx context.Context,
+	req *api.ListLocalReposRequest,
+) (*api.ListLocalReposResponse, error) {
+	repos, err := s.filterLocalRepos(req.SourceFilter, req.NamePattern)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListLocalReposResponse{
+		LocalRepos: make([]*api.LocalRepoListing, len(repos)),
+	}
+
+	for i, r := range repos {
+		res.LocalRepos[i] = &api.LocalRepoListing{
+			LocalRepo: marshalLocalRepo(r),
+		}
+	}
+
+	if !req.IncludeStatus {
+		return res, nil
+	}
+
+	// The map is only read by the goroutines below, and each goroutine writes
+	// to a distinct listing, so no further synchronization is required.
+	index := map[string]*api.LocalRepoListing{}
+	for i, r := range repos {
+		index[r.AbsoluteCloneDir] = res.LocalRepos[i]
+	}
+
+	if err := forEachLocalRepo(
+		repos,
+		0,
+		func(r source.LocalRepo) error {
+			listing := index[r.AbsoluteCloneDir]
+
+			status, err := r.Status(ctx, r.Source.Log(s.Log))
+			if err != nil {
+				listing.StatusError = err.Error()
+			} else {
+				listing.Status = marshalLocalStatus(status)
+			}
+
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// marshalLocalStatus marshals a sourcedriver.LocalStatus to its API
+// representation.
+func marshalLocalStatus(s sourcedriver.LocalStatus) *api.LocalRepoStatus {
+	return &api.LocalRepoStatus{
+		Branch:                s.Branch,
+		Head:                  s.Head,
+		HasUncommittedChanges: s.HasUncommittedChanges,
+		UnpushedBranches:      s.UnpushedBranches,
+		HasStashes:            s.HasStashes,
+	}
+}
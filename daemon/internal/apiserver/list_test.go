@@ -0,0 +1,224 @@
+package apiserver_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/gritcli/grit/api"
+	. "github.com/gritcli/grit/daemon/internal/apiserver"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	"github.com/gritcli/grit/daemon/internal/source"
+	"github.com/gritcli/grit/daemon/internal/stubs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func Server.ListLocalRepos()", func() {
+	var (
+		tempDir  string
+		statuses map[string]sourcedriver.LocalStatus
+		index    *source.Index
+		server   *Server
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = os.MkdirTemp("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(func() {
+			os.RemoveAll(tempDir)
+		})
+
+		statuses = map[string]sourcedriver.LocalStatus{}
+
+		driver := &stubs.Source{
+			LocalCloneFunc: func(
+				_ context.Context,
+				dir string,
+				_ logs.Log,
+			) (sourcedriver.LocalClone, error) {
+				status, ok := statuses[dir]
+				if !ok {
+					return nil, errors.New("<error>")
+				}
+
+				return &stubs.LocalClone{
+					StatusFunc: func(context.Context, logs.Log) (sourcedriver.LocalStatus, error) {
+						return status, nil
+					},
+				}, nil
+			},
+		}
+
+		sources := source.List{
+			{
+				Name:         "<source-a>",
+				BaseCloneDir: filepath.Join(tempDir, "a"),
+				Driver:       driver,
+			},
+			{
+				Name:         "<source-b>",
+				BaseCloneDir: filepath.Join(tempDir, "b"),
+				Driver:       driver,
+			},
+		}
+
+		index = &source.Index{
+			File:    filepath.Join(tempDir, "data", "clones.json"),
+			Sources: sources,
+		}
+
+		for _, r := range []struct {
+			Source source.Source
+			Name   string
+		}{
+			{sources[0], "owner/alpha"},
+			{sources[0], "owner/beta"},
+			{sources[1], "other/alpha"},
+		} {
+			dir := filepath.Join(r.Source.BaseCloneDir, r.Name)
+			Expect(os.MkdirAll(dir, 0700)).To(Succeed())
+
+			err := index.Add(source.LocalRepo{
+				RemoteRepo: sourcedriver.RemoteRepo{
+					ID:               r.Name,
+					Name:             r.Name,
+					RelativeCloneDir: r.Name,
+				},
+				Source:           r.Source,
+				AbsoluteCloneDir: dir,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+
+		server = &Server{
+			SourceList: sources,
+			Index:      index,
+			Log:        logs.Discard,
+		}
+	})
+
+	// names returns the source and name of each listed repository.
+	names := func(res *api.ListLocalReposResponse) []string {
+		var names []string
+		for _, l := range res.GetLocalRepos() {
+			r := l.GetLocalRepo().GetRemoteRepo()
+			names = append(names, r.GetSource()+" "+r.GetName())
+		}
+		return names
+	}
+
+	It("lists all local clones", func() {
+		res, err := server.ListLocalRepos(
+			context.Background(),
+			&api.ListLocalReposRequest{},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(names(res)).To(ConsistOf(
+			"<source-a> owner/alpha",
+			"<source-a> owner/beta",
+			"<source-b> other/alpha",
+		))
+
+		for _, l := range res.GetLocalRepos() {
+			Expect(l.GetLocalRepo().GetAbsoluteCloneDir()).To(BeADirectory())
+			Expect(l.GetStatus()).To(BeNil())
+			Expect(l.GetStatusError()).To(BeEmpty())
+		}
+	})
+
+	It("filters clones by source", func() {
+		res, err := server.ListLocalRepos(
+			context.Background(),
+			&api.ListLocalReposRequest{
+				SourceFilter: []string{"<source-b>"},
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(names(res)).To(ConsistOf(
+			"<source-b> other/alpha",
+		))
+	})
+
+	It("filters clones by name pattern", func() {
+		res, err := server.ListLocalRepos(
+			context.Background(),
+			&api.ListLocalReposRequest{
+				NamePattern: "alpha",
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(names(res)).To(ConsistOf(
+			"<source-a> owner/alpha",
+			"<source-b> other/alpha",
+		))
+	})
+
+	It("includes the status of each clone if requested", func() {
+		statuses[filepath.Join(tempDir, "a", "owner/alpha")] = sourcedriver.LocalStatus{
+			Branch:                "main",
+			Head:                  "<head>",
+			HasUncommittedChanges: true,
+		}
+		statuses[filepath.Join(tempDir, "a", "owner/beta")] = sourcedriver.LocalStatus{
+			Branch:           "main",
+			UnpushedBranches: []string{"main", "feature"},
+			HasStashes:       true,
+		}
+
+		res, err := server.ListLocalRepos(
+			context.Background(),
+			&api.ListLocalReposRequest{
+				SourceFilter:  []string{"<source-a>"},
+				IncludeStatus: true,
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(res.GetLocalRepos()).To(HaveLen(2))
+
+		for _, l := range res.GetLocalRepos() {
+			s := l.GetStatus()
+			Expect(l.GetStatusError()).To(BeEmpty())
+			Expect(s.GetBranch()).To(Equal("main"))
+
+			switch l.GetLocalRepo().GetRemoteRepo().GetName() {
+			case "owner/alpha":
+				Expect(s.GetHead()).To(Equal("<head>"))
+				Expect(s.GetHasUncommittedChanges()).To(BeTrue())
+				Expect(s.GetUnpushedBranches()).To(BeEmpty())
+				Expect(s.GetHasStashes()).To(BeFalse())
+			case "owner/beta":
+				Expect(s.GetHasUncommittedChanges()).To(BeFalse())
+				Expect(s.GetUnpushedBranches()).To(Equal([]string{"main", "feature"}))
+				Expect(s.GetHasStashes()).To(BeTrue())
+			}
+		}
+	})
+
+	It("reports errors determining the status of a clone", func() {
+		res, err := server.ListLocalRepos(
+			context.Background(),
+			&api.ListLocalReposRequest{
+				SourceFilter:  []string{"<source-b>"},
+				IncludeStatus: true,
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(res.GetLocalRepos()).To(HaveLen(1))
+		Expect(res.GetLocalRepos()[0].GetStatus()).To(BeNil())
+		Expect(res.GetLocalRepos()[0].GetStatusError()).To(Equal("unable to open local clone: <error>"))
+	})
+
+	It("returns an error if the name pattern is invalid", func() {
+		_, err := server.ListLocalRepos(
+			context.Background(),
+			&api.ListLocalReposRequest{
+				NamePattern: "[",
+			},
+		)
+		Expect(err).To(HaveOccurred())
+	})
+})
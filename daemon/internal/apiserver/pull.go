@@ -0,0 +1,57 @@
+package apiserver
+
+import (
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/daemon/internal/source"
+	"google.golang.org/protobuf/proto"
+)
+
+// PullRepos fetches changes from the remote repositories of existing local
+// clones then fast-forwards their checked-out branches, where possible.
+func (s *Server) PullRepos(
+	req *api.PullReposRequest,
+	stream api.API_PullReposServer,
+) error {
+	repos, err := s.filterLocalRepos(req.SourceFilter, req.NamePattern)
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	out := &syncStream{ServerStream: stream}
+
+	return forEachLocalRepo(
+		repos,
+		req.Concurrency,
+		func(r source.LocalRepo) error {
+			log := s.newClientLog(
+				out,
+				req.ClientOptions,
+				func(out *api.ClientOutput) proto.Message {
+					return &api.PullReposResponse{
+						Response: &api.PullReposResponse_Output{
+							Output: out,
+						},
+					}
+				},
+			).WithPrefix("%s: ", r.Name)
+
+			result := &api.PullRepoResult{
+				LocalRepo: marshalLocalRepo(r),
+			}
+
+			if res, err := s.Updater.Pull(ctx, r, log); err != nil {
+				result.Error = err.Error()
+			} else {
+				result.Updated = res.Updated
+				result.SkipReason = res.SkipReason
+			}
+
+			return out.SendMsg(&api.PullReposResponse{
+				Response: &api.PullReposResponse_Result{
+					Result: result,
+				},
+			})
+		},
+	)
+}
@@ -0,0 +1,42 @@
+package apiserver
+
+import (
+	"github.com/gritcli/grit/api"
+	"google.golang.org/protobuf/proto"
+)
+
+// CheckoutPullRequest checks out a pull request in a local branch of the local
+// clone of its repository, cloning the repository if necessary.
+func (s *Server) CheckoutPullRequest(
+	req *api.CheckoutPullRequestRequest,
+	stream api.API_CheckoutPullRequestServer,
+) error {
+	repo, _, err := s.PullRequests.Checkout(
+		stream.Context(),
+		req.Query,
+		req.Source,
+		s.newCloneLog(
+			stream,
+			s.newClientLog(
+				stream,
+				req.ClientOptions,
+				func(out *api.ClientOutput) proto.Message {
+					return &api.CloneRepoResponse{
+						Response: &api.CloneRepoResponse_Output{
+							Output: out,
+						},
+					}
+				},
+			),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&api.CloneRepoResponse{
+		Response: &api.CloneRepoResponse_LocalRepo{
+			LocalRepo: marshalLocalRepo(repo),
+		},
+	})
+}
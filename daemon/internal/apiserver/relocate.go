@@ -0,0 +1,60 @@
+package apiserver
+
+import (
+	"errors"
+
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/daemon/internal/source"
+	"google.golang.org/protobuf/proto"
+)
+
+// RelocateRepos moves local clones to the locations dictated by the current
+// configuration.
+func (s *Server) RelocateRepos(
+	req *api.RelocateReposRequest,
+	stream api.API_RelocateReposServer,
+) error {
+	relocations, err := s.Relocator.Relocations()
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	log := s.newClientLog(
+		stream,
+		req.ClientOptions,
+		func(out *api.ClientOutput) proto.Message {
+			return &api.RelocateReposResponse{
+				Response: &api.RelocateReposResponse_Output{
+					Output: out,
+				},
+			}
+		},
+	)
+
+	// Clones are relocated one at a time so that conflicts between clones
+	// that are being moved to the same location are reported consistently.
+	for _, rel := range relocations {
+		result := &api.RelocateRepoResult{
+			LocalRepo:        marshalLocalRepo(rel.LocalRepo),
+			PreviousCloneDir: rel.PreviousCloneDir,
+		}
+
+		if !req.DryRun {
+			if err := s.Relocator.Relocate(ctx, rel, log); err != nil {
+				result.Error = err.Error()
+				result.Conflict = errors.As(err, &source.ConflictError{})
+			}
+		}
+
+		if err := stream.Send(&api.RelocateReposResponse{
+			Response: &api.RelocateReposResponse_Result{
+				Result: result,
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
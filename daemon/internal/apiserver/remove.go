@@ -0,0 +1,55 @@
+package apiserver
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/daemon/internal/source"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// RemoveRepo removes a local clone.
+func (s *Server) RemoveRepo(
+	req *api.RemoveRepoRequest,
+	stream api.API_RemoveRepoServer,
+) error {
+	repo, ok, err := s.Index.ByDir(req.AbsoluteCloneDir)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("there is no local clone in %s", req.AbsoluteCloneDir)
+	}
+
+	if err := s.Remover.Remove(
+		stream.Context(),
+		repo,
+		req.Force,
+		s.newClientLog(
+			stream,
+			req.ClientOptions,
+			func(out *api.ClientOutput) proto.Message {
+				return &api.RemoveRepoResponse{
+					Response: &api.RemoveRepoResponse_Output{
+						Output: out,
+					},
+				}
+			},
+		),
+	); err != nil {
+		if errors.As(err, &source.UnpushedChangesError{}) ||
+			errors.As(err, &source.WorktreesExistError{}) {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return err
+	}
+
+	return stream.Send(&api.RemoveRepoResponse{
+		Response: &api.RemoveRepoResponse_LocalRepo{
+			LocalRepo: marshalLocalRepo(repo),
+		},
+	})
+}
@@ -31,6 +31,16 @@ func (s *Server) ResolveRepo(
 		},
 	)
 
+	if hasLocality(req.LocalityFilter, api.Locality_LOCAL) {
+		if err := s.resolveLocalRepo(
+			req.Query,
+			req.SourceFilter,
+			responses,
+		); err != nil {
+			return err
+		}
+	}
+
 	for _, src := range s.SourceList {
 		src := src // capture loop variable
 
@@ -54,6 +64,35 @@ func (s *Server) ResolveRepo(
 	return g.Wait()
 }
 
+// resolveLocalRepo sends a response for each local clone that matches the
+// given query.
+func (s *Server) resolveLocalRepo(
+	query string,
+	sourceFilter []string,
+	responses api.API_ResolveRepoServer,
+) error {
+	repos, err := s.Index.Resolve(query)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range repos {
+		if !hasSource(sourceFilter, r.Source.Name) {
+			continue
+		}
+
+		if err := responses.Send(&api.ResolveRepoResponse{
+			Response: &api.ResolveRepoResponse_LocalRepo{
+				LocalRepo: marshalLocalRepo(r),
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // resolveRemoteRepo sends a response for each repository from src that matches
 // the given query.
 func (s *Server) resolveRemoteRepo(
@@ -4,25 +4,36 @@ import (
 	"errors"
 	"net"
 	"os"
+	"path"
 	"strings"
+	"sync"
 	"syscall"
 
 	"github.com/gritcli/grit/api"
 	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
 	"github.com/gritcli/grit/daemon/internal/logs"
 	"github.com/gritcli/grit/daemon/internal/source"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 )
 
 // Server is the implementation of api.APIServer
 type Server struct {
-	Version    string
-	PID        int
-	SourceList source.List
-	Cloner     *source.Cloner
-	Suggester  *source.Suggester
-	Log        logs.Log
+	Version      string
+	PID          int
+	SourceList   source.List
+	Index        *source.Index
+	Cloner       *source.Cloner
+	Updater      *source.Updater
+	Remover      *source.Remover
+	Archiver     *source.Archiver
+	Adopter      *source.Adopter
+	Relocator    *source.Relocator
+	Suggester    *source.Suggester
+	Worktrees    *source.WorktreeManager
+	PullRequests *source.PullRequestManager
+	Log          logs.Log
 }
 
 // newClientLog returns a logs.Logger that sends messages to the gRPC client.
@@ -48,6 +59,53 @@ func (s *Server) newClientLog(
 	}
 }
 
+// syncStream is a grpc.ServerStream that can safely be used to send messages
+// from multiple goroutines.
+type syncStream struct {
+	grpc.ServerStream
+
+	m sync.Mutex
+}
+
+// SendMsg sends a message on the stream.
+func (s *syncStream) SendMsg(m any) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.ServerStream.SendMsg(m)
+}
+
+// filterLocalRepos returns the local repositories that match the given
+// source filter and name pattern.
+func (s *Server) filterLocalRepos(
+	sourceFilter []string,
+	namePattern string,
+) ([]source.LocalRepo, error) {
+	repos, err := s.Index.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []source.LocalRepo
+
+	for _, r := range repos {
+		if !hasSource(sourceFilter, r.Source.Name) {
+			continue
+		}
+
+		ok, err := matchName(namePattern, r.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			matches = append(matches, r)
+		}
+	}
+
+	return matches, nil
+}
+
 // Listen starts a listener on the given unix socket.
 //
 // It deletes the socket file if it already exists.
@@ -72,11 +130,13 @@ func Listen(socket string) (net.Listener, error) {
 // representation.
 func marshalRemoteRepo(source string, r sourcedriver.RemoteRepo) *api.RemoteRepo {
 	return &api.RemoteRepo{
-		Id:          r.ID,
-		Source:      source,
-		Name:        r.Name,
-		Description: r.Description,
-		WebUrl:      r.WebURL,
+		Id:              r.ID,
+		Source:          source,
+		Name:            r.Name,
+		Description:     r.Description,
+		WebUrl:          r.WebURL,
+		IssuesUrl:       r.IssuesURL,
+		PullRequestsUrl: r.PullRequestsURL,
 	}
 }
 
@@ -122,3 +182,60 @@ func hasSource(filter []string, source string) bool {
 
 	return false
 }
+
+// matchName returns true if the given repository name matches a glob pattern.
+//
+// The pattern is matched case-insensitively against both the full name and the
+// last path component of the name. An empty pattern matches all names.
+func matchName(pattern, name string) (bool, error) {
+	if pattern == "" {
+		return true, nil
+	}
+
+	pattern = strings.ToLower(pattern)
+	name = strings.ToLower(name)
+
+	ok, err := path.Match(pattern, name)
+	if err != nil || ok {
+		return ok, err
+	}
+
+	return path.Match(pattern, path.Base(name))
+}
+
+// forEachLocalRepo calls fn for each of the given repositories, performing at
+// most n calls in parallel.
+//
+// If n is zero, a default concurrency limit is used.
+func forEachLocalRepo(
+	repos []source.LocalRepo,
+	n uint32,
+	fn func(source.LocalRepo) error,
+) error {
+	g := &errgroup.Group{}
+	g.SetLimit(concurrency(n))
+
+	for _, r := range repos {
+		r := r // capture loop variable
+
+		g.Go(func() error {
+			return fn(r)
+		})
+	}
+
+	return g.Wait()
+}
+
+// defaultConcurrency is the number of repositories that are operated on in
+// parallel when the client does not specify a concurrency limit.
+const defaultConcurrency = 4
+
+// concurrency returns the number of operations to perform in parallel, given
+// the limit requested by the client.
+func concurrency(n uint32) int {
+	if n == 0 {
+		return defaultConcurrency
+	}
+
+	return int(n)
+}
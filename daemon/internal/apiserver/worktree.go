@@ -0,0 +1,155 @@
+package apiserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gritcli/grit/api"
+	"github.com/gritcli/grit/daemon/internal/source"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// AddWorktree creates an additional working tree for a local clone.
+func (s *Server) AddWorktree(
+	req *api.AddWorktreeRequest,
+	stream api.API_AddWorktreeServer,
+) error {
+	repo, ok, err := s.Index.ByDir(req.AbsoluteCloneDir)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("there is no local clone in %s", req.AbsoluteCloneDir)
+	}
+
+	wt, err := s.Worktrees.Add(
+		stream.Context(),
+		repo,
+		req.Branch,
+		s.newClientLog(
+			stream,
+			req.ClientOptions,
+			func(out *api.ClientOutput) proto.Message {
+				return &api.AddWorktreeResponse{
+					Response: &api.AddWorktreeResponse_Output{
+						Output: out,
+					},
+				}
+			},
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&api.AddWorktreeResponse{
+		Response: &api.AddWorktreeResponse_Worktree{
+			Worktree: marshalWorktree(wt),
+		},
+	})
+}
+
+// ListWorktrees lists the additional working trees of local clones.
+//
+// If the request does not specify a local clone, the working trees of every
+// local clone that supports them are listed.
+func (s *Server) ListWorktrees(
+	ctx context.Context,
+	req *api.ListWorktreesRequest,
+) (*api.ListWorktreesResponse, error) {
+	var repos []source.LocalRepo
+
+	if req.AbsoluteCloneDir == "" {
+		var err error
+		repos, err = s.Index.List()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		repo, ok, err := s.Index.ByDir(req.AbsoluteCloneDir)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("there is no local clone in %s", req.AbsoluteCloneDir)
+		}
+		repos = append(repos, repo)
+	}
+
+	res := &api.ListWorktreesResponse{}
+
+	for _, r := range repos {
+		worktrees, err := s.Worktrees.List(ctx, r, r.Source.Log(s.Log))
+		if err != nil {
+			if req.AbsoluteCloneDir != "" {
+				return nil, err
+			}
+
+			s.Log.WriteVerbose("unable to list worktrees of %s: %s", r.AbsoluteCloneDir, err)
+			continue
+		}
+
+		for _, wt := range worktrees {
+			res.Worktrees = append(res.Worktrees, marshalWorktree(wt))
+		}
+	}
+
+	return res, nil
+}
+
+// RemoveWorktree removes an additional working tree of a local clone.
+func (s *Server) RemoveWorktree(
+	req *api.RemoveWorktreeRequest,
+	stream api.API_RemoveWorktreeServer,
+) error {
+	repo, ok, err := s.Index.ByDir(req.AbsoluteCloneDir)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("there is no local clone in %s", req.AbsoluteCloneDir)
+	}
+
+	wt, err := s.Worktrees.Remove(
+		stream.Context(),
+		repo,
+		req.Branch,
+		req.Force,
+		s.newClientLog(
+			stream,
+			req.ClientOptions,
+			func(out *api.ClientOutput) proto.Message {
+				return &api.RemoveWorktreeResponse{
+					Response: &api.RemoveWorktreeResponse_Output{
+						Output: out,
+					},
+				}
+			},
+		),
+	)
+	if err != nil {
+		if errors.As(err, &source.DirtyWorktreeError{}) {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return err
+	}
+
+	return stream.Send(&api.RemoveWorktreeResponse{
+		Response: &api.RemoveWorktreeResponse_Worktree{
+			Worktree: marshalWorktree(wt),
+		},
+	})
+}
+
+// marshalWorktree marshals a source.Worktree into its API representation.
+func marshalWorktree(wt source.Worktree) *api.Worktree {
+	return &api.Worktree{
+		LocalRepo:             marshalLocalRepo(wt.Repo),
+		AbsoluteDir:           wt.Dir,
+		Branch:                wt.Branch,
+		HasUncommittedChanges: wt.HasUncommittedChanges,
+	}
+}
@@ -25,3 +25,18 @@ func (s *source) SignOut(
 ) error {
 	return errors.New("<not implemented>")
 }
+
+// tokenUsername is the username used to authenticate Git operations over HTTP
+// when the source is configured with a personal access token. GitHub ignores
+// the username, but it must not be empty.
+const tokenUsername = "x-access-token"
+
+// httpCredentials returns the username and password used to authenticate Git
+// operations over HTTP. Both are empty if the source has no token.
+func (s *source) httpCredentials() (username, password string) {
+	if s.config.Token == "" {
+		return "", ""
+	}
+
+	return tokenUsername, s.config.Token
+}
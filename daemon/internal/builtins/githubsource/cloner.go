@@ -3,6 +3,7 @@ package githubsource
 import (
 	"context"
 
+	"github.com/google/go-github/v50/github"
 	"github.com/gritcli/grit/daemon/internal/builtins/gitvcs"
 	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
 	"github.com/gritcli/grit/daemon/internal/logs"
@@ -20,7 +21,7 @@ func (s *source) Cloner(
 		return nil, sourcedriver.RemoteRepo{}, err
 	}
 
-	r, ok := s.reposByID[intID]
+	r, ok := s.cachedRepo(intID)
 	if !ok {
 		var err error
 		r, _, err = s.client.Repositories.GetByID(ctx, intID)
@@ -35,17 +36,28 @@ func (s *source) Cloner(
 		r.GetFullName(),
 	)
 
+	return s.newCloner(r), toRemoteRepo(s.config.Domain, r), nil
+}
+
+// newCloner returns a cloner that clones r using the source's Git
+// configuration.
+func (s *source) newCloner(r *github.Repository) *gitvcs.Cloner {
 	c := &gitvcs.Cloner{
 		SSHEndpoint:      r.GetSSHURL(),
 		SSHKeyFile:       s.config.Git.SSHKeyFile,
 		SSHKeyPassphrase: s.config.Git.SSHKeyPassphrase,
 		HTTPEndpoint:     r.GetCloneURL(),
 		PreferHTTP:       s.config.Git.PreferHTTP,
+		Depth:            s.config.Git.Depth,
+		SingleBranch:     s.config.Git.SingleBranch,
+		UserName:         s.config.Git.UserName,
+		UserEmail:        s.config.Git.UserEmail,
+		SigningKey:       s.config.Git.SigningKey,
+		SigningFormat:    s.config.Git.SigningFormat,
+		MirrorDir:        s.config.Git.MirrorDir,
 	}
 
-	if s.config.Token != "" {
-		c.HTTPPassword = s.config.Token
-	}
+	c.HTTPUsername, c.HTTPPassword = s.httpCredentials()
 
-	return c, toRemoteRepo(r), nil
+	return c
 }
@@ -45,13 +45,14 @@ var _ = Describe("func source.Cloner()", func() {
 			DeferCleanup(cancel)
 		})
 
-		It("returns a gitvcs.Cloner with the token as the HTTP password", func() {
+		It("returns a gitvcs.Cloner that uses the token to authenticate over HTTP", func() {
 			cloner, repo, err := src.Cloner(ctx, privateUserRepo.ID, logs.Discard)
 			skipIfRateLimited(err)
 
 			Expect(cloner).To(Equal(&gitvcs.Cloner{
 				SSHEndpoint:  "git@github.com:grit-integration-tests/test-private.git",
 				HTTPEndpoint: "https://github.com/grit-integration-tests/test-private.git",
+				HTTPUsername: "x-access-token",
 				HTTPPassword: token,
 			}))
 
@@ -0,0 +1,175 @@
+package githubsource
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v50/github"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// ResolveCloneURL returns the repository that is cloned from the given URL.
+func (s *source) ResolveCloneURL(
+	ctx context.Context,
+	cloneURL string,
+	log logs.Log,
+) (sourcedriver.RemoteRepo, bool, error) {
+	ownerName, repoName, ok := parseCloneURL(s.config.Domain, cloneURL)
+	if !ok {
+		return sourcedriver.RemoteRepo{}, false, nil
+	}
+
+	return s.resolveURL(ctx, cloneURL, ownerName, repoName, log)
+}
+
+// resolveURL returns the repository with the given owner and name, which were
+// obtained by parsing the URL u.
+func (s *source) resolveURL(
+	ctx context.Context,
+	u, ownerName, repoName string,
+	log logs.Log,
+) (sourcedriver.RemoteRepo, bool, error) {
+	if r, ok := s.cachedRepoByName(ownerName, repoName); ok {
+		log.WriteVerbose(
+			"found a match for '%s' in the repository list for @%s",
+			u,
+			s.user.GetLogin(),
+		)
+
+		return toRemoteRepo(s.config.Domain, r), true, nil
+	}
+
+	r, res, err := s.client.Repositories.Get(ctx, ownerName, repoName)
+	if err != nil {
+		if res != nil && res.StatusCode == http.StatusNotFound {
+			log.WriteVerbose(
+				"no repository found for '%s' by querying the GitHub API",
+				u,
+			)
+
+			return sourcedriver.RemoteRepo{}, false, nil
+		}
+
+		return sourcedriver.RemoteRepo{}, false, err
+	}
+
+	log.WriteVerbose(
+		"found a repository for '%s' by querying the GitHub API",
+		u,
+	)
+
+	return toRemoteRepo(s.config.Domain, r), true, nil
+}
+
+// cachedRepoByName returns the repository with the given owner and name from
+// the repository cache. The names are compared case-insensitively.
+func (s *source) cachedRepoByName(ownerName, repoName string) (*github.Repository, bool) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	for o, reposByName := range s.reposByOwner {
+		if !strings.EqualFold(o, ownerName) {
+			continue
+		}
+
+		for n, r := range reposByName {
+			if strings.EqualFold(n, repoName) {
+				return r, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// scpURLPattern is a regex that matches the SCP-like "[user@]host:path" syntax
+// that Git accepts for SSH URLs.
+var scpURLPattern = regexp.MustCompile(`^(?:[^@/:]+@)?([^@/:]+):([^/].*)$`)
+
+// parseCloneURL parses a Git clone URL that refers to a repository on the
+// GitHub installation at the given domain.
+//
+// ok is false if the URL is not a valid clone URL or if it refers to a
+// different host.
+func parseCloneURL(domain, cloneURL string) (ownerName, repoName string, ok bool) {
+	var host, p string
+
+	if m := scpURLPattern.FindStringSubmatch(cloneURL); m != nil {
+		host, p = m[1], m[2]
+	} else {
+		u, err := url.Parse(cloneURL)
+		if err != nil {
+			return "", "", false
+		}
+
+		switch u.Scheme {
+		case "ssh", "git", "git+ssh", "http", "https":
+		default:
+			return "", "", false
+		}
+
+		host, p = u.Hostname(), u.Path
+	}
+
+	if !strings.EqualFold(host, domain) {
+		return "", "", false
+	}
+
+	p = strings.Trim(p, "/")
+	p = strings.TrimSuffix(p, ".git")
+
+	ownerName, repoName, ok = strings.Cut(p, "/")
+	if !ok ||
+		!ownerNamePattern.MatchString(ownerName) ||
+		!repoNamePattern.MatchString(repoName) {
+		return "", "", false
+	}
+
+	return ownerName, repoName, true
+}
+
+// parseRepoURL parses a URL that refers to a repository on the GitHub
+// installation at the given domain.
+//
+// It accepts any URL accepted by parseCloneURL(), as well as the URLs of the
+// repository's web pages, such as "https://github.com/owner/repo/tree/main".
+//
+// ok is false if the URL does not refer to a repository or if it refers to a
+// different host.
+func parseRepoURL(domain, repoURL string) (ownerName, repoName string, ok bool) {
+	if ownerName, repoName, ok := parseCloneURL(domain, repoURL); ok {
+		return ownerName, repoName, true
+	}
+
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return "", "", false
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", "", false
+	}
+
+	if !strings.EqualFold(u.Hostname(), domain) {
+		return "", "", false
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 2 {
+		return "", "", false
+	}
+
+	ownerName = parts[0]
+	repoName = strings.TrimSuffix(parts[1], ".git")
+
+	if !ownerNamePattern.MatchString(ownerName) ||
+		!repoNamePattern.MatchString(repoName) {
+		return "", "", false
+	}
+
+	return ownerName, repoName, true
+}
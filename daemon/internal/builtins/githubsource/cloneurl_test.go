@@ -0,0 +1,53 @@
+package githubsource_test
+
+import (
+	"context"
+
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func source.ResolveCloneURL()", func() {
+	var (
+		ctx context.Context
+		src sourcedriver.Source
+	)
+
+	BeforeEach(func() {
+		var cancel context.CancelFunc
+		ctx, cancel, src = beforeEachUnauthenticated()
+		DeferCleanup(cancel)
+	})
+
+	DescribeTable(
+		"it resolves clone URLs that refer to the source's domain",
+		func(url string) {
+			repo, ok, err := src.ResolveCloneURL(ctx, url, logs.Discard)
+			skipIfRateLimited(err)
+			Expect(ok).To(BeTrue())
+			Expect(repo).To(Equal(publicUserRepo))
+		},
+		Entry("SCP-style SSH", "git@github.com:grit-integration-tests/test-public.git"),
+		Entry("SSH", "ssh://git@github.com/grit-integration-tests/test-public.git"),
+		Entry("HTTPS", "https://github.com/grit-integration-tests/test-public.git"),
+		Entry("HTTPS without .git suffix", "https://github.com/grit-integration-tests/test-public"),
+		Entry("mismatched case", "https://GitHub.com/Grit-Integration-Tests/Test-Public"),
+	)
+
+	DescribeTable(
+		"it does not resolve clone URLs that do not refer to a repository on the source's domain",
+		func(url string) {
+			_, ok, err := src.ResolveCloneURL(ctx, url, logs.Discard)
+			skipIfRateLimited(err)
+			Expect(ok).To(BeFalse())
+		},
+		Entry("different domain", "git@example.org:grit-integration-tests/test-public.git"),
+		Entry("unsupported scheme", "ftp://github.com/grit-integration-tests/test-public.git"),
+		Entry("missing owner", "https://github.com/test-public.git"),
+		Entry("extra path components", "https://github.com/grit-integration-tests/test-public/tree/main"),
+		Entry("local path", "/path/to/repo"),
+		Entry("non-existent repository", "git@github.com:grit-integration-tests/test-non-existant.git"),
+	)
+})
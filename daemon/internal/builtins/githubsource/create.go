@@ -0,0 +1,108 @@
+package githubsource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v50/github"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// Create creates a new repository with the given name.
+//
+// If the name is fully-qualified the repository is created within the
+// organization that owns it, otherwise it is owned by the authenticated user.
+// The template, if any, must be a fully-qualified repository name.
+func (s *source) Create(
+	ctx context.Context,
+	name string,
+	opts sourcedriver.CreateOptions,
+	log logs.Log,
+) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error) {
+	if s.user == nil {
+		return nil, sourcedriver.RemoteRepo{}, errors.New("not authenticated, creating repositories requires a personal access token (PAT)")
+	}
+
+	ownerName, repoName, err := parseRepoName(name)
+	if err != nil {
+		return nil, sourcedriver.RemoteRepo{}, err
+	}
+
+	// The GitHub API expects an empty organization name when creating a
+	// repository owned by the authenticated user.
+	if strings.EqualFold(ownerName, s.user.GetLogin()) {
+		ownerName = ""
+	}
+
+	var r *github.Repository
+
+	if opts.Template == "" {
+		r, _, err = s.client.Repositories.Create(
+			ctx,
+			ownerName,
+			&github.Repository{
+				Name:    github.String(repoName),
+				Private: github.Bool(opts.Private),
+			},
+		)
+		if err != nil {
+			return nil, sourcedriver.RemoteRepo{}, err
+		}
+	} else {
+		r, err = s.createFromTemplate(ctx, ownerName, repoName, opts, log)
+		if err != nil {
+			return nil, sourcedriver.RemoteRepo{}, err
+		}
+	}
+
+	s.cacheRepo(r)
+
+	log.Write("created %s", r.GetFullName())
+
+	return s.newCloner(r), toRemoteRepo(s.config.Domain, r), nil
+}
+
+// createFromTemplate creates a new repository using opts.Template as a
+// template, and waits until it is ready to be cloned.
+func (s *source) createFromTemplate(
+	ctx context.Context,
+	ownerName, repoName string,
+	opts sourcedriver.CreateOptions,
+	log logs.Log,
+) (*github.Repository, error) {
+	templateOwner, templateName, err := parseRepoName(opts.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	if templateOwner == "" {
+		return nil, fmt.Errorf("template name (%s) must be fully-qualified (owner/name)", opts.Template)
+	}
+
+	if ownerName == "" {
+		ownerName = s.user.GetLogin()
+	}
+
+	r, _, err := s.client.Repositories.CreateFromTemplate(
+		ctx,
+		templateOwner,
+		templateName,
+		&github.TemplateRepoRequest{
+			Name:    github.String(repoName),
+			Owner:   github.String(ownerName),
+			Private: github.Bool(opts.Private),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.waitUntilReady(ctx, r, log); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
@@ -0,0 +1,33 @@
+package githubsource_test
+
+import (
+	"context"
+
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func source.Create()", func() {
+	var (
+		ctx context.Context
+		src sourcedriver.Source
+	)
+
+	When("unauthenticated", func() {
+		BeforeEach(func() {
+			var cancel context.CancelFunc
+			ctx, cancel, src = beforeEachUnauthenticated()
+			DeferCleanup(cancel)
+		})
+
+		It("returns an error", func() {
+			creator, ok := src.(sourcedriver.Creator)
+			Expect(ok).To(BeTrue())
+
+			_, _, err := creator.Create(ctx, "<name>", sourcedriver.CreateOptions{}, logs.Discard)
+			Expect(err).To(MatchError("not authenticated, creating repositories requires a personal access token (PAT)"))
+		})
+	})
+})
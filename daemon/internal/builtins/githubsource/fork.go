@@ -0,0 +1,125 @@
+package githubsource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/go-github/v50/github"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+const (
+	// readyPollInterval is the interval at which GitHub is polled to determine
+	// whether a new repository is ready to be cloned.
+	readyPollInterval = 2 * time.Second
+
+	// readyTimeout is the maximum amount of time to wait for a new repository
+	// to become ready to be cloned.
+	readyTimeout = 5 * time.Minute
+)
+
+// Fork creates a fork of the repository with the given ID, owned by the
+// authenticated user, and waits until it is ready to be cloned.
+func (s *source) Fork(
+	ctx context.Context,
+	id string,
+	log logs.Log,
+) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error) {
+	if s.user == nil {
+		return nil, sourcedriver.RemoteRepo{}, errors.New("not authenticated, forking requires a personal access token (PAT)")
+	}
+
+	intID, err := parseRepoID(id)
+	if err != nil {
+		return nil, sourcedriver.RemoteRepo{}, err
+	}
+
+	parent, ok := s.cachedRepo(intID)
+	if !ok {
+		var err error
+		parent, _, err = s.client.Repositories.GetByID(ctx, intID)
+		if err != nil {
+			return nil, sourcedriver.RemoteRepo{}, err
+		}
+	}
+
+	log.Write("forking %s", parent.GetFullName())
+
+	// GitHub creates the fork in the background, in which case the API
+	// returns an AcceptedError along with the details of the pending fork. If
+	// the user already has a fork of the repository, the existing fork is
+	// returned instead.
+	fork, _, err := s.client.Repositories.CreateFork(
+		ctx,
+		parent.GetOwner().GetLogin(),
+		parent.GetName(),
+		&github.RepositoryCreateForkOptions{},
+	)
+	if err != nil && !errors.As(err, new(*github.AcceptedError)) {
+		return nil, sourcedriver.RemoteRepo{}, err
+	}
+
+	if err := s.waitUntilReady(ctx, fork, log); err != nil {
+		return nil, sourcedriver.RemoteRepo{}, err
+	}
+
+	s.cacheRepo(fork)
+
+	log.Write("forked %s as %s", parent.GetFullName(), fork.GetFullName())
+
+	c := s.newCloner(fork)
+	c.UpstreamSSHEndpoint = parent.GetSSHURL()
+	c.UpstreamHTTPEndpoint = parent.GetCloneURL()
+
+	return c, toRemoteRepo(s.config.Domain, fork), nil
+}
+
+// waitUntilReady blocks until the default branch of a newly created repository
+// is available, indicating that GitHub has finished copying its content from
+// the repository it was forked or generated from.
+func (s *source) waitUntilReady(
+	ctx context.Context,
+	r *github.Repository,
+	log logs.Log,
+) error {
+	branch := r.GetDefaultBranch()
+	if branch == "" {
+		// The original repository is empty, so there is nothing to wait for.
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readyTimeout)
+	defer cancel()
+
+	for {
+		_, res, err := s.client.Repositories.GetBranch(
+			ctx,
+			r.GetOwner().GetLogin(),
+			r.GetName(),
+			branch,
+			false, // followRedirects
+		)
+		if err == nil {
+			return nil
+		}
+
+		if res == nil || res.StatusCode != http.StatusNotFound {
+			return err
+		}
+
+		log.WriteVerbose("waiting for %s to become ready", r.GetFullName())
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("timed out waiting for %s to become ready", r.GetFullName())
+			}
+			return ctx.Err()
+		case <-time.After(readyPollInterval):
+		}
+	}
+}
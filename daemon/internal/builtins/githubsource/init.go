@@ -75,9 +75,6 @@ func (s *source) populateRepoCache(
 		},
 	}
 
-	s.reposByID = map[int64]*github.Repository{}
-	s.reposByOwner = map[string]map[string]*github.Repository{}
-
 	for opts.Page != 0 {
 		repoPage, res, err := s.client.Repositories.List(ctx, "", opts)
 		if err != nil {
@@ -86,16 +83,7 @@ func (s *source) populateRepoCache(
 
 		for _, r := range repoPage {
 			log.WriteVerbose("discovered %s", r.GetFullName())
-
-			owner := r.GetOwner().GetLogin()
-			reposByName := s.reposByOwner[owner]
-			if reposByName == nil {
-				reposByName = map[string]*github.Repository{}
-				s.reposByOwner[owner] = reposByName
-			}
-
-			reposByName[r.GetName()] = r
-			s.reposByID[r.GetID()] = r
+			s.cacheRepo(r)
 		}
 
 		opts.Page = res.NextPage
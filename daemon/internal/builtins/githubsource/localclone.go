@@ -0,0 +1,33 @@
+package githubsource
+
+import (
+	"context"
+
+	"github.com/gritcli/grit/daemon/internal/builtins/gitvcs"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// LocalClone returns an interface for operating on an existing local clone of
+// one of the source's repositories.
+func (s *source) LocalClone(
+	ctx context.Context,
+	dir string,
+	log logs.Log,
+) (sourcedriver.LocalClone, error) {
+	return s.localClone(dir), nil
+}
+
+// localClone returns a gitvcs.LocalClone for the local clone in dir that uses
+// the source's Git configuration.
+func (s *source) localClone(dir string) *gitvcs.LocalClone {
+	c := &gitvcs.LocalClone{
+		Dir:              dir,
+		SSHKeyFile:       s.config.Git.SSHKeyFile,
+		SSHKeyPassphrase: s.config.Git.SSHKeyPassphrase,
+	}
+
+	c.HTTPUsername, c.HTTPPassword = s.httpCredentials()
+
+	return c
+}
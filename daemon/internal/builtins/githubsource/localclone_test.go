@@ -0,0 +1,38 @@
+package githubsource_test
+
+import (
+	"context"
+
+	. "github.com/gritcli/grit/daemon/internal/builtins/githubsource"
+	"github.com/gritcli/grit/daemon/internal/builtins/gitvcs"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func source.LocalClone()", func() {
+	It("returns a gitvcs.LocalClone that uses the source's credentials", func() {
+		src := Config{
+			Domain: "github.com",
+			Token:  "<token>",
+			Git: gitvcs.Config{
+				SSHKeyFile:       "/path/to/key",
+				SSHKeyPassphrase: "<passphrase>",
+			},
+		}.NewSource()
+
+		clone, err := src.LocalClone(
+			context.Background(),
+			"/path/to/clone",
+			logs.Discard,
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(clone).To(Equal(&gitvcs.LocalClone{
+			Dir:              "/path/to/clone",
+			SSHKeyFile:       "/path/to/key",
+			SSHKeyPassphrase: "<passphrase>",
+			HTTPUsername:     "x-access-token",
+			HTTPPassword:     "<token>",
+		}))
+	})
+})
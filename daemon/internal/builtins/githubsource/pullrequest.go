@@ -0,0 +1,161 @@
+package githubsource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/go-github/v50/github"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// ResolvePullRequest resolves a reference to a pull request, such as its URL.
+//
+// It accepts the short "owner/repo#123" syntax and the URL of the pull
+// request's web page.
+func (s *source) ResolvePullRequest(
+	ctx context.Context,
+	query string,
+	log logs.Log,
+) (sourcedriver.PullRequest, bool, error) {
+	ownerName, repoName, number, ok := parsePullRequest(s.config.Domain, query)
+	if !ok {
+		return sourcedriver.PullRequest{}, false, nil
+	}
+
+	r, ok := s.cachedRepoByName(ownerName, repoName)
+	if !ok {
+		var (
+			res *github.Response
+			err error
+		)
+
+		r, res, err = s.client.Repositories.Get(ctx, ownerName, repoName)
+		if err != nil {
+			if res != nil && res.StatusCode == http.StatusNotFound {
+				log.WriteVerbose(
+					"no repository found for '%s' by querying the GitHub API",
+					query,
+				)
+
+				return sourcedriver.PullRequest{}, false, nil
+			}
+
+			return sourcedriver.PullRequest{}, false, err
+		}
+	}
+
+	pr, res, err := s.client.PullRequests.Get(ctx, r.GetOwner().GetLogin(), r.GetName(), number)
+	if err != nil {
+		if res != nil && res.StatusCode == http.StatusNotFound {
+			log.WriteVerbose(
+				"no pull request found for '%s' by querying the GitHub API",
+				query,
+			)
+
+			return sourcedriver.PullRequest{}, false, nil
+		}
+
+		return sourcedriver.PullRequest{}, false, err
+	}
+
+	log.WriteVerbose(
+		"found pull request #%d in %s by querying the GitHub API",
+		number,
+		r.GetFullName(),
+	)
+
+	return sourcedriver.PullRequest{
+		Repo:  toRemoteRepo(s.config.Domain, r),
+		ID:    strconv.Itoa(number),
+		Title: pr.GetTitle(),
+	}, true, nil
+}
+
+// CheckoutPullRequest fetches the head of a pull request into a local branch
+// of the local clone in dir, then checks that branch out.
+//
+// The branch is named "pr/<number>".
+func (s *source) CheckoutPullRequest(
+	ctx context.Context,
+	dir string,
+	pr sourcedriver.PullRequest,
+	log logs.Log,
+) (string, error) {
+	number, err := strconv.Atoi(pr.ID)
+	if err != nil || number <= 0 {
+		return "", fmt.Errorf("invalid pull request ID (%s), expected positive integer", pr.ID)
+	}
+
+	branch := fmt.Sprintf("pr/%d", number)
+
+	if err := s.localClone(dir).CheckoutRemoteRef(
+		ctx,
+		"origin",
+		plumbing.ReferenceName(fmt.Sprintf("refs/pull/%d/head", number)),
+		branch,
+		log,
+	); err != nil {
+		return "", err
+	}
+
+	return branch, nil
+}
+
+// pullRequestPattern is a regex that matches the "owner/repo#123" syntax used
+// by GitHub to refer to pull requests.
+var pullRequestPattern = regexp.MustCompile(`^([^/#]+)/([^/#]+)#([0-9]+)$`)
+
+// parsePullRequest parses a reference to a pull request on the GitHub
+// installation at the given domain.
+//
+// The reference is either of the form "owner/repo#123" or the URL of the pull
+// request's web page. ok is false if the reference is invalid or refers to a
+// different host.
+func parsePullRequest(domain, query string) (ownerName, repoName string, number int, ok bool) {
+	var n string
+
+	if m := pullRequestPattern.FindStringSubmatch(query); m != nil {
+		ownerName, repoName, n = m[1], m[2], m[3]
+	} else {
+		u, err := url.Parse(query)
+		if err != nil {
+			return "", "", 0, false
+		}
+
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return "", "", 0, false
+		}
+
+		if !strings.EqualFold(u.Hostname(), domain) {
+			return "", "", 0, false
+		}
+
+		// The URL may refer to any of the pull request's pages, such as
+		// "/owner/repo/pull/123/files".
+		parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if len(parts) < 4 || parts[2] != "pull" {
+			return "", "", 0, false
+		}
+
+		ownerName, repoName, n = parts[0], parts[1], parts[3]
+	}
+
+	if !ownerNamePattern.MatchString(ownerName) ||
+		!repoNamePattern.MatchString(repoName) {
+		return "", "", 0, false
+	}
+
+	number, err := strconv.Atoi(n)
+	if err != nil || number <= 0 {
+		return "", "", 0, false
+	}
+
+	return ownerName, repoName, number, true
+}
@@ -0,0 +1,49 @@
+package githubsource_test
+
+import (
+	"context"
+
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func source.ResolvePullRequest()", func() {
+	var (
+		ctx context.Context
+		src sourcedriver.PullRequestSource
+	)
+
+	BeforeEach(func() {
+		var (
+			cancel context.CancelFunc
+			s      sourcedriver.Source
+		)
+
+		ctx, cancel, s = beforeEachUnauthenticated()
+		DeferCleanup(cancel)
+
+		var ok bool
+		src, ok = s.(sourcedriver.PullRequestSource)
+		Expect(ok).To(BeTrue())
+	})
+
+	DescribeTable(
+		"it does not resolve references that do not refer to a pull request on the source's domain",
+		func(query string) {
+			_, ok, err := src.ResolvePullRequest(ctx, query, logs.Discard)
+			skipIfRateLimited(err)
+			Expect(ok).To(BeFalse())
+		},
+		Entry("repository name", "grit-integration-tests/test-public"),
+		Entry("unqualified repository name", "test-public#1"),
+		Entry("zero pull request number", "grit-integration-tests/test-public#0"),
+		Entry("non-numeric pull request number", "grit-integration-tests/test-public#abc"),
+		Entry("different domain", "https://example.org/grit-integration-tests/test-public/pull/1"),
+		Entry("unsupported scheme", "ftp://github.com/grit-integration-tests/test-public/pull/1"),
+		Entry("repository URL", "https://github.com/grit-integration-tests/test-public"),
+		Entry("issue URL", "https://github.com/grit-integration-tests/test-public/issues/1"),
+		Entry("non-existent repository", "grit-integration-tests/test-non-existant#1"),
+	)
+})
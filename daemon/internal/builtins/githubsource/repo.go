@@ -59,7 +59,9 @@ func parseRepoName(name string) (ownerName, repoName string, err error) {
 }
 
 // toRemoteRepo converts a github.Repository to a sourcedriver.RemoteRepo.
-func toRemoteRepo(r *github.Repository) sourcedriver.RemoteRepo {
+//
+// The domain is the domain name of the GitHub server that hosts r.
+func toRemoteRepo(domain string, r *github.Repository) sourcedriver.RemoteRepo {
 	owner, name, err := parseRepoName(r.GetFullName())
 	if err != nil {
 		panic(err)
@@ -70,16 +72,23 @@ func toRemoteRepo(r *github.Repository) sourcedriver.RemoteRepo {
 		Name:             r.GetFullName(),
 		Description:      r.GetDescription(),
 		WebURL:           r.GetHTMLURL(),
+		IssuesURL:        r.GetHTMLURL() + "/issues",
+		PullRequestsURL:  r.GetHTMLURL() + "/pulls",
 		RelativeCloneDir: filepath.Join(owner, name),
+		LayoutFields: map[string]string{
+			"domain": domain,
+			"owner":  owner,
+			"name":   name,
+		},
 	}
 }
 
 // toRemoteRepos converts multiple github.Repository to a slice of
 // sourcedriver.RemoteRepo.
-func toRemoteRepos(repos ...*github.Repository) []sourcedriver.RemoteRepo {
+func toRemoteRepos(domain string, repos ...*github.Repository) []sourcedriver.RemoteRepo {
 	remotes := make([]sourcedriver.RemoteRepo, len(repos))
 	for i, r := range repos {
-		remotes[i] = toRemoteRepo(r)
+		remotes[i] = toRemoteRepo(domain, r)
 	}
 
 	return remotes
@@ -12,7 +12,14 @@ var (
 		Name:             "grit-integration-tests-org/test-public",
 		Description:      "Used to test that Grit works with public GitHub repositories that belong to an organization.",
 		WebURL:           "https://github.com/grit-integration-tests-org/test-public",
+		IssuesURL:        "https://github.com/grit-integration-tests-org/test-public/issues",
+		PullRequestsURL:  "https://github.com/grit-integration-tests-org/test-public/pulls",
 		RelativeCloneDir: filepath.Join("grit-integration-tests-org", "test-public"),
+		LayoutFields: map[string]string{
+			"domain": "github.com",
+			"owner":  "grit-integration-tests-org",
+			"name":   "test-public",
+		},
 	}
 
 	privateOrgRepo = sourcedriver.RemoteRepo{
@@ -20,7 +27,14 @@ var (
 		Name:             "grit-integration-tests-org/test-private",
 		Description:      "Used to test that Grit works with private GitHub repositories that belong to an organization.",
 		WebURL:           "https://github.com/grit-integration-tests-org/test-private",
+		IssuesURL:        "https://github.com/grit-integration-tests-org/test-private/issues",
+		PullRequestsURL:  "https://github.com/grit-integration-tests-org/test-private/pulls",
 		RelativeCloneDir: filepath.Join("grit-integration-tests-org", "test-private"),
+		LayoutFields: map[string]string{
+			"domain": "github.com",
+			"owner":  "grit-integration-tests-org",
+			"name":   "test-private",
+		},
 	}
 
 	publicUserRepo = sourcedriver.RemoteRepo{
@@ -28,7 +42,14 @@ var (
 		Name:             "grit-integration-tests/test-public",
 		Description:      "Used to test that Grit works with public GitHub repositories.",
 		WebURL:           "https://github.com/grit-integration-tests/test-public",
+		IssuesURL:        "https://github.com/grit-integration-tests/test-public/issues",
+		PullRequestsURL:  "https://github.com/grit-integration-tests/test-public/pulls",
 		RelativeCloneDir: filepath.Join("grit-integration-tests", "test-public"),
+		LayoutFields: map[string]string{
+			"domain": "github.com",
+			"owner":  "grit-integration-tests",
+			"name":   "test-public",
+		},
 	}
 
 	privateUserRepo = sourcedriver.RemoteRepo{
@@ -36,7 +57,14 @@ var (
 		Name:             "grit-integration-tests/test-private",
 		Description:      "Used to test that Grit works with private GitHub repositories.",
 		WebURL:           "https://github.com/grit-integration-tests/test-private",
+		IssuesURL:        "https://github.com/grit-integration-tests/test-private/issues",
+		PullRequestsURL:  "https://github.com/grit-integration-tests/test-private/pulls",
 		RelativeCloneDir: filepath.Join("grit-integration-tests", "test-private"),
+		LayoutFields: map[string]string{
+			"domain": "github.com",
+			"owner":  "grit-integration-tests",
+			"name":   "test-private",
+		},
 	}
 
 	// thirdPartyRepo is a repository that the authenticated user does not have
@@ -50,7 +78,14 @@ var (
 		Name:             "gritcli/grit",
 		Description:      "Manage your local Git clones.",
 		WebURL:           "https://github.com/gritcli/grit",
+		IssuesURL:        "https://github.com/gritcli/grit/issues",
+		PullRequestsURL:  "https://github.com/gritcli/grit/pulls",
 		RelativeCloneDir: filepath.Join("gritcli", "grit"),
+		LayoutFields: map[string]string{
+			"domain": "github.com",
+			"owner":  "gritcli",
+			"name":   "grit",
+		},
 	}
 
 	// allTestRepos is the set of all test repositories to which the
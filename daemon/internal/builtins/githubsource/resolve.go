@@ -15,6 +15,15 @@ func (s *source) Resolve(
 	query string,
 	log logs.Log,
 ) ([]sourcedriver.RemoteRepo, error) {
+	if ownerName, repoName, ok := parseRepoURL(s.config.Domain, query); ok {
+		r, ok, err := s.resolveURL(ctx, query, ownerName, repoName, log)
+		if !ok || err != nil {
+			return nil, err
+		}
+
+		return []sourcedriver.RemoteRepo{r}, nil
+	}
+
 	ownerName, repoName, err := parseRepoName(query)
 	if err != nil {
 		return nil, nil
@@ -23,11 +32,13 @@ func (s *source) Resolve(
 	if ownerName == "" {
 		var matches []sourcedriver.RemoteRepo
 
+		s.m.RLock()
 		for _, reposByName := range s.reposByOwner {
 			if r, ok := reposByName[repoName]; ok {
-				matches = append(matches, toRemoteRepo(r))
+				matches = append(matches, toRemoteRepo(s.config.Domain, r))
 			}
 		}
+		s.m.RUnlock()
 
 		log.WriteVerbose(
 			"found %d match(es) for '%s' in the repository list for @%s",
@@ -46,19 +57,23 @@ func (s *source) Resolve(
 		return matches, nil
 	}
 
-	if r, ok := s.reposByOwner[ownerName][repoName]; ok {
+	s.m.RLock()
+	cached, ok := s.reposByOwner[ownerName][repoName]
+	s.m.RUnlock()
+
+	if ok {
 		log.WriteVerbose(
 			"found an exact match for '%s' in the repository list for @%s",
 			query,
 			s.user.GetLogin(),
 		)
 
-		return toRemoteRepos(r), nil
+		return toRemoteRepos(s.config.Domain, cached), nil
 	}
 
 	r, res, err := s.client.Repositories.Get(ctx, ownerName, repoName)
 	if err != nil {
-		if res != nil || res.StatusCode == http.StatusNotFound {
+		if res != nil && res.StatusCode == http.StatusNotFound {
 			log.WriteVerbose(
 				"no repository named '%s' found by querying the GitHub API",
 				query,
@@ -75,5 +90,5 @@ func (s *source) Resolve(
 		query,
 	)
 
-	return toRemoteRepos(r), nil
+	return toRemoteRepos(s.config.Domain, r), nil
 }
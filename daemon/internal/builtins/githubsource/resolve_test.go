@@ -3,6 +3,7 @@ package githubsource_test
 import (
 	"context"
 
+	. "github.com/gritcli/grit/daemon/internal/builtins/githubsource"
 	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
 	"github.com/gritcli/grit/daemon/internal/logs"
 	. "github.com/onsi/ginkgo/v2"
@@ -15,6 +16,21 @@ var _ = Describe("func source.Resolve()", func() {
 		src sourcedriver.Source
 	)
 
+	It("returns an error if the GitHub API request fails without a response", func() {
+		src = Config{Domain: "github.com"}.NewSource()
+
+		err := src.Init(context.Background(), sourcedriver.InitParameters{}, logs.Discard)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// Use a canceled context so that the request fails before any response
+		// is received.
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err = src.Resolve(ctx, "grit-integration-tests/test-public", logs.Discard)
+		Expect(err).To(MatchError(context.Canceled))
+	})
+
 	When("unauthenticated", func() {
 		BeforeEach(func() {
 			var cancel context.CancelFunc
@@ -53,6 +69,32 @@ var _ = Describe("func source.Resolve()", func() {
 			skipIfRateLimited(err)
 			Expect(repos).To(BeEmpty())
 		})
+
+		DescribeTable(
+			"it resolves URLs that refer to a repository on the source's domain",
+			func(url string) {
+				repos, err := src.Resolve(ctx, url, logs.Discard)
+				skipIfRateLimited(err)
+				Expect(repos).To(ConsistOf(publicUserRepo))
+			},
+			Entry("SCP-style SSH", "git@github.com:grit-integration-tests/test-public.git"),
+			Entry("HTTPS clone URL", "https://github.com/grit-integration-tests/test-public.git"),
+			Entry("web URL", "https://github.com/grit-integration-tests/test-public"),
+			Entry("web URL of a branch", "https://github.com/grit-integration-tests/test-public/tree/main"),
+		)
+
+		DescribeTable(
+			"it does not resolve URLs that do not refer to a repository on the source's domain",
+			func(url string) {
+				repos, err := src.Resolve(ctx, url, logs.Discard)
+				skipIfRateLimited(err)
+				Expect(repos).To(BeEmpty())
+			},
+			Entry("different domain", "https://example.org/grit-integration-tests/test-public/tree/main"),
+			Entry("SCP-style SSH on a different domain", "git@example.org:grit-integration-tests/test-public.git"),
+			Entry("missing repository name", "https://github.com/grit-integration-tests"),
+			Entry("non-existent repository", "https://github.com/grit-integration-tests/test-non-existant/tree/main"),
+		)
 	})
 
 	When("authenticated", func() {
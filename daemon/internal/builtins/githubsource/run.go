@@ -2,14 +2,45 @@ package githubsource
 
 import (
 	"context"
+	"time"
 
+	"github.com/gritcli/grit/daemon/internal/builtins/gitvcs"
 	"github.com/gritcli/grit/daemon/internal/logs"
 )
 
+// mirrorRefreshInterval is the interval at which the mirrors of the source's
+// repositories are refreshed.
+const mirrorRefreshInterval = 1 * time.Hour
+
 // Run performs any background processing required by the source.
+//
+// If the source is configured to use mirrors, they are refreshed periodically
+// so that new clones need to download as little as possible.
 func (s *source) Run(
 	ctx context.Context,
 	log logs.Log,
 ) error {
-	return nil
+	if s.config.Git.MirrorDir == "" {
+		return nil
+	}
+
+	cache := &gitvcs.MirrorCache{
+		Dir:              s.config.Git.MirrorDir,
+		SSHKeyFile:       s.config.Git.SSHKeyFile,
+		SSHKeyPassphrase: s.config.Git.SSHKeyPassphrase,
+	}
+
+	cache.HTTPUsername, cache.HTTPPassword = s.httpCredentials()
+
+	for {
+		if err := cache.Refresh(ctx, s.config.Domain, log); err != nil {
+			log.Write("unable to refresh mirrors: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(mirrorRefreshInterval):
+		}
+	}
 }
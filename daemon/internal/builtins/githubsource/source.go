@@ -2,6 +2,7 @@ package githubsource
 
 import (
 	"strings"
+	"sync"
 
 	"github.com/google/go-github/v50/github"
 )
@@ -12,11 +13,46 @@ type source struct {
 	config Config
 	client *github.Client
 
-	user         *github.User
+	user *github.User
+
+	// m guards reposByID and reposByOwner, which are modified after the
+	// source is initialized when the user creates new repositories.
+	m            sync.RWMutex
 	reposByID    map[int64]*github.Repository
 	reposByOwner map[string]map[string]*github.Repository
 }
 
+// cacheRepo adds r to the repository cache.
+func (s *source) cacheRepo(r *github.Repository) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.reposByID == nil {
+		s.reposByID = map[int64]*github.Repository{}
+		s.reposByOwner = map[string]map[string]*github.Repository{}
+	}
+
+	owner := r.GetOwner().GetLogin()
+	reposByName := s.reposByOwner[owner]
+	if reposByName == nil {
+		reposByName = map[string]*github.Repository{}
+		s.reposByOwner[owner] = reposByName
+	}
+
+	reposByName[r.GetName()] = r
+	s.reposByID[r.GetID()] = r
+}
+
+// cachedRepo returns the repository with the given ID from the repository
+// cache.
+func (s *source) cachedRepo(id int64) (*github.Repository, bool) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	r, ok := s.reposByID[id]
+	return r, ok
+}
+
 // isEnterpriseServer returns true if domain seems to refer to a GitHub
 // Enterprise Server installation.
 func isEnterpriseServer(domain string) bool {
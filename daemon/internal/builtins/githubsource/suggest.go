@@ -18,6 +18,9 @@ func (s *source) Suggest(
 ) map[string][]sourcedriver.RemoteRepo {
 	suggestions := map[string][]sourcedriver.RemoteRepo{}
 
+	s.m.RLock()
+	defer s.m.RUnlock()
+
 	for _, r := range s.reposByID {
 		candidates := []string{
 			r.GetFullName(),
@@ -28,7 +31,7 @@ func (s *source) Suggest(
 			if strings.HasPrefix(c, word) {
 				suggestions[c] = append(
 					suggestions[c],
-					toRemoteRepo(r),
+					toRemoteRepo(s.config.Domain, r),
 				)
 				break
 			}
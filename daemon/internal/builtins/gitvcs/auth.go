@@ -0,0 +1,69 @@
+package gitvcs
+
+import (
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+)
+
+// sshAuth returns the authentication method to use when communicating with a
+// remote repository using the SSH protocol.
+//
+// If keyFile is empty it returns nil, in which case Git uses the system's SSH
+// agent.
+func sshAuth(
+	endpoint string,
+	keyFile, passphrase string,
+) (transport.AuthMethod, error) {
+	if keyFile == "" {
+		return nil, nil
+	}
+
+	ep, err := transport.NewEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return ssh.NewPublicKeysFromFile(
+		ep.User,
+		keyFile,
+		passphrase,
+	)
+}
+
+// httpAuth returns the authentication method to use when communicating with a
+// remote repository using the HTTP protocol.
+//
+// It returns nil if both the username and password are empty.
+func httpAuth(username, password string) transport.AuthMethod {
+	if username == "" && password == "" {
+		return nil
+	}
+
+	return &http.BasicAuth{
+		Username: username,
+		Password: password,
+	}
+}
+
+// urlAuth returns the authentication method to use when communicating with the
+// remote repository at the given URL, based on the URL's protocol.
+func urlAuth(
+	url string,
+	sshKeyFile, sshKeyPassphrase string,
+	httpUsername, httpPassword string,
+) (transport.AuthMethod, error) {
+	ep, err := transport.NewEndpoint(url)
+	if err != nil {
+		return nil, err
+	}
+
+	switch ep.Protocol {
+	case "ssh":
+		return sshAuth(url, sshKeyFile, sshKeyPassphrase)
+	case "http", "https":
+		return httpAuth(httpUsername, httpPassword), nil
+	default:
+		return nil, nil
+	}
+}
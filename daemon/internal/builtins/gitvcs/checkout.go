@@ -0,0 +1,147 @@
+package gitvcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// fetchedRef is the reference that CheckoutRemoteRef() temporarily fetches
+// into before updating the local branch.
+const fetchedRef plumbing.ReferenceName = "refs/grit/fetched"
+
+// CheckoutRemoteRef fetches a reference from one of the clone's remotes into a
+// local branch, then checks that branch out.
+//
+// The branch is created if it does not already exist, otherwise it is
+// fast-forwarded to the fetched commit. An error is returned if the branch has
+// diverged from the fetched commit, or if the working tree has uncommitted
+// changes.
+func (c *LocalClone) CheckoutRemoteRef(
+	ctx context.Context,
+	remote string,
+	ref plumbing.ReferenceName,
+	branch string,
+	log logs.Log,
+) error {
+	repo, err := git.PlainOpen(c.Dir)
+	if err != nil {
+		return err
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+
+	status, err := wt.Status()
+	if err != nil {
+		return err
+	}
+
+	if !status.IsClean() {
+		return errors.New("the working tree has uncommitted changes")
+	}
+
+	hash, err := c.fetchRef(ctx, repo, remote, ref, log)
+	if err != nil {
+		return err
+	}
+
+	name := plumbing.NewBranchReferenceName(branch)
+
+	existing, err := repo.Reference(name, true)
+	if err == plumbing.ErrReferenceNotFound {
+		log.WriteVerbose("creating the '%s' branch at %s", branch, hash.String()[:7])
+	} else if err != nil {
+		return err
+	} else if existing.Hash() != hash {
+		fetched, err := repo.CommitObject(hash)
+		if err != nil {
+			return err
+		}
+
+		if ok, err := isReachable(repo, existing.Hash(), []*object.Commit{fetched}); err != nil {
+			return err
+		} else if !ok {
+			return fmt.Errorf("the '%s' branch has diverged from %s", branch, ref)
+		}
+
+		log.Write(
+			"fast-forwarding the '%s' branch from %s to %s",
+			branch,
+			existing.Hash().String()[:7],
+			hash.String()[:7],
+		)
+	}
+
+	if err := repo.Storer.SetReference(
+		plumbing.NewHashReference(name, hash),
+	); err != nil {
+		return err
+	}
+
+	// A hard reset of a clean working tree is used to update the files, which
+	// is equivalent to a regular checkout when switching from another branch
+	// and to a fast-forward merge when the branch is already checked out.
+	if err := repo.Storer.SetReference(
+		plumbing.NewSymbolicReference(plumbing.HEAD, name),
+	); err != nil {
+		return err
+	}
+
+	return wt.Reset(&git.ResetOptions{
+		Commit: hash,
+		Mode:   git.HardReset,
+	})
+}
+
+// fetchRef fetches a single reference from the given remote and returns the
+// hash of the commit it refers to.
+func (c *LocalClone) fetchRef(
+	ctx context.Context,
+	repo *git.Repository,
+	remote string,
+	ref plumbing.ReferenceName,
+	log logs.Log,
+) (plumbing.Hash, error) {
+	r, err := repo.Remote(remote)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	auth, err := c.auth(r.Config().URLs[0])
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	defer repo.Storer.RemoveReference(fetchedRef)
+
+	err = r.FetchContext(
+		ctx,
+		&git.FetchOptions{
+			RemoteName: remote,
+			RefSpecs: []config.RefSpec{
+				config.RefSpec(fmt.Sprintf("+%s:%s", ref, fetchedRef)),
+			},
+			Auth:     auth,
+			Progress: progressWriter(log),
+		},
+	)
+	if err != nil && err != git.NoErrAlreadyUpToDate {
+		return plumbing.ZeroHash, err
+	}
+
+	fetched, err := repo.Reference(fetchedRef, true)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	return fetched.Hash(), nil
+}
@@ -0,0 +1,153 @@
+package gitvcs_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	. "github.com/gritcli/grit/daemon/internal/builtins/gitvcs"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func LocalClone.CheckoutRemoteRef()", func() {
+	const pullRef plumbing.ReferenceName = "refs/pull/1/head"
+
+	var (
+		ctx      context.Context
+		upstream *git.Repository
+		dir      string
+		clone    *LocalClone
+	)
+
+	BeforeEach(func() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+		DeferCleanup(cancel)
+
+		tempDir, err := os.MkdirTemp("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(func() {
+			os.RemoveAll(tempDir)
+		})
+
+		upstream = initRepo(filepath.Join(tempDir, "upstream"))
+		commitFile(upstream, "README.md", "<content>")
+
+		dir = filepath.Join(tempDir, "clone")
+		_, err = git.PlainClone(
+			dir,
+			false, // isBare
+			&git.CloneOptions{
+				URL: filepath.Join(tempDir, "upstream"),
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		clone = &LocalClone{
+			Dir: dir,
+		}
+	})
+
+	// setPullRef commits a change in the upstream repository and points the
+	// pull request reference at the new commit.
+	setPullRef := func(content string) plumbing.Hash {
+		hash := commitFile(upstream, "README.md", content)
+
+		err := upstream.Storer.SetReference(
+			plumbing.NewHashReference(pullRef, hash),
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		return hash
+	}
+
+	// openClone opens the local clone. A new repository value is required each
+	// time the clone is inspected, as it caches the set of packfiles.
+	openClone := func() *git.Repository {
+		r, err := git.PlainOpen(dir)
+		Expect(err).ShouldNot(HaveOccurred())
+		return r
+	}
+
+	// expectCheckedOut asserts that the given branch is checked out at the
+	// given commit.
+	expectCheckedOut := func(branch string, hash plumbing.Hash) {
+		head, err := openClone().Head()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(head.Name()).To(Equal(plumbing.NewBranchReferenceName(branch)))
+		Expect(head.Hash()).To(Equal(hash))
+	}
+
+	It("fetches the reference into a new branch and checks it out", func() {
+		hash := setPullRef("<pull request>")
+
+		err := clone.CheckoutRemoteRef(ctx, "origin", pullRef, "pr/1", logs.Discard)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		expectCheckedOut("pr/1", hash)
+		Expect(filepath.Join(dir, "README.md")).To(BeARegularFile())
+
+		data, err := os.ReadFile(filepath.Join(dir, "README.md"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(data)).To(Equal("<pull request>"))
+	})
+
+	It("fast-forwards a branch that is already checked out", func() {
+		setPullRef("<pull request>")
+
+		err := clone.CheckoutRemoteRef(ctx, "origin", pullRef, "pr/1", logs.Discard)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		hash := setPullRef("<updated>")
+
+		err = clone.CheckoutRemoteRef(ctx, "origin", pullRef, "pr/1", logs.Discard)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		expectCheckedOut("pr/1", hash)
+	})
+
+	It("does not leave the temporary reference in the clone", func() {
+		setPullRef("<pull request>")
+
+		err := clone.CheckoutRemoteRef(ctx, "origin", pullRef, "pr/1", logs.Discard)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = openClone().Reference("refs/grit/fetched", false)
+		Expect(err).To(Equal(plumbing.ErrReferenceNotFound))
+	})
+
+	It("returns an error if the branch has diverged", func() {
+		setPullRef("<pull request>")
+
+		err := clone.CheckoutRemoteRef(ctx, "origin", pullRef, "pr/1", logs.Discard)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		commitFile(openClone(), "local.txt", "<local>")
+
+		// Rewrite the pull request so that it no longer contains the local
+		// commit.
+		hash := setPullRef("<rewritten>")
+
+		err = clone.CheckoutRemoteRef(ctx, "origin", pullRef, "pr/1", logs.Discard)
+		Expect(err).To(MatchError("the 'pr/1' branch has diverged from refs/pull/1/head"))
+
+		head, err := openClone().Head()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(head.Hash()).NotTo(Equal(hash))
+	})
+
+	It("returns an error if the working tree has uncommitted changes", func() {
+		setPullRef("<pull request>")
+
+		err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("<modified>"), 0600)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = clone.CheckoutRemoteRef(ctx, "origin", pullRef, "pr/1", logs.Discard)
+		Expect(err).To(MatchError("the working tree has uncommitted changes"))
+	})
+})
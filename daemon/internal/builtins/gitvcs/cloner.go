@@ -3,13 +3,13 @@ package gitvcs
 import (
 	"context"
 	"errors"
-	"io"
+	"net/url"
 	"os"
 
 	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing/transport"
-	"github.com/go-git/go-git/v5/plumbing/transport/http"
-	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
 	"github.com/gritcli/grit/daemon/internal/logs"
 )
 
@@ -44,29 +44,238 @@ type Cloner struct {
 	// PreferHTTP indicates that the HTTP protocol should be used in preference
 	// to SSH. By default SSH is preferred.
 	PreferHTTP bool
+
+	// Depth limits the history of the clone to the given number of commits.
+	// A value of zero means the full history is cloned.
+	Depth int
+
+	// SingleBranch indicates that only the repository's default branch should
+	// be cloned.
+	SingleBranch bool
+
+	// UserName is the name to use as the author and committer of commits made
+	// in the clone. If it is empty, Git's global configuration is used.
+	UserName string
+
+	// UserEmail is the email address to use as the author and committer of
+	// commits made in the clone. If it is empty, Git's global configuration is
+	// used.
+	UserEmail string
+
+	// SigningKey is the key used to sign commits and tags made in the clone.
+	// If it is empty, Git's global configuration is used.
+	SigningKey string
+
+	// SigningFormat is the format of SigningKey, such as "openpgp" or "ssh".
+	// If it is empty, Git's global configuration is used.
+	SigningFormat string
+
+	// MirrorDir is the directory of a MirrorCache. If it is non-empty, a
+	// mirror of the repository is created or updated before cloning and the
+	// clone borrows objects from the mirror instead of downloading them.
+	//
+	// The mirror is not used for shallow clones.
+	MirrorDir string
+
+	// UpstreamSSHEndpoint is the URL of the repository that this repository
+	// was forked from, for use with the SSH protocol. If it is non-empty and
+	// the clone uses SSH, an "upstream" remote is added to the clone.
+	UpstreamSSHEndpoint string
+
+	// UpstreamHTTPEndpoint is the URL of the repository that this repository
+	// was forked from, for use with the HTTP protocol. If it is non-empty and
+	// the clone uses HTTP, an "upstream" remote is added to the clone.
+	UpstreamHTTPEndpoint string
 }
 
+// upstreamRemoteName is the name of the remote that refers to the repository
+// that a clone was forked from.
+const upstreamRemoteName = "upstream"
+
 // Clone clones the repository into the given target directory.
+//
+// Any options in opts take precedence over those configured on the cloner.
 func (c *Cloner) Clone(
 	ctx context.Context,
 	dir string,
+	opts sourcedriver.CloneOptions,
 	log logs.Log,
 ) error {
-	opts, err := c.cloneOptions(log)
+	gitOpts, err := c.cloneOptions(log)
 	if err != nil {
 		return err
 	}
 
-	_, err = git.PlainCloneContext(
-		ctx,
-		dir,
-		false, // isBare
-		opts,
-	)
+	gitOpts.Depth = c.Depth
+	if opts.Depth != nil {
+		gitOpts.Depth = *opts.Depth
+	}
+
+	gitOpts.SingleBranch = c.SingleBranch
+	if opts.SingleBranch != nil {
+		gitOpts.SingleBranch = *opts.SingleBranch
+	}
+
+	var r *git.Repository
+
+	if c.MirrorDir != "" && gitOpts.Depth == 0 {
+		r, err = c.cloneWithMirror(ctx, dir, gitOpts, log)
+	} else {
+		r, err = git.PlainCloneContext(
+			ctx,
+			dir,
+			false, // isBare
+			gitOpts,
+		)
+	}
+	if errors.Is(err, transport.ErrEmptyRemoteRepository) {
+		log.WriteVerbose("the repository is empty, initializing an empty clone")
+		r, err = initEmptyClone(dir, gitOpts.URL)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := c.configure(r); err != nil {
+		return err
+	}
+
+	return c.addUpstream(r, gitOpts.URL == c.HTTPEndpoint)
+}
+
+// cloneWithMirror updates the repository's mirror then clones the repository
+// using the mirror as a reference repository.
+//
+// If the mirror can not be updated the repository is cloned without it.
+func (c *Cloner) cloneWithMirror(
+	ctx context.Context,
+	dir string,
+	opts *git.CloneOptions,
+	log logs.Log,
+) (*git.Repository, error) {
+	mirror, err := updateMirror(ctx, c.MirrorDir, opts.URL, opts.Auth, log)
+	if err != nil {
+		// There is nothing to borrow from the mirror of an empty repository,
+		// so there is no need to report the failure.
+		if !errors.Is(err, transport.ErrEmptyRemoteRepository) {
+			log.Write("unable to update mirror, cloning without it: %s", err)
+		}
+
+		return git.PlainCloneContext(
+			ctx,
+			dir,
+			false, // isBare
+			opts,
+		)
+	}
+
+	log.WriteVerbose("using mirror in %s", mirror)
+
+	return cloneWithMirror(ctx, dir, mirror, opts)
+}
+
+// initEmptyClone initializes a new repository in dir with an "origin" remote
+// that refers to the empty repository at the given URL.
+func initEmptyClone(dir, url string) (*git.Repository, error) {
+	r, err := git.PlainInit(dir, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := r.CreateRemote(&config.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{url},
+	}); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// configure writes the cloner's identity and signing configuration into the
+// local configuration of the new clone.
+func (c *Cloner) configure(r *git.Repository) error {
+	if c.UserName == "" && c.UserEmail == "" && c.SigningKey == "" && c.SigningFormat == "" {
+		return nil
+	}
+
+	cfg, err := r.Config()
+	if err != nil {
+		return err
+	}
+
+	if c.UserName != "" {
+		cfg.User.Name = c.UserName
+	}
+
+	if c.UserEmail != "" {
+		cfg.User.Email = c.UserEmail
+	}
+
+	if c.SigningKey != "" {
+		cfg.Raw.Section("user").SetOption("signingkey", c.SigningKey)
+		cfg.Raw.Section("commit").SetOption("gpgsign", "true")
+		cfg.Raw.Section("tag").SetOption("gpgsign", "true")
+	}
+
+	if c.SigningFormat != "" {
+		cfg.Raw.Section("gpg").SetOption("format", c.SigningFormat)
+	}
+
+	return r.SetConfig(cfg)
+}
+
+// addUpstream adds the "upstream" remote to the new clone, if the cloner is
+// configured with an upstream endpoint for the protocol that was used to clone.
+func (c *Cloner) addUpstream(r *git.Repository, useHTTP bool) error {
+	url := c.UpstreamSSHEndpoint
+	if useHTTP {
+		url = c.UpstreamHTTPEndpoint
+	}
+
+	if url == "" {
+		return nil
+	}
+
+	_, err := r.CreateRemote(&config.RemoteConfig{
+		Name: upstreamRemoteName,
+		URLs: []string{url},
+	})
 
 	return err
 }
 
+// CloneURLs returns the URLs that may be used to clone the repository, in
+// order of preference.
+func (c *Cloner) CloneURLs() []sourcedriver.CloneURL {
+	var ssh, http []sourcedriver.CloneURL
+
+	if c.SSHEndpoint != "" {
+		ssh = append(ssh, sourcedriver.CloneURL{
+			Protocol: "ssh",
+			URL:      c.SSHEndpoint,
+		})
+	}
+
+	if c.HTTPEndpoint != "" {
+		protocol := "http"
+		if u, err := url.Parse(c.HTTPEndpoint); err == nil && u.Scheme != "" {
+			protocol = u.Scheme
+		}
+
+		http = append(http, sourcedriver.CloneURL{
+			Protocol: protocol,
+			URL:      c.HTTPEndpoint,
+		})
+	}
+
+	if c.PreferHTTP {
+		return append(http, ssh...)
+	}
+
+	return append(ssh, http...)
+}
+
 // cloneOptions returns the options to use when cloning the repository, based on
 // the configuration of the cloner.
 func (c *Cloner) cloneOptions(log logs.Log) (*git.CloneOptions, error) {
@@ -82,20 +291,12 @@ func (c *Cloner) cloneOptions(log logs.Log) (*git.CloneOptions, error) {
 	return c.sshCloneOptions(log)
 }
 
-// sshCloneOptions returns options that clone the repository using the HTTP
+// httpCloneOptions returns options that clone the repository using the HTTP
 // protocol.
 func (c *Cloner) httpCloneOptions(log logs.Log) (*git.CloneOptions, error) {
-	var auth *http.BasicAuth
-	if c.HTTPUsername != "" || c.HTTPPassword != "" {
-		auth = &http.BasicAuth{
-			Username: c.HTTPUsername,
-			Password: c.HTTPPassword,
-		}
-	}
-
 	return &git.CloneOptions{
 		URL:      c.HTTPEndpoint,
-		Auth:     auth,
+		Auth:     httpAuth(c.HTTPUsername, c.HTTPPassword),
 		Progress: progressWriter(log),
 	}, nil
 }
@@ -103,30 +304,20 @@ func (c *Cloner) httpCloneOptions(log logs.Log) (*git.CloneOptions, error) {
 // sshCloneOptions returns options that clone the repository using the SSH
 // protocol.
 func (c *Cloner) sshCloneOptions(log logs.Log) (*git.CloneOptions, error) {
-	opts := &git.CloneOptions{
-		URL:      c.SSHEndpoint,
-		Progress: progressWriter(log),
-	}
-
-	if c.SSHKeyFile != "" {
-		ep, err := transport.NewEndpoint(c.SSHEndpoint)
-		if err != nil {
-			return nil, err
-		}
-
-		publicKeys, err := ssh.NewPublicKeysFromFile(
-			ep.User,
-			c.SSHKeyFile,
-			c.SSHKeyPassphrase,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		opts.Auth = publicKeys
+	auth, err := sshAuth(
+		c.SSHEndpoint,
+		c.SSHKeyFile,
+		c.SSHKeyPassphrase,
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	return opts, nil
+	return &git.CloneOptions{
+		URL:      c.SSHEndpoint,
+		Auth:     auth,
+		Progress: progressWriter(log),
+	}, nil
 }
 
 // useHTTP returns true if the HTTP protocol should be used to clone a
@@ -164,10 +355,3 @@ func (c *Cloner) useHTTP() (bool, error) {
 
 	return false, errors.New("SSH is the only available protocol but there is no SSH agent and no private key was provided")
 }
-
-// progressWriter returns the writer used to log the output from Git.
-func progressWriter(log logs.Log) io.Writer {
-	return &logs.Writer{
-		Target: log.WithPrefix("git: "),
-	}
-}
@@ -3,9 +3,14 @@ package gitvcs // note: no _test suffix to allow testing unexported useHTTP() me
 import (
 	"context"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
 	"github.com/gritcli/grit/daemon/internal/logs"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -49,7 +54,7 @@ var _ = Describe("type Cloner", func() {
 			// locally using the developer's SSH agent.
 			cloner.SSHEndpoint = "git@github.com:grit-integration-tests/test-public.git"
 
-			err := cloner.Clone(ctx, tempDir, buffer.Log())
+			err := cloner.Clone(ctx, tempDir, sourcedriver.CloneOptions{}, buffer.Log())
 			Expect(err).ShouldNot(HaveOccurred())
 			expectCloneWithURL(tempDir, cloner.SSHEndpoint, buffer)
 		})
@@ -60,7 +65,7 @@ var _ = Describe("type Cloner", func() {
 			cloner.SSHEndpoint = "git@github.com:grit-integration-tests/test-private.git"
 			cloner.SSHKeyFile = "../../../../testdata/keys/deploy-key-no-passphrase"
 
-			err := cloner.Clone(ctx, tempDir, buffer.Log())
+			err := cloner.Clone(ctx, tempDir, sourcedriver.CloneOptions{}, buffer.Log())
 			Expect(err).ShouldNot(HaveOccurred())
 			expectCloneWithURL(tempDir, cloner.SSHEndpoint, buffer)
 		})
@@ -72,7 +77,7 @@ var _ = Describe("type Cloner", func() {
 			cloner.SSHKeyFile = "../../../../testdata/keys/deploy-key-with-passphrase"
 			cloner.SSHKeyPassphrase = "passphrase"
 
-			err := cloner.Clone(ctx, tempDir, buffer.Log())
+			err := cloner.Clone(ctx, tempDir, sourcedriver.CloneOptions{}, buffer.Log())
 			Expect(err).ShouldNot(HaveOccurred())
 			expectCloneWithURL(tempDir, cloner.SSHEndpoint, buffer)
 		})
@@ -83,7 +88,7 @@ var _ = Describe("type Cloner", func() {
 			cloner.HTTPEndpoint = "https://github.com/grit-integration-tests/test-public.git"
 			cloner.PreferHTTP = true
 
-			err := cloner.Clone(ctx, tempDir, buffer.Log())
+			err := cloner.Clone(ctx, tempDir, sourcedriver.CloneOptions{}, buffer.Log())
 			Expect(err).ShouldNot(HaveOccurred())
 			expectCloneWithURL(tempDir, cloner.HTTPEndpoint, buffer)
 		})
@@ -101,10 +106,190 @@ var _ = Describe("type Cloner", func() {
 			cloner.HTTPPassword = token
 			cloner.PreferHTTP = true
 
-			err := cloner.Clone(ctx, tempDir, buffer.Log())
+			err := cloner.Clone(ctx, tempDir, sourcedriver.CloneOptions{}, buffer.Log())
 			Expect(err).ShouldNot(HaveOccurred())
 			expectCloneWithURL(tempDir, cloner.HTTPEndpoint, buffer)
 		})
+
+		It("initializes an empty clone of an empty repository", func() {
+			upstreamDir := filepath.Join(tempDir, "upstream")
+			_, err := git.PlainInit(upstreamDir, true)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			cloner.HTTPEndpoint = upstreamDir
+			cloner.PreferHTTP = true
+			cloner.MirrorDir = filepath.Join(tempDir, "mirrors")
+
+			dir := filepath.Join(tempDir, "clone")
+			err = cloner.Clone(ctx, dir, sourcedriver.CloneOptions{}, buffer.Log())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			r, err := git.PlainOpen(dir)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			rem, err := r.Remote("origin")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rem.Config().URLs).To(ConsistOf(upstreamDir))
+		})
+
+		When("cloning a local repository", func() {
+			var dir string
+
+			BeforeEach(func() {
+				upstreamDir := filepath.Join(tempDir, "upstream")
+				initUpstream(upstreamDir)
+
+				cloner.HTTPEndpoint = upstreamDir
+				cloner.PreferHTTP = true
+
+				dir = filepath.Join(tempDir, "clone")
+			})
+
+			It("makes a shallow single-branch clone when configured to do so", func() {
+				cloner.Depth = 1
+				cloner.SingleBranch = true
+
+				err := cloner.Clone(ctx, dir, sourcedriver.CloneOptions{}, buffer.Log())
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(filepath.Join(dir, ".git", "shallow")).To(BeAnExistingFile())
+				Expect(remoteBranches(dir)).To(ConsistOf("master"))
+			})
+
+			It("allows the options to override the configuration", func() {
+				cloner.Depth = 1
+				cloner.SingleBranch = true
+
+				depth := 0
+				singleBranch := false
+
+				err := cloner.Clone(
+					ctx,
+					dir,
+					sourcedriver.CloneOptions{
+						Depth:        &depth,
+						SingleBranch: &singleBranch,
+					},
+					buffer.Log(),
+				)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(filepath.Join(dir, ".git", "shallow")).NotTo(BeAnExistingFile())
+				Expect(remoteBranches(dir)).To(ConsistOf("master", "feature"))
+			})
+		})
+	})
+
+	Describe("func configure()", func() {
+		It("writes the identity and signing configuration to the clone", func() {
+			r, err := git.PlainInit(tempDir, false)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			cloner.UserName = "<name>"
+			cloner.UserEmail = "<email>"
+			cloner.SigningKey = "<key>"
+			cloner.SigningFormat = "ssh"
+
+			err = cloner.configure(r)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			cfg, err := r.Config()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cfg.User.Name).To(Equal("<name>"))
+			Expect(cfg.User.Email).To(Equal("<email>"))
+			Expect(cfg.Raw.Section("user").Option("signingkey")).To(Equal("<key>"))
+			Expect(cfg.Raw.Section("commit").Option("gpgsign")).To(Equal("true"))
+			Expect(cfg.Raw.Section("tag").Option("gpgsign")).To(Equal("true"))
+			Expect(cfg.Raw.Section("gpg").Option("format")).To(Equal("ssh"))
+		})
+
+		It("does not modify the clone's configuration when no identity is configured", func() {
+			r, err := git.PlainInit(tempDir, false)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = cloner.configure(r)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			cfg, err := r.Config()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cfg.User.Email).To(BeEmpty())
+			Expect(cfg.Raw.HasSection("commit")).To(BeFalse())
+		})
+	})
+
+	Describe("func addUpstream()", func() {
+		BeforeEach(func() {
+			cloner.UpstreamSSHEndpoint = "git@github.com:owner/repo.git"
+			cloner.UpstreamHTTPEndpoint = "https://github.com/owner/repo.git"
+		})
+
+		It("adds an upstream remote that uses the SSH protocol", func() {
+			r, err := git.PlainInit(tempDir, false)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = cloner.addUpstream(r, false)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			rem, err := r.Remote("upstream")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rem.Config().URLs).To(ConsistOf("git@github.com:owner/repo.git"))
+		})
+
+		It("adds an upstream remote that uses the HTTP protocol", func() {
+			r, err := git.PlainInit(tempDir, false)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = cloner.addUpstream(r, true)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			rem, err := r.Remote("upstream")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rem.Config().URLs).To(ConsistOf("https://github.com/owner/repo.git"))
+		})
+
+		It("does not add a remote when there is no upstream endpoint", func() {
+			r, err := git.PlainInit(tempDir, false)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			cloner.UpstreamHTTPEndpoint = ""
+
+			err = cloner.addUpstream(r, true)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = r.Remote("upstream")
+			Expect(err).To(Equal(git.ErrRemoteNotFound))
+		})
+	})
+
+	Describe("func CloneURLs()", func() {
+		It("returns the SSH URL before the HTTP URL", func() {
+			cloner.SSHEndpoint = "git@github.com:gritcli/test-public.git"
+			cloner.HTTPEndpoint = "https://github.com/gritcli/test-public.git"
+
+			Expect(cloner.CloneURLs()).To(Equal([]sourcedriver.CloneURL{
+				{Protocol: "ssh", URL: "git@github.com:gritcli/test-public.git"},
+				{Protocol: "https", URL: "https://github.com/gritcli/test-public.git"},
+			}))
+		})
+
+		It("returns the HTTP URL first when HTTP is preferred", func() {
+			cloner.SSHEndpoint = "git@github.com:gritcli/test-public.git"
+			cloner.HTTPEndpoint = "http://github.com/gritcli/test-public.git"
+			cloner.PreferHTTP = true
+
+			Expect(cloner.CloneURLs()).To(Equal([]sourcedriver.CloneURL{
+				{Protocol: "http", URL: "http://github.com/gritcli/test-public.git"},
+				{Protocol: "ssh", URL: "git@github.com:gritcli/test-public.git"},
+			}))
+		})
+
+		It("omits endpoints that are not configured", func() {
+			cloner.HTTPEndpoint = "https://github.com/gritcli/test-public.git"
+
+			Expect(cloner.CloneURLs()).To(Equal([]sourcedriver.CloneURL{
+				{Protocol: "https", URL: "https://github.com/gritcli/test-public.git"},
+			}))
+		})
 	})
 
 	Describe("func useHTTP()", func() {
@@ -327,3 +512,62 @@ func expectCloneWithURL(dir, url string, buffer logs.Buffer) {
 		MatchRegexp(`git: Total \d+ \(delta \d+\), reused \d+ \(delta \d+\), pack-reused \d+`),
 	)
 }
+
+// initUpstream initializes a repository in dir with two commits on the master
+// branch and an additional "feature" branch.
+func initUpstream(dir string) {
+	r, err := git.PlainInit(dir, false)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	wt, err := r.Worktree()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	for _, content := range []string{"<first>", "<second>"} {
+		err := os.WriteFile(filepath.Join(dir, "README.md"), []byte(content), 0600)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = wt.Add("README.md")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = wt.Commit(content, &git.CommitOptions{
+			Author: &object.Signature{
+				Name:  "<name>",
+				Email: "<email>",
+				When:  time.Now(),
+			},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	head, err := r.Head()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	err = r.Storer.SetReference(
+		plumbing.NewHashReference(plumbing.NewBranchReferenceName("feature"), head.Hash()),
+	)
+	Expect(err).ShouldNot(HaveOccurred())
+}
+
+// remoteBranches returns the names of the branches of the "origin" remote in
+// the clone in dir.
+func remoteBranches(dir string) []string {
+	r, err := git.PlainOpen(dir)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	refs, err := r.References()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	var names []string
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Name().IsRemote() {
+			name := strings.TrimPrefix(ref.Name().Short(), "origin/")
+			if name != "HEAD" {
+				names = append(names, name)
+			}
+		}
+		return nil
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	return names
+}
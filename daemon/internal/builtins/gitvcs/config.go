@@ -1,6 +1,7 @@
 package gitvcs
 
 import (
+	"fmt"
 	"path/filepath"
 
 	"github.com/gritcli/grit/daemon/internal/driver/vcsdriver"
@@ -24,6 +25,36 @@ type Config struct {
 	// PreferHTTP indicates that Grit should prefer the HTTP transport. By
 	// default SSH is preferred.
 	PreferHTTP bool
+
+	// Depth limits the history of new clones to the given number of commits.
+	// A value of zero means the full history is cloned.
+	Depth int
+
+	// SingleBranch indicates that new clones should only contain the
+	// repository's default branch.
+	SingleBranch bool
+
+	// UserName is the name written to the configuration of new clones for use
+	// as the author and committer of commits.
+	UserName string
+
+	// UserEmail is the email address written to the configuration of new
+	// clones for use as the author and committer of commits.
+	UserEmail string
+
+	// SigningKey is the key written to the configuration of new clones for
+	// signing commits and tags. Signing is enabled in clones only when a key
+	// is configured.
+	SigningKey string
+
+	// SigningFormat is the format of SigningKey, one of "openpgp", "x509" or
+	// "ssh".
+	SigningFormat string
+
+	// MirrorDir is the directory in which bare mirrors of cloned repositories
+	// are kept for use as reference repositories. If it is empty, mirrors are
+	// not used.
+	MirrorDir string
 }
 
 // DescribeVCSConfig returns a human-readable description of the
@@ -41,6 +72,26 @@ func (c Config) DescribeVCSConfig() string {
 		desc += ", prefer http"
 	}
 
+	if c.Depth != 0 {
+		desc += fmt.Sprintf(", depth %d", c.Depth)
+	}
+
+	if c.SingleBranch {
+		desc += ", single branch"
+	}
+
+	if c.UserEmail != "" {
+		desc += ", commit as " + c.UserEmail
+	}
+
+	if c.SigningKey != "" {
+		desc += ", sign commits"
+	}
+
+	if c.MirrorDir != "" {
+		desc += ", use mirrors"
+	}
+
 	return desc
 }
 
@@ -50,7 +101,14 @@ type configSchema struct {
 		File       string `hcl:"file"`
 		Passphrase string `hcl:"passphrase,optional"`
 	} `hcl:"ssh_key,block"`
-	PreferHTTP *bool `hcl:"prefer_http"`
+	PreferHTTP    *bool   `hcl:"prefer_http"`
+	Depth         *int    `hcl:"depth"`
+	SingleBranch  *bool   `hcl:"single_branch"`
+	UserName      *string `hcl:"user_name"`
+	UserEmail     *string `hcl:"user_email"`
+	SigningKey    *string `hcl:"signing_key"`
+	SigningFormat *string `hcl:"signing_format"`
+	MirrorDir     *string `hcl:"mirror_dir"`
 }
 
 // configLoader is an implementation of vcsdriver.ConfigLoader for Git.
@@ -85,5 +143,49 @@ func (configLoader) UnmarshalAndMerge(
 		cfg.PreferHTTP = *s.PreferHTTP
 	}
 
+	if s.Depth != nil {
+		if *s.Depth < 0 {
+			return Config{}, fmt.Errorf("clone depth must not be negative (%d)", *s.Depth)
+		}
+
+		cfg.Depth = *s.Depth
+	}
+
+	if s.SingleBranch != nil {
+		cfg.SingleBranch = *s.SingleBranch
+	}
+
+	if s.UserName != nil {
+		cfg.UserName = *s.UserName
+	}
+
+	if s.UserEmail != nil {
+		cfg.UserEmail = *s.UserEmail
+	}
+
+	if s.SigningKey != nil {
+		cfg.SigningKey = *s.SigningKey
+	}
+
+	if s.SigningFormat != nil {
+		switch *s.SigningFormat {
+		case "", "openpgp", "x509", "ssh":
+			cfg.SigningFormat = *s.SigningFormat
+		default:
+			return Config{}, fmt.Errorf(
+				"unrecognized signing format (%s), the supported formats are 'openpgp', 'x509' and 'ssh'",
+				*s.SigningFormat,
+			)
+		}
+	}
+
+	if s.MirrorDir != nil {
+		cfg.MirrorDir = *s.MirrorDir
+
+		if err := ctx.NormalizePath(&cfg.MirrorDir); err != nil {
+			return Config{}, err
+		}
+	}
+
 	return cfg, nil
 }
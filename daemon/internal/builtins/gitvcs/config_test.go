@@ -33,6 +33,29 @@ var _ = Describe("type Config", func() {
 				},
 				"use ssh agent, prefer http",
 			),
+			Entry(
+				"shallow single-branch clones",
+				Config{
+					Depth:        1,
+					SingleBranch: true,
+				},
+				"use ssh agent, depth 1, single branch",
+			),
+			Entry(
+				"identity and signing key",
+				Config{
+					UserEmail:  "<email>",
+					SigningKey: "<key>",
+				},
+				"use ssh agent, commit as <email>, sign commits",
+			),
+			Entry(
+				"mirrors",
+				Config{
+					MirrorDir: "/path/to/mirrors",
+				},
+				"use ssh agent, use mirrors",
+			),
 		)
 	})
 })
@@ -83,6 +106,55 @@ var _ = Describe("type configLoader", func() {
 				PreferHTTP: true,
 			},
 		),
+		configtest.VCSSuccess(
+			"shallow single-branch clones",
+			`vcs "git" {
+				depth = 1
+				single_branch = true
+			}`,
+			Config{
+				Depth:        1,
+				SingleBranch: true,
+			},
+		),
+		configtest.VCSSuccess(
+			"identity and signing key",
+			`vcs "git" {
+				user_name = "<name>"
+				user_email = "<email>"
+				signing_key = "<key>"
+				signing_format = "ssh"
+			}`,
+			Config{
+				UserName:      "<name>",
+				UserEmail:     "<email>",
+				SigningKey:    "<key>",
+				SigningFormat: "ssh",
+			},
+		),
+		configtest.VCSSuccess(
+			"mirror directory",
+			`vcs "git" {
+				mirror_dir = "/path/to/mirrors"
+			}`,
+			Config{
+				MirrorDir: "/path/to/mirrors",
+			},
+		),
+		configtest.VCSFailure(
+			`unrecognized signing format`,
+			`vcs "git" {
+				signing_format = "<format>"
+			}`,
+			`<dir>/config-0.hcl: the global configuration for the 'git' version control system cannot be loaded: unrecognized signing format (<format>), the supported formats are 'openpgp', 'x509' and 'ssh'`,
+		),
+		configtest.VCSFailure(
+			`negative clone depth`,
+			`vcs "git" {
+				depth = -1
+			}`,
+			`<dir>/config-0.hcl: the global configuration for the 'git' version control system cannot be loaded: clone depth must not be negative (-1)`,
+		),
 		configtest.VCSFailure(
 			`explicit SSH passphrase without key file`,
 			`vcs "git" {
@@ -141,6 +213,36 @@ var _ = Describe("type configLoader", func() {
 				PreferHTTP: false,
 			},
 		),
+		configtest.VCSSourceSpecificSuccess(
+			"override identity",
+			`vcs "git" {
+				user_name = "<name>"
+				user_email = "<personal email>"
+			}`,
+			`vcs "git" {
+				user_email = "<work email>"
+				signing_key = "<key>"
+			}`,
+			Config{
+				UserName:   "<name>",
+				UserEmail:  "<work email>",
+				SigningKey: "<key>",
+			},
+		),
+		configtest.VCSSourceSpecificSuccess(
+			"override clone depth",
+			`vcs "git" {
+				depth = 1
+				single_branch = true
+			}`,
+			`vcs "git" {
+				depth = 0
+			}`,
+			Config{
+				Depth:        0,
+				SingleBranch: true,
+			},
+		),
 	)
 
 })
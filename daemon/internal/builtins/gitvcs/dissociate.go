@@ -0,0 +1,41 @@
+package gitvcs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+var _ sourcedriver.Dissociator = (*LocalClone)(nil)
+
+// Dissociate copies any objects that the local clone borrows from other
+// repositories (via Git's "alternates" mechanism) into the clone, then stops
+// borrowing them.
+//
+// It is equivalent to "git clone --dissociate", and like the worktree
+// operations it requires the "git" executable, as go-git can not repack
+// objects from an alternate object store.
+func (c *LocalClone) Dissociate(
+	ctx context.Context,
+	log logs.Log,
+) error {
+	alternates := filepath.Join(c.Dir, git.GitDirName, "objects", "info", "alternates")
+
+	if _, err := os.Stat(alternates); os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	log.WriteVerbose("copying borrowed objects into the clone")
+
+	if _, err := runGit(ctx, c.Dir, "repack", "-a", "-d", "-q"); err != nil {
+		return err
+	}
+
+	return os.Remove(alternates)
+}
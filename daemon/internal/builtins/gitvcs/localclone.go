@@ -0,0 +1,413 @@
+package gitvcs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// LocalClone is an implementation of sourcedriver.LocalClone that operates on
+// an existing local clone of a Git repository.
+type LocalClone struct {
+	// Dir is the directory containing the local clone.
+	Dir string
+
+	// SSHKeyFile is the path to the private SSH key used to authenticate when
+	// communicating with remotes that use the SSH transport.
+	//
+	// If it is empty, the system's SSH agent is queried to determine which key
+	// to use.
+	SSHKeyFile string
+
+	// SSHKeyPassphrase is the passphrase used to decrypt the SSH private key,
+	// if any. It is ignored if SSHKeyFile is empty.
+	SSHKeyPassphrase string
+
+	// HTTPUsername is the username to use when communicating with remotes that
+	// use the HTTP transport, if any.
+	HTTPUsername string
+
+	// HTTPPassword is the password to use when communicating with remotes that
+	// use the HTTP transport, if any.
+	HTTPPassword string
+}
+
+// Fetch fetches changes from each of the clone's remotes.
+func (c *LocalClone) Fetch(
+	ctx context.Context,
+	log logs.Log,
+) error {
+	repo, err := git.PlainOpen(c.Dir)
+	if err != nil {
+		return err
+	}
+
+	remotes, err := repo.Remotes()
+	if err != nil {
+		return err
+	}
+
+	for _, r := range remotes {
+		if err := c.fetch(ctx, r, log); err != nil {
+			return fmt.Errorf(
+				"unable to fetch from the '%s' remote: %w",
+				r.Config().Name,
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// Pull fetches changes from each of the clone's remotes then fast-forwards the
+// checked-out branch to match its upstream branch.
+func (c *LocalClone) Pull(
+	ctx context.Context,
+	log logs.Log,
+) (sourcedriver.PullResult, error) {
+	if err := c.Fetch(ctx, log); err != nil {
+		return sourcedriver.PullResult{}, err
+	}
+
+	repo, err := git.PlainOpen(c.Dir)
+	if err != nil {
+		return sourcedriver.PullResult{}, err
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return sourcedriver.PullResult{}, err
+	}
+
+	if !head.Name().IsBranch() {
+		return skipPull("HEAD is detached"), nil
+	}
+
+	branch := head.Name().Short()
+
+	upstream, ok, err := upstreamRef(repo, branch)
+	if err != nil {
+		return sourcedriver.PullResult{}, err
+	}
+	if !ok {
+		return skipPull("the '%s' branch does not track an upstream branch", branch), nil
+	}
+
+	target, err := repo.Reference(upstream, true)
+	if err != nil {
+		if err == plumbing.ErrReferenceNotFound {
+			return skipPull("the upstream branch (%s) does not exist", upstream.Short()), nil
+		}
+		return sourcedriver.PullResult{}, err
+	}
+
+	if target.Hash() == head.Hash() {
+		log.WriteVerbose("the '%s' branch is already up-to-date", branch)
+		return sourcedriver.PullResult{}, nil
+	}
+
+	local, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return sourcedriver.PullResult{}, err
+	}
+
+	remote, err := repo.CommitObject(target.Hash())
+	if err != nil {
+		return sourcedriver.PullResult{}, err
+	}
+
+	if ok, err := remote.IsAncestor(local); err != nil {
+		return sourcedriver.PullResult{}, err
+	} else if ok {
+		log.WriteVerbose("the '%s' branch is ahead of its upstream branch", branch)
+		return sourcedriver.PullResult{}, nil
+	}
+
+	if ok, err := local.IsAncestor(remote); err != nil {
+		return sourcedriver.PullResult{}, err
+	} else if !ok {
+		return skipPull("the '%s' branch has diverged from its upstream branch", branch), nil
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return sourcedriver.PullResult{}, err
+	}
+
+	status, err := wt.Status()
+	if err != nil {
+		return sourcedriver.PullResult{}, err
+	}
+
+	if !status.IsClean() {
+		return skipPull("the working tree has uncommitted changes"), nil
+	}
+
+	// A hard reset of a clean working tree to a descendant of HEAD is
+	// equivalent to a fast-forward merge.
+	if err := wt.Reset(&git.ResetOptions{
+		Commit: target.Hash(),
+		Mode:   git.HardReset,
+	}); err != nil {
+		return sourcedriver.PullResult{}, err
+	}
+
+	log.Write(
+		"fast-forwarded the '%s' branch from %s to %s",
+		branch,
+		head.Hash().String()[:7],
+		target.Hash().String()[:7],
+	)
+
+	return sourcedriver.PullResult{Updated: true}, nil
+}
+
+// Status returns information about the state of the local clone.
+func (c *LocalClone) Status(
+	ctx context.Context,
+	log logs.Log,
+) (sourcedriver.LocalStatus, error) {
+	repo, err := git.PlainOpen(c.Dir)
+	if err != nil {
+		return sourcedriver.LocalStatus{}, err
+	}
+
+	var status sourcedriver.LocalStatus
+
+	head, err := repo.Head()
+	if err == nil {
+		status.Head = head.Hash().String()
+		if head.Name().IsBranch() {
+			status.Branch = head.Name().Short()
+		}
+
+		commit, err := repo.CommitObject(head.Hash())
+		if err != nil {
+			return sourcedriver.LocalStatus{}, err
+		}
+		status.LastCommit = commit.Committer.When
+	} else if err != plumbing.ErrReferenceNotFound {
+		return sourcedriver.LocalStatus{}, err
+	}
+
+	status.LastAccess = lastAccess(filepath.Join(c.Dir, ".git"))
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return sourcedriver.LocalStatus{}, err
+	}
+
+	wts, err := wt.Status()
+	if err != nil {
+		return sourcedriver.LocalStatus{}, err
+	}
+
+	status.HasUncommittedChanges = !wts.IsClean()
+
+	status.UnpushedBranches, err = unpushedBranches(repo)
+	if err != nil {
+		return sourcedriver.LocalStatus{}, err
+	}
+
+	if _, err := repo.Reference("refs/stash", false); err == nil {
+		status.HasStashes = true
+	} else if err != plumbing.ErrReferenceNotFound {
+		return sourcedriver.LocalStatus{}, err
+	}
+
+	return status, nil
+}
+
+// RemoteURL returns the URL of the clone's "origin" remote.
+func (c *LocalClone) RemoteURL(
+	ctx context.Context,
+	log logs.Log,
+) (string, error) {
+	return RemoteURL(c.Dir, "origin")
+}
+
+// fetch fetches changes from a single remote.
+func (c *LocalClone) fetch(
+	ctx context.Context,
+	r *git.Remote,
+	log logs.Log,
+) error {
+	cfg := r.Config()
+
+	auth, err := c.auth(cfg.URLs[0])
+	if err != nil {
+		return err
+	}
+
+	err = r.FetchContext(
+		ctx,
+		&git.FetchOptions{
+			RemoteName: cfg.Name,
+			Auth:       auth,
+			Progress:   progressWriter(log),
+		},
+	)
+
+	if err == git.NoErrAlreadyUpToDate {
+		log.WriteVerbose("the '%s' remote is already up-to-date", cfg.Name)
+		return nil
+	}
+
+	return err
+}
+
+// auth returns the authentication method to use when communicating with the
+// remote repository at the given URL.
+func (c *LocalClone) auth(url string) (transport.AuthMethod, error) {
+	return urlAuth(
+		url,
+		c.SSHKeyFile,
+		c.SSHKeyPassphrase,
+		c.HTTPUsername,
+		c.HTTPPassword,
+	)
+}
+
+// upstreamRef returns the name of the reference that the given local branch
+// tracks. ok is false if the branch does not track an upstream branch.
+func upstreamRef(
+	repo *git.Repository,
+	branch string,
+) (_ plumbing.ReferenceName, ok bool, _ error) {
+	cfg, err := repo.Config()
+	if err != nil {
+		return "", false, err
+	}
+
+	b, ok := cfg.Branches[branch]
+	if !ok || b.Remote == "" || b.Merge == "" {
+		return "", false, nil
+	}
+
+	if b.Remote == "." {
+		return b.Merge, true, nil
+	}
+
+	return plumbing.NewRemoteReferenceName(b.Remote, b.Merge.Short()), true, nil
+}
+
+// skipPull returns a result indicating that a pull was skipped for the given
+// reason.
+func skipPull(format string, args ...any) sourcedriver.PullResult {
+	return sourcedriver.PullResult{
+		SkipReason: fmt.Sprintf(format, args...),
+	}
+}
+
+// unpushedBranches returns the names of the local branches that contain
+// commits that are not reachable from any remote-tracking branch.
+func unpushedBranches(repo *git.Repository) ([]string, error) {
+	refs, err := repo.References()
+	if err != nil {
+		return nil, err
+	}
+	defer refs.Close()
+
+	var (
+		branches []*plumbing.Reference
+		remotes  []*object.Commit
+	)
+
+	if err := refs.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() != plumbing.HashReference {
+			return nil
+		}
+
+		switch {
+		case ref.Name().IsBranch():
+			branches = append(branches, ref)
+		case ref.Name().IsRemote():
+			c, err := repo.CommitObject(ref.Hash())
+			if err != nil {
+				return err
+			}
+			remotes = append(remotes, c)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var unpushed []string
+
+	for _, b := range branches {
+		ok, err := isReachable(repo, b.Hash(), remotes)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			unpushed = append(unpushed, b.Name().Short())
+		}
+	}
+
+	return unpushed, nil
+}
+
+// isReachable returns true if the commit with the given hash is reachable from
+// any of the given commits.
+func isReachable(
+	repo *git.Repository,
+	hash plumbing.Hash,
+	from []*object.Commit,
+) (bool, error) {
+	c, err := repo.CommitObject(hash)
+	if err != nil {
+		return false, err
+	}
+
+	for _, f := range from {
+		if f.Hash == hash {
+			return true, nil
+		}
+
+		ok, err := c.IsAncestor(f)
+		if err != nil {
+			return false, err
+		}
+
+		if ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// lastAccess returns the time at which the repository in the given .git
+// directory was last used.
+//
+// It uses the modification times of files that Git updates whenever the
+// working tree is checked out, committed to or otherwise manipulated.
+func lastAccess(gitDir string) time.Time {
+	var t time.Time
+
+	for _, name := range []string{
+		"HEAD",
+		"index",
+		filepath.Join("logs", "HEAD"),
+	} {
+		info, err := os.Stat(filepath.Join(gitDir, name))
+		if err == nil && info.ModTime().After(t) {
+			t = info.ModTime()
+		}
+	}
+
+	return t
+}
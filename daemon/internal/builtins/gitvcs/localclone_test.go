@@ -0,0 +1,367 @@
+package gitvcs_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	. "github.com/gritcli/grit/daemon/internal/builtins/gitvcs"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type LocalClone", func() {
+	var (
+		ctx      context.Context
+		upstream *git.Repository
+		tempDir  string
+		dir      string
+		clone    *LocalClone
+	)
+
+	BeforeEach(func() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+		DeferCleanup(cancel)
+
+		var err error
+		tempDir, err = os.MkdirTemp("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(func() {
+			os.RemoveAll(tempDir)
+		})
+
+		upstream = initRepo(filepath.Join(tempDir, "upstream"))
+		commitFile(upstream, "README.md", "<content>")
+
+		dir = filepath.Join(tempDir, "clone")
+		_, err = git.PlainClone(
+			dir,
+			false, // isBare
+			&git.CloneOptions{
+				URL: filepath.Join(tempDir, "upstream"),
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		clone = &LocalClone{
+			Dir: dir,
+		}
+	})
+
+	Describe("func Fetch()", func() {
+		It("fetches new commits from the remote", func() {
+			hash := commitFile(upstream, "README.md", "<updated>")
+
+			err := clone.Fetch(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			repo, err := git.PlainOpen(dir)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			ref, err := repo.Reference(
+				plumbing.NewRemoteReferenceName("origin", "master"),
+				true,
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ref.Hash()).To(Equal(hash))
+		})
+
+		It("does not return an error if the clone is already up-to-date", func() {
+			var buffer logs.Buffer
+
+			err := clone.Fetch(ctx, buffer.Log())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer).To(ContainElement(
+				logs.Message{
+					Text:      "the 'origin' remote is already up-to-date",
+					IsVerbose: true,
+				},
+			))
+		})
+
+		It("returns an error if the directory is not a Git repository", func() {
+			clone.Dir = tempDir
+
+			err := clone.Fetch(ctx, logs.Discard)
+			Expect(err).To(MatchError(git.ErrRepositoryNotExists))
+		})
+	})
+
+	Describe("func Pull()", func() {
+		var repo *git.Repository
+
+		BeforeEach(func() {
+			var err error
+			repo, err = git.PlainOpen(dir)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("fast-forwards the checked-out branch", func() {
+			hash := commitFile(upstream, "README.md", "<updated>")
+
+			result, err := clone.Pull(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(sourcedriver.PullResult{Updated: true}))
+
+			head, err := repo.Head()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head.Hash()).To(Equal(hash))
+
+			data, err := os.ReadFile(filepath.Join(dir, "README.md"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(data)).To(Equal("<updated>"))
+		})
+
+		It("does not update the branch if it is already up-to-date", func() {
+			result, err := clone.Pull(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(sourcedriver.PullResult{}))
+		})
+
+		It("does not update the branch if it is ahead of its upstream branch", func() {
+			hash := commitFile(repo, "README.md", "<local>")
+
+			result, err := clone.Pull(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(sourcedriver.PullResult{}))
+
+			head, err := repo.Head()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head.Hash()).To(Equal(hash))
+		})
+
+		It("skips the pull if the working tree is dirty", func() {
+			commitFile(upstream, "README.md", "<updated>")
+
+			err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("<dirty>"), 0600)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			result, err := clone.Pull(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(sourcedriver.PullResult{
+				SkipReason: "the working tree has uncommitted changes",
+			}))
+
+			data, err := os.ReadFile(filepath.Join(dir, "README.md"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(data)).To(Equal("<dirty>"))
+		})
+
+		It("skips the pull if the branch has diverged from its upstream branch", func() {
+			commitFile(upstream, "README.md", "<updated>")
+			hash := commitFile(repo, "LOCAL.md", "<local>")
+
+			result, err := clone.Pull(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(sourcedriver.PullResult{
+				SkipReason: "the 'master' branch has diverged from its upstream branch",
+			}))
+
+			head, err := repo.Head()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head.Hash()).To(Equal(hash))
+		})
+
+		It("skips the pull if the branch does not track an upstream branch", func() {
+			wt, err := repo.Worktree()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = wt.Checkout(&git.CheckoutOptions{
+				Branch: plumbing.NewBranchReferenceName("feature"),
+				Create: true,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			result, err := clone.Pull(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(sourcedriver.PullResult{
+				SkipReason: "the 'feature' branch does not track an upstream branch",
+			}))
+		})
+
+		It("skips the pull if HEAD is detached", func() {
+			head, err := repo.Head()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			wt, err := repo.Worktree()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = wt.Checkout(&git.CheckoutOptions{
+				Hash: head.Hash(),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			result, err := clone.Pull(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(sourcedriver.PullResult{
+				SkipReason: "HEAD is detached",
+			}))
+		})
+	})
+
+	Describe("func Status()", func() {
+		var repo *git.Repository
+
+		BeforeEach(func() {
+			var err error
+			repo, err = git.PlainOpen(dir)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("returns the status of a clean clone", func() {
+			head, err := repo.Head()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			commit, err := repo.CommitObject(head.Hash())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			status, err := clone.Status(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.Branch).To(Equal("master"))
+			Expect(status.Head).To(Equal(head.Hash().String()))
+			Expect(status.LastCommit).To(BeTemporally("==", commit.Committer.When))
+			Expect(status.LastAccess).To(BeTemporally("~", time.Now(), 10*time.Second))
+			Expect(status.IsPushed()).To(BeTrue())
+		})
+
+		It("reports uncommitted changes", func() {
+			err := os.WriteFile(filepath.Join(dir, "NEW.md"), []byte("<new>"), 0600)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			status, err := clone.Status(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.HasUncommittedChanges).To(BeTrue())
+			Expect(status.IsPushed()).To(BeFalse())
+		})
+
+		It("reports branches with unpushed commits", func() {
+			commitFile(repo, "README.md", "<local>")
+
+			status, err := clone.Status(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.UnpushedBranches).To(ConsistOf("master"))
+			Expect(status.IsPushed()).To(BeFalse())
+		})
+
+		It("does not report branches that only contain pushed commits", func() {
+			head, err := repo.Head()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = repo.Storer.SetReference(
+				plumbing.NewHashReference(
+					plumbing.NewBranchReferenceName("feature"),
+					head.Hash(),
+				),
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			commitFile(upstream, "README.md", "<updated>")
+			err = clone.Fetch(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			status, err := clone.Status(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.UnpushedBranches).To(BeEmpty())
+		})
+
+		It("does not report a branch that is behind its upstream branch", func() {
+			commitFile(upstream, "README.md", "<updated>")
+			err := clone.Fetch(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			status, err := clone.Status(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.UnpushedBranches).To(BeEmpty())
+			Expect(status.IsPushed()).To(BeTrue())
+		})
+
+		It("reports a branch that has diverged from its upstream branch", func() {
+			commitFile(repo, "README.md", "<local>")
+			commitFile(upstream, "README.md", "<updated>")
+			err := clone.Fetch(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			status, err := clone.Status(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.UnpushedBranches).To(ConsistOf("master"))
+			Expect(status.IsPushed()).To(BeFalse())
+		})
+
+		It("does not report a branch when HEAD is detached", func() {
+			head, err := repo.Head()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			wt, err := repo.Worktree()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = wt.Checkout(&git.CheckoutOptions{Hash: head.Hash()})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			status, err := clone.Status(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.Branch).To(BeEmpty())
+			Expect(status.Head).To(Equal(head.Hash().String()))
+		})
+
+		It("reports stashed changes", func() {
+			head, err := repo.Head()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = repo.Storer.SetReference(
+				plumbing.NewHashReference("refs/stash", head.Hash()),
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			status, err := clone.Status(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.HasStashes).To(BeTrue())
+			Expect(status.IsPushed()).To(BeFalse())
+		})
+	})
+})
+
+// initRepo initializes a new (non-bare) Git repository in the given directory.
+func initRepo(dir string) *git.Repository {
+	repo, err := git.PlainInit(dir, false)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	return repo
+}
+
+// commitFile writes a file to the repository's working tree and commits it,
+// returning the hash of the new commit.
+func commitFile(repo *git.Repository, name, content string) plumbing.Hash {
+	wt, err := repo.Worktree()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	err = os.WriteFile(
+		filepath.Join(wt.Filesystem.Root(), name),
+		[]byte(content),
+		0600,
+	)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	_, err = wt.Add(name)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	hash, err := wt.Commit(
+		"update "+name,
+		&git.CommitOptions{
+			Author: &object.Signature{
+				Name:  "<name>",
+				Email: "<email>",
+				When:  time.Now(),
+			},
+		},
+	)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	return hash
+}
@@ -0,0 +1,328 @@
+package gitvcs
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/go-git/go-billy/v5/osfs"
+	"github.com/go-git/go-billy/v5/util"
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/cache"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// MirrorCache is a directory containing bare mirrors of remote repositories.
+//
+// The mirrors are used as reference repositories (via Git's "alternates"
+// mechanism) when cloning, such that new clones borrow objects from the mirror
+// instead of downloading them again. As a consequence, a mirror must not be
+// removed while there are clones that refer to it.
+//
+// Each mirror is stored in a directory named after the host and path of the
+// repository's URL, such as "github.com/owner/repo.git", so that the same
+// mirror is used regardless of which protocol is used to clone.
+type MirrorCache struct {
+	// Dir is the directory containing the mirrors.
+	Dir string
+
+	// SSHKeyFile is the path to the private SSH key used to authenticate when
+	// refreshing mirrors that use the SSH transport.
+	//
+	// If it is empty, the system's SSH agent is queried to determine which key
+	// to use.
+	SSHKeyFile string
+
+	// SSHKeyPassphrase is the passphrase used to decrypt the SSH private key,
+	// if any. It is ignored if SSHKeyFile is empty.
+	SSHKeyPassphrase string
+
+	// HTTPUsername is the username to use when refreshing mirrors that use the
+	// HTTP transport, if any.
+	HTTPUsername string
+
+	// HTTPPassword is the password to use when refreshing mirrors that use the
+	// HTTP transport, if any.
+	HTTPPassword string
+}
+
+// Refresh fetches changes into each of the mirrors of repositories hosted on
+// the given host.
+//
+// A failure to refresh an individual mirror is logged but does not prevent the
+// remaining mirrors from being refreshed.
+func (m *MirrorCache) Refresh(
+	ctx context.Context,
+	host string,
+	log logs.Log,
+) error {
+	root := filepath.Join(m.Dir, host)
+
+	return filepath.WalkDir(
+		root,
+		func(dir string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if dir == root && errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+
+			if !d.IsDir() || !strings.HasSuffix(dir, ".git") {
+				return nil
+			}
+
+			if err := m.refresh(ctx, dir, log); err != nil {
+				log.Write("unable to refresh mirror in %s: %s", dir, err)
+			}
+
+			return filepath.SkipDir
+		},
+	)
+}
+
+// refresh fetches changes into the existing mirror in dir.
+func (m *MirrorCache) refresh(
+	ctx context.Context,
+	dir string,
+	log logs.Log,
+) error {
+	unlock := lockMirror(dir)
+	defer unlock()
+
+	r, err := git.PlainOpen(dir)
+	if err != nil {
+		return err
+	}
+
+	rem, err := r.Remote(git.DefaultRemoteName)
+	if err != nil {
+		return err
+	}
+
+	url := rem.Config().URLs[0]
+
+	auth, err := urlAuth(
+		url,
+		m.SSHKeyFile,
+		m.SSHKeyPassphrase,
+		m.HTTPUsername,
+		m.HTTPPassword,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := fetchMirror(ctx, r, auth, nil); err != nil {
+		return err
+	}
+
+	log.WriteVerbose("refreshed mirror of %s", url)
+
+	return nil
+}
+
+// updateMirror creates or updates the mirror of the repository at the given
+// URL and returns the directory containing it.
+func updateMirror(
+	ctx context.Context,
+	root, url string,
+	auth transport.AuthMethod,
+	log logs.Log,
+) (string, error) {
+	dir, err := mirrorDir(root, url)
+	if err != nil {
+		return "", err
+	}
+
+	unlock := lockMirror(dir)
+	defer unlock()
+
+	r, err := git.PlainOpen(dir)
+	if err == git.ErrRepositoryNotExists {
+		log.WriteVerbose("creating mirror in %s", dir)
+		r, err = initMirror(dir, url)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	rem, err := r.Remote(git.DefaultRemoteName)
+	if err != nil {
+		return "", err
+	}
+
+	// Always fetch from the URL that is about to be cloned so that the
+	// authentication method matches its protocol.
+	if rem.Config().URLs[0] != url {
+		rc, err := r.Config()
+		if err != nil {
+			return "", err
+		}
+
+		rc.Remotes[git.DefaultRemoteName].URLs = []string{url}
+
+		if err := r.SetConfig(rc); err != nil {
+			return "", err
+		}
+	}
+
+	if err := fetchMirror(ctx, r, auth, log); err != nil {
+		return "", err
+	}
+
+	return dir, nil
+}
+
+// initMirror initializes a new, empty mirror of the repository at the given
+// URL in dir.
+func initMirror(dir, url string) (*git.Repository, error) {
+	r, err := git.PlainInit(dir, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := r.CreateRemote(&config.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{url},
+		Fetch: []config.RefSpec{
+			"+refs/heads/*:refs/heads/*",
+			"+refs/tags/*:refs/tags/*",
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// fetchMirror fetches changes into the mirror r.
+//
+// Objects are never removed from the mirror, even if they are no longer
+// reachable, as they may still be used by the clones that refer to it.
+func fetchMirror(
+	ctx context.Context,
+	r *git.Repository,
+	auth transport.AuthMethod,
+	log logs.Log,
+) error {
+	err := r.FetchContext(
+		ctx,
+		&git.FetchOptions{
+			RemoteName: git.DefaultRemoteName,
+			Auth:       auth,
+			Progress:   progressWriter(log),
+			Force:      true,
+		},
+	)
+
+	if err == git.NoErrAlreadyUpToDate {
+		return nil
+	}
+
+	return err
+}
+
+// mirrorRefPrefix is the prefix of the references that are temporarily added
+// to a new clone that uses a mirror, see cloneWithMirror().
+const mirrorRefPrefix = "refs/grit/mirror/"
+
+// cloneWithMirror clones a repository into dir, borrowing objects from the
+// mirror in mirrorDir.
+func cloneWithMirror(
+	ctx context.Context,
+	dir, mirrorDir string,
+	opts *git.CloneOptions,
+) (*git.Repository, error) {
+	mirror, err := git.PlainOpen(mirrorDir)
+	if err != nil {
+		return nil, err
+	}
+
+	wt := osfs.New(dir)
+	dot, err := wt.Chroot(git.GitDirName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := util.WriteFile(
+		dot,
+		filepath.Join("objects", "info", "alternates"),
+		[]byte(filepath.Join(mirrorDir, "objects")+"\n"),
+		0644,
+	); err != nil {
+		return nil, err
+	}
+
+	s := filesystem.NewStorage(dot, cache.NewObjectLRUDefault())
+
+	// Expose the mirror's references within the clone so that Git tells the
+	// remote which objects are already available, otherwise any commits made
+	// since the mirror was last updated would cause the entire history to be
+	// downloaded.
+	refs, err := mirror.References()
+	if err != nil {
+		return nil, err
+	}
+
+	var seeded []plumbing.ReferenceName
+	if err := refs.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() != plumbing.HashReference {
+			return nil
+		}
+
+		name := plumbing.ReferenceName(mirrorRefPrefix + ref.Name().String())
+		seeded = append(seeded, name)
+
+		return s.SetReference(plumbing.NewHashReference(name, ref.Hash()))
+	}); err != nil {
+		return nil, err
+	}
+
+	r, err := git.CloneContext(ctx, s, wt, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range seeded {
+		if err := s.RemoveReference(name); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// mirrorDir returns the directory within root that contains the mirror of the
+// repository at the given URL.
+func mirrorDir(root, url string) (string, error) {
+	ep, err := transport.NewEndpoint(url)
+	if err != nil {
+		return "", err
+	}
+
+	p := strings.Trim(ep.Path, "/")
+	p = strings.TrimSuffix(p, ".git") + ".git"
+
+	return filepath.Join(root, ep.Host, filepath.FromSlash(p)), nil
+}
+
+// mirrorLocks is a map of mirror directory to the mutex that serializes
+// operations on that mirror.
+var mirrorLocks sync.Map // map[string]*sync.Mutex
+
+// lockMirror acquires the lock for the mirror in dir and returns a function
+// that releases it.
+func lockMirror(dir string) func() {
+	v, _ := mirrorLocks.LoadOrStore(dir, &sync.Mutex{})
+	m := v.(*sync.Mutex)
+	m.Lock()
+	return m.Unlock
+}
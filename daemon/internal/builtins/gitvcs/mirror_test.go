@@ -0,0 +1,193 @@
+package gitvcs_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	. "github.com/gritcli/grit/daemon/internal/builtins/gitvcs"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type MirrorCache", func() {
+	var (
+		ctx         context.Context
+		tempDir     string
+		upstreamDir string
+		mirrorDir   string
+		upstream    *git.Repository
+		cloner      *Cloner
+		cache       *MirrorCache
+	)
+
+	BeforeEach(func() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+		DeferCleanup(cancel)
+
+		var err error
+		tempDir, err = os.MkdirTemp("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(func() {
+			os.RemoveAll(tempDir)
+		})
+
+		upstreamDir = filepath.Join(tempDir, "upstream")
+		upstream = initRepo(upstreamDir)
+		commitFile(upstream, "README.md", "<content>")
+
+		cache = &MirrorCache{
+			Dir: filepath.Join(tempDir, "mirrors"),
+		}
+
+		mirrorDir = filepath.Join(
+			cache.Dir,
+			strings.TrimPrefix(upstreamDir, string(filepath.Separator))+".git",
+		)
+
+		cloner = &Cloner{
+			HTTPEndpoint: upstreamDir,
+			PreferHTTP:   true,
+			MirrorDir:    cache.Dir,
+		}
+	})
+
+	// mirrorHead returns the hash of the master branch in the mirror.
+	mirrorHead := func() plumbing.Hash {
+		r, err := git.PlainOpen(mirrorDir)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		ref, err := r.Reference(plumbing.Master, false)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		return ref.Hash()
+	}
+
+	When("used by a cloner", func() {
+		It("creates a mirror and borrows its objects", func() {
+			dir := filepath.Join(tempDir, "clone")
+
+			err := cloner.Clone(ctx, dir, sourcedriver.CloneOptions{}, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(filepath.Join(dir, "README.md")).To(BeARegularFile())
+
+			alternates, err := os.ReadFile(filepath.Join(dir, ".git", "objects", "info", "alternates"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(alternates)).To(Equal(filepath.Join(mirrorDir, "objects") + "\n"))
+
+			packs, err := filepath.Glob(filepath.Join(dir, ".git", "objects", "pack", "*.pack"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(packs).To(BeEmpty())
+		})
+
+		It("does not leave the mirror's references in the clone", func() {
+			dir := filepath.Join(tempDir, "clone")
+
+			err := cloner.Clone(ctx, dir, sourcedriver.CloneOptions{}, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			r, err := git.PlainOpen(dir)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			refs, err := r.References()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = refs.ForEach(func(ref *plumbing.Reference) error {
+				Expect(ref.Name().String()).NotTo(HavePrefix("refs/grit/"))
+				return nil
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("updates an existing mirror before cloning", func() {
+			err := cloner.Clone(ctx, filepath.Join(tempDir, "clone-1"), sourcedriver.CloneOptions{}, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			hash := commitFile(upstream, "README.md", "<updated>")
+
+			dir := filepath.Join(tempDir, "clone-2")
+			err = cloner.Clone(ctx, dir, sourcedriver.CloneOptions{}, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(mirrorHead()).To(Equal(hash))
+
+			r, err := git.PlainOpen(dir)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			head, err := r.Head()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head.Hash()).To(Equal(hash))
+		})
+
+		It("does not use the mirror for shallow clones", func() {
+			dir := filepath.Join(tempDir, "clone")
+
+			depth := 1
+			err := cloner.Clone(ctx, dir, sourcedriver.CloneOptions{Depth: &depth}, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(mirrorDir).NotTo(BeADirectory())
+			Expect(filepath.Join(dir, ".git", "objects", "info", "alternates")).NotTo(BeAnExistingFile())
+		})
+	})
+
+	When("a clone that uses the mirror is dissociated", func() {
+		It("no longer depends on the mirror", func() {
+			dir := filepath.Join(tempDir, "clone")
+
+			err := cloner.Clone(ctx, dir, sourcedriver.CloneOptions{}, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			clone := &LocalClone{Dir: dir}
+			err = clone.Dissociate(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(filepath.Join(dir, ".git", "objects", "info", "alternates")).NotTo(BeAnExistingFile())
+
+			Expect(os.RemoveAll(cache.Dir)).To(Succeed())
+
+			r, err := git.PlainOpen(dir)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			head, err := r.Head()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = r.CommitObject(head.Hash())
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("does nothing if the clone does not borrow any objects", func() {
+			dir := filepath.Join(tempDir, "clone")
+
+			depth := 1
+			err := cloner.Clone(ctx, dir, sourcedriver.CloneOptions{Depth: &depth}, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			clone := &LocalClone{Dir: dir}
+			err = clone.Dissociate(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+
+	Describe("func Refresh()", func() {
+		It("fetches new commits into existing mirrors", func() {
+			err := cloner.Clone(ctx, filepath.Join(tempDir, "clone"), sourcedriver.CloneOptions{}, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			hash := commitFile(upstream, "README.md", "<updated>")
+
+			err = cache.Refresh(ctx, "", logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(mirrorHead()).To(Equal(hash))
+		})
+
+		It("does nothing if there are no mirrors", func() {
+			err := cache.Refresh(ctx, "github.com", logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,81 @@
+package gitvcs
+
+import (
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// progressWriter returns the writer used to log the output from Git.
+//
+// Lines that describe the progress of the operation are annotated with
+// structured progress information, see parseProgress().
+func progressWriter(log logs.Log) io.Writer {
+	if log == nil {
+		return io.Discard
+	}
+
+	log = log.WithPrefix("git: ")
+
+	return &logs.Writer{
+		Target: func(m logs.Message) {
+			if p, ok := parseProgress(m.Text); ok {
+				m.Progress = &p
+			}
+
+			log(m)
+		},
+	}
+}
+
+// progressPattern matches the progress lines that Git writes to the sideband
+// channel, such as:
+//
+//	Enumerating objects: 20, done.
+//	Counting objects:  45% (9/20)
+//	Receiving objects:  45% (9/20), 1.20 MiB | 500.00 KiB/s
+var progressPattern = regexp.MustCompile(
+	`^([A-Za-z][A-Za-z ]*):\s+(?:\d+% \((\d+)/(\d+)\)|(\d+))(?:,\s+([\d.]+) (bytes|KiB|MiB|GiB))?`,
+)
+
+// byteUnits maps the units used by Git to describe transfer sizes to the
+// number of bytes per unit.
+var byteUnits = map[string]float64{
+	"bytes": 1,
+	"KiB":   1 << 10,
+	"MiB":   1 << 20,
+	"GiB":   1 << 30,
+}
+
+// parseProgress parses a line of Git output into structured progress
+// information.
+//
+// It returns false if the line does not describe the progress of an
+// operation.
+func parseProgress(line string) (logs.Progress, bool) {
+	m := progressPattern.FindStringSubmatch(line)
+	if m == nil {
+		return logs.Progress{}, false
+	}
+
+	p := logs.Progress{
+		Phase: m[1],
+	}
+
+	if m[4] != "" {
+		// Only a count is available, such as "Enumerating objects: 20".
+		p.Current, _ = strconv.ParseUint(m[4], 10, 64)
+	} else {
+		p.Current, _ = strconv.ParseUint(m[2], 10, 64)
+		p.Total, _ = strconv.ParseUint(m[3], 10, 64)
+	}
+
+	if m[5] != "" {
+		n, _ := strconv.ParseFloat(m[5], 64)
+		p.Bytes = uint64(n * byteUnits[m[6]])
+	}
+
+	return p, true
+}
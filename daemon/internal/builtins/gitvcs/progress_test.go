@@ -0,0 +1,83 @@
+package gitvcs // note: no _test suffix to allow testing unexported functions.
+
+import (
+	"io"
+
+	"github.com/gritcli/grit/daemon/internal/logs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func progressWriter()", func() {
+	It("annotates progress lines with structured progress information", func() {
+		var buffer logs.Buffer
+
+		w := progressWriter(buffer.Log())
+		_, err := io.WriteString(w, "Counting objects:  45% (9/20)\rCounting objects: 100% (20/20), done.\nTotal 20 (delta 5)\n")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(buffer).To(Equal(logs.Buffer{
+			{
+				Text: "git: Counting objects:  45% (9/20)",
+				Progress: &logs.Progress{
+					Phase:   "Counting objects",
+					Current: 9,
+					Total:   20,
+				},
+			},
+			{
+				Text: "git: Counting objects: 100% (20/20), done.",
+				Progress: &logs.Progress{
+					Phase:   "Counting objects",
+					Current: 20,
+					Total:   20,
+				},
+			},
+			{
+				Text: "git: Total 20 (delta 5)",
+			},
+		}))
+	})
+})
+
+var _ = Describe("func parseProgress()", func() {
+	DescribeTable(
+		"it parses progress lines",
+		func(line string, expect logs.Progress) {
+			p, ok := parseProgress(line)
+			Expect(ok).To(BeTrue())
+			Expect(p).To(Equal(expect))
+		},
+		Entry(
+			"count only",
+			"Enumerating objects: 20, done.",
+			logs.Progress{Phase: "Enumerating objects", Current: 20},
+		),
+		Entry(
+			"percentage",
+			"Compressing objects:  50% (5/10)",
+			logs.Progress{Phase: "Compressing objects", Current: 5, Total: 10},
+		),
+		Entry(
+			"percentage with transfer size",
+			"Receiving objects:  45% (9/20), 1.50 MiB | 500.00 KiB/s",
+			logs.Progress{Phase: "Receiving objects", Current: 9, Total: 20, Bytes: 1572864},
+		),
+		Entry(
+			"transfer size in bytes",
+			"Receiving objects: 100% (20/20), 512 bytes | 512.00 KiB/s, done.",
+			logs.Progress{Phase: "Receiving objects", Current: 20, Total: 20, Bytes: 512},
+		),
+	)
+
+	DescribeTable(
+		"it ignores other lines",
+		func(line string) {
+			_, ok := parseProgress(line)
+			Expect(ok).To(BeFalse())
+		},
+		Entry("summary", "Total 20 (delta 5), reused 10 (delta 2), pack-reused 0"),
+		Entry("empty", ""),
+		Entry("free text", "warning: redirecting to https://github.com/owner/repo.git/"),
+	)
+})
@@ -0,0 +1,31 @@
+package gitvcs
+
+import (
+	"fmt"
+
+	git "github.com/go-git/go-git/v5"
+)
+
+// RemoteURL returns the URL of the named remote of the Git repository in the
+// given directory.
+//
+// If the remote has multiple URLs, the first URL (which Git uses for fetching)
+// is returned.
+func RemoteURL(dir, remote string) (string, error) {
+	repo, err := git.PlainOpen(dir)
+	if err != nil {
+		return "", err
+	}
+
+	r, err := repo.Remote(remote)
+	if err != nil {
+		return "", err
+	}
+
+	urls := r.Config().URLs
+	if len(urls) == 0 {
+		return "", fmt.Errorf("the '%s' remote does not have a URL", remote)
+	}
+
+	return urls[0], nil
+}
@@ -0,0 +1,58 @@
+package gitvcs_test
+
+import (
+	"os"
+	"path/filepath"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	. "github.com/gritcli/grit/daemon/internal/builtins/gitvcs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func RemoteURL()", func() {
+	var (
+		dir  string
+		repo *git.Repository
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(func() {
+			os.RemoveAll(dir)
+		})
+
+		repo = initRepo(dir)
+	})
+
+	It("returns the first URL of the remote", func() {
+		_, err := repo.CreateRemote(&config.RemoteConfig{
+			Name: "origin",
+			URLs: []string{
+				"git@github.com:owner/repo.git",
+				"https://github.com/owner/repo.git",
+			},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		url, err := RemoteURL(dir, "origin")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(url).To(Equal("git@github.com:owner/repo.git"))
+	})
+
+	It("returns an error if the remote does not exist", func() {
+		_, err := RemoteURL(dir, "origin")
+		Expect(err).To(MatchError(git.ErrRemoteNotFound))
+	})
+
+	It("returns an error if the directory is not a Git repository", func() {
+		empty := filepath.Join(dir, "empty")
+		Expect(os.Mkdir(empty, 0700)).To(Succeed())
+
+		_, err := RemoteURL(empty, "origin")
+		Expect(err).To(MatchError(git.ErrRepositoryNotExists))
+	})
+})
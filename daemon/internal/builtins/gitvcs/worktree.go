@@ -0,0 +1,186 @@
+package gitvcs
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// The worktree operations are implemented using the Git CLI, as go-git does
+// not support linked working trees. As such, they require the "git" executable
+// to be available in the daemon's PATH.
+
+var _ sourcedriver.WorktreeManager = (*LocalClone)(nil)
+
+// AddWorktree creates a new working tree in dir with the given branch checked
+// out.
+func (c *LocalClone) AddWorktree(
+	ctx context.Context,
+	dir, branch string,
+	log logs.Log,
+) error {
+	exists, err := c.branchExists(branch)
+	if err != nil {
+		return err
+	}
+
+	args := []string{"worktree", "add", "--quiet"}
+
+	if exists {
+		args = append(args, dir, branch)
+	} else {
+		log.Write("creating the '%s' branch from HEAD", branch)
+		args = append(args, "-b", branch, dir)
+	}
+
+	if _, err := runGit(ctx, c.Dir, args...); err != nil {
+		return err
+	}
+
+	log.WriteVerbose("checked out the '%s' branch in %s", branch, dir)
+
+	return nil
+}
+
+// Worktrees returns the additional working trees of the local clone.
+func (c *LocalClone) Worktrees(
+	ctx context.Context,
+	log logs.Log,
+) ([]sourcedriver.Worktree, error) {
+	out, err := runGit(ctx, c.Dir, "worktree", "list", "--porcelain")
+	if err != nil {
+		return nil, err
+	}
+
+	worktrees := parseWorktreeList(out)
+	if len(worktrees) == 0 {
+		return nil, nil
+	}
+
+	// The first entry is always the clone's own working tree.
+	worktrees = worktrees[1:]
+
+	for i, wt := range worktrees {
+		if _, err := os.Stat(wt.Dir); err != nil {
+			log.WriteVerbose("unable to determine the status of %s: %s", wt.Dir, err)
+			continue
+		}
+
+		status, err := runGit(ctx, wt.Dir, "status", "--porcelain")
+		if err != nil {
+			return nil, err
+		}
+
+		worktrees[i].HasUncommittedChanges = status != ""
+	}
+
+	return worktrees, nil
+}
+
+// RemoveWorktree removes the working tree in dir.
+func (c *LocalClone) RemoveWorktree(
+	ctx context.Context,
+	dir string,
+	force bool,
+	log logs.Log,
+) error {
+	args := []string{"worktree", "remove"}
+	if force {
+		args = append(args, "--force")
+	}
+	args = append(args, dir)
+
+	if _, err := runGit(ctx, c.Dir, args...); err != nil {
+		return err
+	}
+
+	log.WriteVerbose("removed the working tree in %s", dir)
+
+	return nil
+}
+
+// branchExists returns true if the given branch exists in the local clone,
+// either as a local branch or as a branch of one of its remotes.
+func (c *LocalClone) branchExists(branch string) (bool, error) {
+	repo, err := git.PlainOpen(c.Dir)
+	if err != nil {
+		return false, err
+	}
+
+	names := []plumbing.ReferenceName{
+		plumbing.NewBranchReferenceName(branch),
+	}
+
+	remotes, err := repo.Remotes()
+	if err != nil {
+		return false, err
+	}
+
+	for _, r := range remotes {
+		names = append(
+			names,
+			plumbing.NewRemoteReferenceName(r.Config().Name, branch),
+		)
+	}
+
+	for _, n := range names {
+		if _, err := repo.Reference(n, false); err == nil {
+			return true, nil
+		} else if err != plumbing.ErrReferenceNotFound {
+			return false, err
+		}
+	}
+
+	return false, nil
+}
+
+// parseWorktreeList parses the output of "git worktree list --porcelain".
+func parseWorktreeList(out string) []sourcedriver.Worktree {
+	var worktrees []sourcedriver.Worktree
+
+	s := bufio.NewScanner(strings.NewReader(out))
+	for s.Scan() {
+		key, value, _ := strings.Cut(s.Text(), " ")
+
+		switch key {
+		case "worktree":
+			worktrees = append(worktrees, sourcedriver.Worktree{
+				Dir: value,
+			})
+		case "branch":
+			if n := len(worktrees); n != 0 {
+				worktrees[n-1].Branch = plumbing.ReferenceName(value).Short()
+			}
+		}
+	}
+
+	return worktrees
+}
+
+// runGit runs the Git CLI within dir and returns its output.
+func runGit(ctx context.Context, dir string, args ...string) (string, error) {
+	var stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git %s: %w: %s", args[0], err, msg)
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+
+	return string(out), nil
+}
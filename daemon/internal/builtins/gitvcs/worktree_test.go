@@ -0,0 +1,185 @@
+package gitvcs_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	. "github.com/gritcli/grit/daemon/internal/builtins/gitvcs"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type LocalClone (worktrees)", func() {
+	var (
+		ctx      context.Context
+		upstream *git.Repository
+		tempDir  string
+		dir      string
+		clone    *LocalClone
+	)
+
+	BeforeEach(func() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+		DeferCleanup(cancel)
+
+		var err error
+		tempDir, err = os.MkdirTemp("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(func() {
+			os.RemoveAll(tempDir)
+		})
+
+		tempDir, err = filepath.EvalSymlinks(tempDir)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		upstream = initRepo(filepath.Join(tempDir, "upstream"))
+		commitFile(upstream, "README.md", "<content>")
+
+		dir = filepath.Join(tempDir, "clone")
+		_, err = git.PlainClone(
+			dir,
+			false, // isBare
+			&git.CloneOptions{
+				URL: filepath.Join(tempDir, "upstream"),
+			},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		clone = &LocalClone{
+			Dir: dir,
+		}
+	})
+
+	Describe("func AddWorktree()", func() {
+		It("creates a new branch if it does not exist", func() {
+			var buffer logs.Buffer
+			wtDir := dir + "@feature"
+
+			err := clone.AddWorktree(ctx, wtDir, "feature", buffer.Log())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer).To(ContainElement(
+				logs.Message{
+					Text: "creating the 'feature' branch from HEAD",
+				},
+			))
+
+			worktrees, err := clone.Worktrees(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(worktrees).To(ConsistOf(
+				sourcedriver.Worktree{
+					Dir:    wtDir,
+					Branch: "feature",
+				},
+			))
+		})
+
+		It("checks out a branch that exists in a remote", func() {
+			wt, err := upstream.Worktree()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = wt.Checkout(&git.CheckoutOptions{
+				Branch: plumbing.NewBranchReferenceName("other"),
+				Create: true,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			hash := commitFile(upstream, "README.md", "<other>")
+
+			err = clone.Fetch(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			wtDir := dir + "@other"
+
+			err = clone.AddWorktree(ctx, wtDir, "other", logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			repo, err := git.PlainOpen(dir)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			ref, err := repo.Reference(plumbing.NewBranchReferenceName("other"), false)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ref.Hash()).To(Equal(hash))
+		})
+
+		It("returns an error if the branch is already checked out", func() {
+			err := clone.AddWorktree(ctx, dir+"@master", "master", logs.Discard)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Describe("func Worktrees()", func() {
+		It("returns an empty slice if there are no additional working trees", func() {
+			worktrees, err := clone.Worktrees(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(worktrees).To(BeEmpty())
+		})
+
+		It("reports uncommitted changes", func() {
+			wtDir := dir + "@feature"
+
+			err := clone.AddWorktree(ctx, wtDir, "feature", logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = os.WriteFile(filepath.Join(wtDir, "untracked"), nil, 0600)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			worktrees, err := clone.Worktrees(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(worktrees).To(ConsistOf(
+				sourcedriver.Worktree{
+					Dir:                   wtDir,
+					Branch:                "feature",
+					HasUncommittedChanges: true,
+				},
+			))
+		})
+	})
+
+	Describe("func RemoveWorktree()", func() {
+		It("removes the working tree but keeps the branch", func() {
+			wtDir := dir + "@feature"
+
+			err := clone.AddWorktree(ctx, wtDir, "feature", logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = clone.RemoveWorktree(ctx, wtDir, false, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wtDir).NotTo(BeADirectory())
+
+			worktrees, err := clone.Worktrees(ctx, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(worktrees).To(BeEmpty())
+
+			repo, err := git.PlainOpen(dir)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = repo.Reference(plumbing.NewBranchReferenceName("feature"), false)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("does not remove a working tree with uncommitted changes unless forced", func() {
+			wtDir := dir + "@feature"
+
+			err := clone.AddWorktree(ctx, wtDir, "feature", logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = os.WriteFile(filepath.Join(wtDir, "untracked"), nil, 0600)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = clone.RemoveWorktree(ctx, wtDir, false, logs.Discard)
+			Expect(err).To(HaveOccurred())
+			Expect(wtDir).To(BeADirectory())
+
+			err = clone.RemoveWorktree(ctx, wtDir, true, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wtDir).NotTo(BeADirectory())
+		})
+	})
+})
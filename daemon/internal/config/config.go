@@ -2,6 +2,7 @@ package config
 
 import (
 	"path/filepath"
+	"time"
 
 	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
 )
@@ -13,6 +14,18 @@ var (
 	// DefaultClonesDirectory is the default path in which grit stores local
 	// clones of remote repositories.
 	DefaultClonesDirectory = filepath.Join("~", "grit")
+
+	// DefaultDataDirectory is the default path in which the Grit daemon stores
+	// its internal data, such as the index of local clones.
+	DefaultDataDirectory = filepath.Join("~", "grit", ".data")
+
+	// DefaultArchiveDirectory is the default path in which Grit stores archives
+	// of local clones.
+	DefaultArchiveDirectory = filepath.Join("~", "grit", ".archive")
+
+	// DefaultArchiveAfter is the default period of inactivity after which a
+	// local clone is eligible to be archived.
+	DefaultArchiveAfter = 365 * 24 * time.Hour
 )
 
 // Config contains an entire Grit configuration.
@@ -30,6 +43,10 @@ type Daemon struct {
 	// Socket is the path of the Unix socket used for communication between
 	// the Grit CLI and the Grit daemon (via gRPC).
 	Socket string
+
+	// DataDir is the path to the directory in which the Grit daemon stores its
+	// internal data, such as the index of local clones.
+	DataDir string
 }
 
 // Source is the configuration for a source of repositories.
@@ -46,6 +63,10 @@ type Source struct {
 	// repository clones for this source.
 	Clones Clones
 
+	// Hooks is the set of commands that are run in response to operations on
+	// repositories from this source.
+	Hooks Hooks
+
 	// Driver contains driver-specific configuration for this source.
 	Driver sourcedriver.Config
 }
@@ -55,4 +76,37 @@ type Source struct {
 type Clones struct {
 	// Dir is the path to the directory in which local clones are kept.
 	Dir string
+
+	// ArchiveDir is the path to the directory in which archives of local
+	// clones are kept.
+	ArchiveDir string
+
+	// ArchiveAfter is the period of inactivity after which a local clone is
+	// eligible to be archived.
+	ArchiveAfter time.Duration
+
+	// Layout is a template for the path of each clone within Dir, such as
+	// "{owner}/{name}". The available fields depend on the source driver.
+	//
+	// If it is empty, the directory chosen by the source driver is used.
+	Layout string
+}
+
+// Hooks is the configuration of commands that are run in response to
+// operations on repositories.
+type Hooks struct {
+	// PostClone is the list of hooks that are run in the directory of a new
+	// clone, in order, after the repository is cloned.
+	PostClone []Hook
+}
+
+// Hook is the configuration of a single command that is run in response to an
+// operation on a repository.
+type Hook struct {
+	// Command is the command to run and its arguments.
+	Command []string
+
+	// Required is true if a failure of the hook causes the operation that
+	// triggered it to fail.
+	Required bool
 }
@@ -10,7 +10,8 @@ import (
 // defaultConfig is the expected default Grit configuration.
 var defaultConfig = Config{
 	Daemon: Daemon{
-		Socket: "~/grit/daemon.sock",
+		Socket:  "~/grit/daemon.sock",
+		DataDir: "~/grit/.data",
 	},
 }
 
@@ -21,12 +22,22 @@ func init() {
 		panic(err)
 	}
 
+	defaultConfig.Daemon.DataDir, err = homedir.Expand(defaultConfig.Daemon.DataDir)
+	if err != nil {
+		panic(err)
+	}
+
 	for n, s := range defaultConfig.Sources {
 		s.Clones.Dir, err = homedir.Expand(s.Clones.Dir)
 		if err != nil {
 			panic(err)
 		}
 
+		s.Clones.ArchiveDir, err = homedir.Expand(s.Clones.ArchiveDir)
+		if err != nil {
+			panic(err)
+		}
+
 		defaultConfig.Sources[n] = s
 	}
 }
@@ -48,6 +59,11 @@ func withSource(cfg Config, src Source) Config {
 		panic(err)
 	}
 
+	src.Clones.ArchiveDir, err = homedir.Expand(src.Clones.ArchiveDir)
+	if err != nil {
+		panic(err)
+	}
+
 	for _, s := range prev {
 		if !strings.EqualFold(src.Name, s.Name) {
 			cfg.Sources = append(cfg.Sources, s)
@@ -0,0 +1,129 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ExpandLayout returns the relative clone directory described by a clone
+// directory layout, such as "{owner}/{name}".
+//
+// Each "{field}" placeholder in the layout is replaced with the value of the
+// corresponding entry in fields. Forward slashes in the layout are treated as
+// path separators regardless of the current OS.
+func ExpandLayout(layout string, fields map[string]string) (string, error) {
+	var (
+		b       strings.Builder
+		unknown []string
+	)
+
+	err := parseLayout(
+		layout,
+		func(lit string) {
+			b.WriteString(lit)
+		},
+		func(name string) {
+			if v, ok := fields[name]; ok {
+				b.WriteString(v)
+			} else {
+				unknown = append(unknown, name)
+			}
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if len(unknown) != 0 {
+		var names []string
+		for n := range fields {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+
+		return "", fmt.Errorf(
+			"the clones layout refers to unknown fields ('%s'), the available fields are '%s'",
+			strings.Join(unknown, "', '"),
+			strings.Join(names, "', '"),
+		)
+	}
+
+	dir := filepath.Clean(filepath.FromSlash(b.String()))
+
+	if dir == "." || filepath.IsAbs(dir) || dir == ".." ||
+		strings.HasPrefix(dir, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf(
+			"the clones layout (%s) does not produce a directory within the clones directory (%s)",
+			layout,
+			b.String(),
+		)
+	}
+
+	return dir, nil
+}
+
+// validateLayout returns an error if layout is not a syntactically valid clone
+// directory layout.
+func validateLayout(layout string) error {
+	fields := false
+
+	if err := parseLayout(
+		layout,
+		func(string) {},
+		func(string) { fields = true },
+	); err != nil {
+		return err
+	}
+
+	if !fields {
+		return fmt.Errorf("%q is not a valid layout: it does not refer to any fields", layout)
+	}
+
+	if filepath.IsAbs(filepath.FromSlash(layout)) {
+		return fmt.Errorf("%q is not a valid layout: it is an absolute path", layout)
+	}
+
+	return nil
+}
+
+// parseLayout parses a clone directory layout, calling lit() for each literal
+// portion and field() for each "{field}" placeholder, in order.
+func parseLayout(
+	layout string,
+	lit func(string),
+	field func(string),
+) error {
+	orig := layout
+
+	for layout != "" {
+		open := strings.IndexAny(layout, "{}")
+		if open == -1 {
+			lit(layout)
+			return nil
+		}
+
+		if layout[open] == '}' {
+			return fmt.Errorf("%q is not a valid layout: unmatched '}'", orig)
+		}
+
+		lit(layout[:open])
+		layout = layout[open+1:]
+
+		end := strings.IndexAny(layout, "{}")
+		if end == -1 || layout[end] == '{' {
+			return fmt.Errorf("%q is not a valid layout: unmatched '{'", orig)
+		}
+
+		name := layout[:end]
+		if name == "" {
+			return fmt.Errorf("%q is not a valid layout: empty field name", orig)
+		}
+
+		field(name)
+		layout = layout[end+1:]
+	}
+
+	return nil
+}
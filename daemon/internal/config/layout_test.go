@@ -0,0 +1,62 @@
+package config_test
+
+import (
+	"path/filepath"
+
+	. "github.com/gritcli/grit/daemon/internal/config"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func ExpandLayout()", func() {
+	fields := map[string]string{
+		"domain": "github.com",
+		"owner":  "gritcli",
+		"name":   "grit",
+	}
+
+	DescribeTable(
+		"it returns the expanded directory",
+		func(layout, expect string) {
+			dir, err := ExpandLayout(layout, fields)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(dir).To(Equal(expect))
+		},
+		Entry("flat", "{name}", "grit"),
+		Entry("nested", "{domain}/{owner}/{name}", filepath.Join("github.com", "gritcli", "grit")),
+		Entry("literal text", "{owner}-{name}.git", "gritcli-grit.git"),
+	)
+
+	DescribeTable(
+		"it returns an error if the layout is invalid",
+		func(layout, expect string) {
+			_, err := ExpandLayout(layout, fields)
+			Expect(err).To(MatchError(expect))
+		},
+		Entry(
+			"unknown field",
+			"{org}/{name}",
+			`the clones layout refers to unknown fields ('org'), the available fields are 'domain', 'name', 'owner'`,
+		),
+		Entry(
+			"unmatched opening brace",
+			"{owner/{name}",
+			`"{owner/{name}" is not a valid layout: unmatched '{'`,
+		),
+		Entry(
+			"unmatched closing brace",
+			"owner}/{name}",
+			`"owner}/{name}" is not a valid layout: unmatched '}'`,
+		),
+		Entry(
+			"empty field name",
+			"{}/{name}",
+			`"{}/{name}" is not a valid layout: empty field name`,
+		),
+		Entry(
+			"directory outside of the clones directory",
+			"../{name}",
+			`the clones layout (../{name}) does not produce a directory within the clones directory (../grit)`,
+		),
+	)
+})
@@ -98,6 +98,9 @@ type loader struct {
 	// that driver.
 	globalVCSs map[string]vcsdriver.Config
 
+	// globalHooks is the hooks configuration that applies to all sources.
+	globalHooks Hooks
+
 	// sources is a map of _lowercase_ source name to its intermediate
 	// representation.
 	sources map[string]intermediateSource
@@ -208,6 +211,12 @@ func (l *loader) mergeFile(file string, f fileSchema) (err error) {
 		}
 	}
 
+	for _, h := range f.GlobalHooks {
+		if err := l.mergeGlobalHook(h); err != nil {
+			return err
+		}
+	}
+
 	for _, s := range f.Sources {
 		if err := l.mergeSource(file, s); err != nil {
 			return err
@@ -3,6 +3,9 @@ package config
 import (
 	"fmt"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // mergeGlobalClones merges s into the configuration.
@@ -14,7 +17,11 @@ func (l *loader) mergeGlobalClones(file string, s clonesSchema) error {
 		)
 	}
 
-	cfg := Clones(s)
+	cfg := Clones{
+		Dir:        s.Dir,
+		ArchiveDir: s.ArchiveDir,
+		Layout:     s.Layout,
+	}
 
 	if err := l.normalizePath(&cfg.Dir); err != nil {
 		return fmt.Errorf(
@@ -24,6 +31,34 @@ func (l *loader) mergeGlobalClones(file string, s clonesSchema) error {
 		)
 	}
 
+	if err := l.normalizePath(&cfg.ArchiveDir); err != nil {
+		return fmt.Errorf(
+			"unable to resolve global archive directory: %w (%s)",
+			err,
+			cfg.ArchiveDir,
+		)
+	}
+
+	if s.ArchiveAfter != "" {
+		d, err := ParseDuration(s.ArchiveAfter)
+		if err != nil {
+			return fmt.Errorf(
+				"unable to parse global archive period: %w",
+				err,
+			)
+		}
+		cfg.ArchiveAfter = d
+	}
+
+	if cfg.Layout != "" {
+		if err := validateLayout(cfg.Layout); err != nil {
+			return fmt.Errorf(
+				"unable to parse global clones layout: %w",
+				err,
+			)
+		}
+	}
+
 	l.globalClonesFile = file
 	l.globalClones = cfg
 
@@ -44,6 +79,22 @@ func (l *loader) populateGlobalClonesDefaults() error {
 		}
 	}
 
+	if l.globalClones.ArchiveDir == "" {
+		l.globalClones.ArchiveDir = DefaultArchiveDirectory
+
+		if err := l.normalizePath(&l.globalClones.ArchiveDir); err != nil {
+			return fmt.Errorf(
+				"unable to resolve default global archive directory: %w (%s)",
+				err,
+				l.globalClones.ArchiveDir,
+			)
+		}
+	}
+
+	if l.globalClones.ArchiveAfter == 0 {
+		l.globalClones.ArchiveAfter = DefaultArchiveAfter
+	}
+
 	return nil
 }
 
@@ -57,6 +108,7 @@ func (l *loader) finalizeSourceSpecificClones(
 
 	if s != nil {
 		cfg.Dir = s.Dir
+		cfg.ArchiveDir = s.ArchiveDir
 
 		if err := l.normalizePath(&cfg.Dir); err != nil {
 			return Clones{}, fmt.Errorf(
@@ -66,12 +118,56 @@ func (l *loader) finalizeSourceSpecificClones(
 				cfg.Dir,
 			)
 		}
+
+		if err := l.normalizePath(&cfg.ArchiveDir); err != nil {
+			return Clones{}, fmt.Errorf(
+				"unable to resolve archive directory for the '%s' source: %w (%s)",
+				i.Schema.Name,
+				err,
+				cfg.ArchiveDir,
+			)
+		}
+
+		if s.ArchiveAfter != "" {
+			d, err := ParseDuration(s.ArchiveAfter)
+			if err != nil {
+				return Clones{}, fmt.Errorf(
+					"unable to parse archive period for the '%s' source: %w",
+					i.Schema.Name,
+					err,
+				)
+			}
+			cfg.ArchiveAfter = d
+		}
+
+		if s.Layout != "" {
+			if err := validateLayout(s.Layout); err != nil {
+				return Clones{}, fmt.Errorf(
+					"unable to parse clones layout for the '%s' source: %w",
+					i.Schema.Name,
+					err,
+				)
+			}
+			cfg.Layout = s.Layout
+		}
 	}
 
 	if cfg.Dir == "" {
 		cfg.Dir = filepath.Join(l.globalClones.Dir, i.Schema.Name)
 	}
 
+	if cfg.ArchiveDir == "" {
+		cfg.ArchiveDir = filepath.Join(l.globalClones.ArchiveDir, i.Schema.Name)
+	}
+
+	if cfg.ArchiveAfter == 0 {
+		cfg.ArchiveAfter = l.globalClones.ArchiveAfter
+	}
+
+	if cfg.Layout == "" {
+		cfg.Layout = l.globalClones.Layout
+	}
+
 	return cfg, nil
 }
 
@@ -79,6 +175,35 @@ func (l *loader) finalizeSourceSpecificClones(
 // implicit source.
 func (l *loader) finalizeImplicitSourceClones(name string) Clones {
 	return Clones{
-		Dir: filepath.Join(l.globalClones.Dir, name),
+		Dir:          filepath.Join(l.globalClones.Dir, name),
+		ArchiveDir:   filepath.Join(l.globalClones.ArchiveDir, name),
+		ArchiveAfter: l.globalClones.ArchiveAfter,
+		Layout:       l.globalClones.Layout,
+	}
+}
+
+// ParseDuration parses a human-readable duration, such as the archive period
+// of a clones configuration.
+//
+// In addition to the units supported by time.ParseDuration(), it supports a
+// "d" suffix to express a number of days.
+func ParseDuration(s string) (time.Duration, error) {
+	var (
+		d   time.Duration
+		err error
+	)
+
+	if n, ok := strings.CutSuffix(s, "d"); ok {
+		var days uint64
+		days, err = strconv.ParseUint(n, 10, 32)
+		d = time.Duration(days) * 24 * time.Hour
+	} else {
+		d, err = time.ParseDuration(s)
 	}
+
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("%q is not a valid positive duration", s)
+	}
+
+	return d, nil
 }
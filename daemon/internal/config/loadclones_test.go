@@ -1,6 +1,8 @@
 package config_test
 
 import (
+	"time"
+
 	. "github.com/gritcli/grit/daemon/internal/config"
 	"github.com/gritcli/grit/daemon/internal/driver/vcsdriver"
 	"github.com/gritcli/grit/daemon/internal/stubs"
@@ -24,7 +26,9 @@ var _ = Describe("func Load() (clones configuration)", func() {
 				Name:    "test_source",
 				Enabled: true,
 				Clones: Clones{
-					Dir: "/path/to/clones/test_source",
+					Dir:          "/path/to/clones/test_source",
+					ArchiveDir:   "~/grit/.archive/test_source",
+					ArchiveAfter: DefaultArchiveAfter,
 				},
 				Driver: &stubs.SourceConfig{
 					ArbitraryAttribute: "<default>",
@@ -49,7 +53,9 @@ var _ = Describe("func Load() (clones configuration)", func() {
 				Name:    "test_source",
 				Enabled: true,
 				Clones: Clones{
-					Dir: "/path/to/clones",
+					Dir:          "/path/to/clones",
+					ArchiveDir:   "~/grit/.archive/test_source",
+					ArchiveAfter: DefaultArchiveAfter,
 				},
 				Driver: &stubs.SourceConfig{
 					ArbitraryAttribute: "<default>",
@@ -78,7 +84,130 @@ var _ = Describe("func Load() (clones configuration)", func() {
 				Name:    "test_source",
 				Enabled: true,
 				Clones: Clones{
-					Dir: "/path/to/elsewhere",
+					Dir:          "/path/to/elsewhere",
+					ArchiveDir:   "~/grit/.archive/test_source",
+					ArchiveAfter: DefaultArchiveAfter,
+				},
+				Driver: &stubs.SourceConfig{
+					ArbitraryAttribute: "<default>",
+					VCSs: map[string]vcsdriver.Config{
+						testVCSDriverName: &stubs.VCSConfig{
+							ArbitraryAttribute: "<default>",
+						},
+					},
+				},
+			}),
+		),
+		Entry(
+			"sources use an archive directory within the default archive directory by default",
+			[]string{
+				`clones {
+					archive_dir = "/path/to/archive"
+					archive_after = "90d"
+				}
+
+				source "test_source" "test_source_driver" {}`,
+			},
+			withSource(defaultConfig, Source{
+				Name:    "test_source",
+				Enabled: true,
+				Clones: Clones{
+					Dir:          "~/grit/test_source",
+					ArchiveDir:   "/path/to/archive/test_source",
+					ArchiveAfter: 90 * 24 * time.Hour,
+				},
+				Driver: &stubs.SourceConfig{
+					ArbitraryAttribute: "<default>",
+					VCSs: map[string]vcsdriver.Config{
+						testVCSDriverName: &stubs.VCSConfig{
+							ArbitraryAttribute: "<default>",
+						},
+					},
+				},
+			}),
+		),
+		Entry(
+			"sources can override the archive configuration",
+			[]string{
+				`clones {
+					archive_dir = "/path/to/archive"
+					archive_after = "90d"
+				}
+
+				source "test_source" "test_source_driver" {
+					clones {
+						archive_dir = "/path/to/elsewhere"
+						archive_after = "720h"
+					}
+				}`,
+			},
+			withSource(defaultConfig, Source{
+				Name:    "test_source",
+				Enabled: true,
+				Clones: Clones{
+					Dir:          "~/grit/test_source",
+					ArchiveDir:   "/path/to/elsewhere",
+					ArchiveAfter: 720 * time.Hour,
+				},
+				Driver: &stubs.SourceConfig{
+					ArbitraryAttribute: "<default>",
+					VCSs: map[string]vcsdriver.Config{
+						testVCSDriverName: &stubs.VCSConfig{
+							ArbitraryAttribute: "<default>",
+						},
+					},
+				},
+			}),
+		),
+		Entry(
+			"sources use the global clones layout by default",
+			[]string{
+				`clones {
+					layout = "{domain}/{owner}/{name}"
+				}
+
+				source "test_source" "test_source_driver" {}`,
+			},
+			withSource(defaultConfig, Source{
+				Name:    "test_source",
+				Enabled: true,
+				Clones: Clones{
+					Dir:          "~/grit/test_source",
+					ArchiveDir:   "~/grit/.archive/test_source",
+					ArchiveAfter: DefaultArchiveAfter,
+					Layout:       "{domain}/{owner}/{name}",
+				},
+				Driver: &stubs.SourceConfig{
+					ArbitraryAttribute: "<default>",
+					VCSs: map[string]vcsdriver.Config{
+						testVCSDriverName: &stubs.VCSConfig{
+							ArbitraryAttribute: "<default>",
+						},
+					},
+				},
+			}),
+		),
+		Entry(
+			"sources can override the clones layout",
+			[]string{
+				`clones {
+					layout = "{domain}/{owner}/{name}"
+				}
+
+				source "test_source" "test_source_driver" {
+					clones {
+						layout = "{name}"
+					}
+				}`,
+			},
+			withSource(defaultConfig, Source{
+				Name:    "test_source",
+				Enabled: true,
+				Clones: Clones{
+					Dir:          "~/grit/test_source",
+					ArchiveDir:   "~/grit/.archive/test_source",
+					ArchiveAfter: DefaultArchiveAfter,
+					Layout:       "{name}",
 				},
 				Driver: &stubs.SourceConfig{
 					ArbitraryAttribute: "<default>",
@@ -123,6 +252,66 @@ var _ = Describe("func Load() (clones configuration)", func() {
 			},
 			`<dir>/config-0.hcl: unable to resolve clones directory for the 'test_source' source: cannot expand user-specific home dir (~someuser/path/to/clones)`,
 		),
+		Entry(
+			`unexpandable global archive directory`,
+			[]string{
+				`clones {
+					archive_dir = "~someuser/path/to/archive"
+				}`,
+			},
+			`<dir>/config-0.hcl: unable to resolve global archive directory: cannot expand user-specific home dir (~someuser/path/to/archive)`,
+		),
+		Entry(
+			`unexpandable source-specific archive directory`,
+			[]string{
+				`source "test_source" "test_source_driver" {
+					clones {
+						archive_dir = "~someuser/path/to/archive"
+					}
+				}`,
+			},
+			`<dir>/config-0.hcl: unable to resolve archive directory for the 'test_source' source: cannot expand user-specific home dir (~someuser/path/to/archive)`,
+		),
+		Entry(
+			`invalid global archive period`,
+			[]string{
+				`clones {
+					archive_after = "<invalid>"
+				}`,
+			},
+			`<dir>/config-0.hcl: unable to parse global archive period: "<invalid>" is not a valid positive duration`,
+		),
+		Entry(
+			`invalid source-specific archive period`,
+			[]string{
+				`source "test_source" "test_source_driver" {
+					clones {
+						archive_after = "-1d"
+					}
+				}`,
+			},
+			`<dir>/config-0.hcl: unable to parse archive period for the 'test_source' source: "-1d" is not a valid positive duration`,
+		),
+		Entry(
+			`invalid global clones layout`,
+			[]string{
+				`clones {
+					layout = "{owner/{name}"
+				}`,
+			},
+			`<dir>/config-0.hcl: unable to parse global clones layout: "{owner/{name}" is not a valid layout: unmatched '{'`,
+		),
+		Entry(
+			`source-specific clones layout without any fields`,
+			[]string{
+				`source "test_source" "test_source_driver" {
+					clones {
+						layout = "repos"
+					}
+				}`,
+			},
+			`<dir>/config-0.hcl: unable to parse clones layout for the 'test_source' source: "repos" is not a valid layout: it does not refer to any fields`,
+		),
 	)
 
 	Context("when the default global clones directory cannot be resolved", func() {
@@ -149,4 +338,26 @@ var _ = Describe("func Load() (clones configuration)", func() {
 			),
 		)
 	})
+
+	Context("when the default global archive directory cannot be resolved", func() {
+		var original string
+
+		BeforeEach(func() {
+			original = DefaultArchiveDirectory
+			DefaultArchiveDirectory = "~someuser/path/to/archive"
+			DeferCleanup(func() {
+				DefaultArchiveDirectory = original
+			})
+		})
+
+		DescribeTable(
+			"it returns an error",
+			testLoadFailure,
+			Entry(
+				`unexpandable default archive directory`,
+				[]string{},
+				`unable to resolve default global archive directory: cannot expand user-specific home dir (~someuser/path/to/archive)`,
+			),
+		)
+	})
 })
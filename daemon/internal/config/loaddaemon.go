@@ -25,6 +25,14 @@ func (l *loader) mergeDaemon(file string, s daemonSchema) error {
 		)
 	}
 
+	if err := l.normalizePath(&cfg.DataDir); err != nil {
+		return fmt.Errorf(
+			"unable to resolve daemon data directory: %w (%s)",
+			err,
+			cfg.DataDir,
+		)
+	}
+
 	l.daemonFile = file
 	l.daemon = cfg
 
@@ -45,5 +53,17 @@ func (l *loader) populateDaemonDefaults() error {
 		}
 	}
 
+	if l.daemon.DataDir == "" {
+		l.daemon.DataDir = DefaultDataDirectory
+
+		if err := l.normalizePath(&l.daemon.DataDir); err != nil {
+			return fmt.Errorf(
+				"unable to resolve default daemon data directory: %w (%s)",
+				err,
+				l.daemon.DataDir,
+			)
+		}
+	}
+
 	return nil
 }
@@ -21,7 +21,20 @@ var _ = Describe("func Load() (daemon configuration)", func() {
 				}`,
 			},
 			withDaemon(defaultConfig, Daemon{
-				Socket: "/path/to/socket",
+				Socket:  "/path/to/socket",
+				DataDir: defaultConfig.Daemon.DataDir,
+			}),
+		),
+		Entry(
+			"explicit daemon data directory",
+			[]string{
+				`daemon {
+					data_dir = "/path/to/data"
+				}`,
+			},
+			withDaemon(defaultConfig, Daemon{
+				Socket:  defaultConfig.Daemon.Socket,
+				DataDir: "/path/to/data",
 			}),
 		),
 	)
@@ -46,6 +59,15 @@ var _ = Describe("func Load() (daemon configuration)", func() {
 			},
 			`<dir>/config-0.hcl: unable to resolve daemon socket path: cannot expand user-specific home dir (~someuser/path/to/socket)`,
 		),
+		Entry(
+			`unexpandable daemon data directory`,
+			[]string{
+				`daemon {
+					data_dir = "~someuser/path/to/data"
+				}`,
+			},
+			`<dir>/config-0.hcl: unable to resolve daemon data directory: cannot expand user-specific home dir (~someuser/path/to/data)`,
+		),
 	)
 
 	Context("when the default daemon socket cannot be resolved", func() {
@@ -73,6 +95,31 @@ var _ = Describe("func Load() (daemon configuration)", func() {
 		)
 	})
 
+	Context("when the default daemon data directory cannot be resolved", func() {
+		var original string
+
+		BeforeEach(func() {
+			// HACK: We really shouldn't manipulate (or even have) global
+			// variables like this, but it's the only cross-platform way to
+			// force the home directory resolution to fail.
+			original = DefaultDataDirectory
+			DefaultDataDirectory = "~someuser/path/to/data"
+			DeferCleanup(func() {
+				DefaultDataDirectory = original
+			})
+		})
+
+		DescribeTable(
+			"it returns an error",
+			testLoadFailure,
+			Entry(
+				`unexpandable default daemon data directory`,
+				[]string{},
+				`unable to resolve default daemon data directory: cannot expand user-specific home dir (~someuser/path/to/data)`,
+			),
+		)
+	})
+
 	It("resolves the socket path relative to the config directory", func() {
 		dir, cleanup := makeConfigDir(
 			`daemon {
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+)
+
+// postCloneEvent is the name of the event that occurs after a repository is
+// cloned.
+const postCloneEvent = "post_clone"
+
+// mergeGlobalHook merges s into the configuration.
+func (l *loader) mergeGlobalHook(s hookSchema) error {
+	h, err := unmarshalHook(s)
+	if err != nil {
+		return fmt.Errorf("the global '%s' hook is invalid: %w", s.Event, err)
+	}
+
+	l.globalHooks = appendHook(l.globalHooks, s.Event, h)
+
+	return nil
+}
+
+// finalizeSourceSpecificHooks returns the hooks configuration to use for a
+// specific source.
+//
+// The source-specific hooks are run after the global hooks.
+func (l *loader) finalizeSourceSpecificHooks(i intermediateSource) (Hooks, error) {
+	var cfg Hooks
+
+	// Copy the global hooks so that appending source-specific hooks never
+	// modifies the global hooks slice, which is shared between sources.
+	cfg.PostClone = append(cfg.PostClone, l.globalHooks.PostClone...)
+
+	for _, s := range i.Schema.Hooks {
+		h, err := unmarshalHook(s)
+		if err != nil {
+			return Hooks{}, fmt.Errorf(
+				"the '%s' hook for the '%s' source is invalid: %w",
+				s.Event,
+				i.Schema.Name,
+				err,
+			)
+		}
+
+		cfg = appendHook(cfg, s.Event, h)
+	}
+
+	return cfg, nil
+}
+
+// unmarshalHook returns the hook described by s.
+func unmarshalHook(s hookSchema) (Hook, error) {
+	if s.Event != postCloneEvent {
+		return Hook{}, fmt.Errorf(
+			"unrecognized event, the supported events are '%s'",
+			postCloneEvent,
+		)
+	}
+
+	if len(s.Command) == 0 || s.Command[0] == "" {
+		return Hook{}, errors.New("the command must not be empty")
+	}
+
+	h := Hook{
+		Command: s.Command,
+	}
+
+	if s.Required != nil {
+		h.Required = *s.Required
+	}
+
+	return h, nil
+}
+
+// appendHook returns hooks with h appended to the hooks for the given event.
+func appendHook(hooks Hooks, event string, h Hook) Hooks {
+	switch event {
+	case postCloneEvent:
+		hooks.PostClone = append(hooks.PostClone, h)
+	}
+
+	return hooks
+}
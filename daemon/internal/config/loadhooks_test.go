@@ -0,0 +1,123 @@
+package config_test
+
+import (
+	. "github.com/gritcli/grit/daemon/internal/config"
+	"github.com/gritcli/grit/daemon/internal/driver/vcsdriver"
+	"github.com/gritcli/grit/daemon/internal/stubs"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = Describe("func Load() (hooks configuration)", func() {
+	DescribeTable(
+		"it returns the expected configuration",
+		testLoadSuccess,
+		Entry(
+			"sources use the global hooks",
+			[]string{
+				`hook "post_clone" {
+					command = ["go", "mod", "download"]
+				}
+
+				source "test_source" "test_source_driver" {}`,
+			},
+			withSource(defaultConfig, Source{
+				Name:    "test_source",
+				Enabled: true,
+				Clones: Clones{
+					Dir:          "~/grit/test_source",
+					ArchiveDir:   "~/grit/.archive/test_source",
+					ArchiveAfter: DefaultArchiveAfter,
+				},
+				Hooks: Hooks{
+					PostClone: []Hook{
+						{Command: []string{"go", "mod", "download"}},
+					},
+				},
+				Driver: &stubs.SourceConfig{
+					ArbitraryAttribute: "<default>",
+					VCSs: map[string]vcsdriver.Config{
+						testVCSDriverName: &stubs.VCSConfig{
+							ArbitraryAttribute: "<default>",
+						},
+					},
+				},
+			}),
+		),
+		Entry(
+			"source-specific hooks are run after the global hooks",
+			[]string{
+				`hook "post_clone" {
+					command = ["pre-commit", "install"]
+				}`,
+				`hook "post_clone" {
+					command = ["direnv", "allow"]
+				}
+
+				source "test_source" "test_source_driver" {
+					hook "post_clone" {
+						command  = ["go", "mod", "download"]
+						required = true
+					}
+				}`,
+			},
+			withSource(defaultConfig, Source{
+				Name:    "test_source",
+				Enabled: true,
+				Clones: Clones{
+					Dir:          "~/grit/test_source",
+					ArchiveDir:   "~/grit/.archive/test_source",
+					ArchiveAfter: DefaultArchiveAfter,
+				},
+				Hooks: Hooks{
+					PostClone: []Hook{
+						{Command: []string{"pre-commit", "install"}},
+						{Command: []string{"direnv", "allow"}},
+						{Command: []string{"go", "mod", "download"}, Required: true},
+					},
+				},
+				Driver: &stubs.SourceConfig{
+					ArbitraryAttribute: "<default>",
+					VCSs: map[string]vcsdriver.Config{
+						testVCSDriverName: &stubs.VCSConfig{
+							ArbitraryAttribute: "<default>",
+						},
+					},
+				},
+			}),
+		),
+	)
+
+	DescribeTable(
+		"it returns an error if there is a problem with the configuration",
+		testLoadFailure,
+		Entry(
+			`unrecognized global hook event`,
+			[]string{
+				`hook "pre_clone" {
+					command = ["true"]
+				}`,
+			},
+			`<dir>/config-0.hcl: the global 'pre_clone' hook is invalid: unrecognized event, the supported events are 'post_clone'`,
+		),
+		Entry(
+			`empty global hook command`,
+			[]string{
+				`hook "post_clone" {
+					command = []
+				}`,
+			},
+			`<dir>/config-0.hcl: the global 'post_clone' hook is invalid: the command must not be empty`,
+		),
+		Entry(
+			`empty source-specific hook command`,
+			[]string{
+				`source "test_source" "test_source_driver" {
+					hook "post_clone" {
+						command = [""]
+					}
+				}`,
+			},
+			`<dir>/config-0.hcl: the 'post_clone' hook for the 'test_source' source is invalid: the command must not be empty`,
+		),
+	)
+})
@@ -106,6 +106,7 @@ func (l *loader) populateImplicitSources(cfg *Config) error {
 				Name:    src.Name,
 				Enabled: true,
 				Clones:  l.finalizeImplicitSourceClones(src.Name),
+				Hooks:   l.globalHooks,
 				Driver:  src.Config,
 			})
 		}
@@ -131,6 +132,11 @@ func (l *loader) finalizeSource(i intermediateSource) (Source, error) {
 		return Source{}, err
 	}
 
+	hooks, err := l.finalizeSourceSpecificHooks(i)
+	if err != nil {
+		return Source{}, err
+	}
+
 	sourceVCSs, err := l.finalizeSourceSpecificVCSs(i)
 	if err != nil {
 		return Source{}, err
@@ -175,6 +181,7 @@ func (l *loader) finalizeSource(i intermediateSource) (Source, error) {
 		Name:    i.Schema.Name,
 		Enabled: enabled,
 		Clones:  clones,
+		Hooks:   hooks,
 		Driver:  cfg,
 	}, nil
 }
@@ -31,7 +31,9 @@ var _ = Describe("func Load() (source configuration)", func() {
 				Name:    "test_source",
 				Enabled: true,
 				Clones: Clones{
-					Dir: "~/grit/test_source",
+					Dir:          "~/grit/test_source",
+					ArchiveDir:   "~/grit/.archive/test_source",
+					ArchiveAfter: DefaultArchiveAfter,
 				},
 				Driver: &stubs.SourceConfig{
 					ArbitraryAttribute: "<default>",
@@ -54,7 +56,9 @@ var _ = Describe("func Load() (source configuration)", func() {
 				Name:    "test_source",
 				Enabled: false,
 				Clones: Clones{
-					Dir: "~/grit/test_source",
+					Dir:          "~/grit/test_source",
+					ArchiveDir:   "~/grit/.archive/test_source",
+					ArchiveAfter: DefaultArchiveAfter,
 				},
 				Driver: &stubs.SourceConfig{
 					ArbitraryAttribute: "<default>",
@@ -77,7 +81,9 @@ var _ = Describe("func Load() (source configuration)", func() {
 				Name:    "test_source",
 				Enabled: true,
 				Clones: Clones{
-					Dir: "~/grit/test_source",
+					Dir:          "~/grit/test_source",
+					ArchiveDir:   "~/grit/.archive/test_source",
+					ArchiveAfter: DefaultArchiveAfter,
 				},
 				Driver: &stubs.SourceConfig{
 					ArbitraryAttribute: "<explicit>",
@@ -96,7 +102,9 @@ var _ = Describe("func Load() (source configuration)", func() {
 				Name:    "implicit",
 				Enabled: true,
 				Clones: Clones{
-					Dir: "~/grit/implicit",
+					Dir:          "~/grit/implicit",
+					ArchiveDir:   "~/grit/.archive/implicit",
+					ArchiveAfter: DefaultArchiveAfter,
 				},
 				Driver: &stubs.SourceConfig{
 					ArbitraryAttribute: "<implicit>",
@@ -153,7 +161,9 @@ var _ = Describe("func Load() (source configuration)", func() {
 				Name:    "implicit",
 				Enabled: true,
 				Clones: Clones{
-					Dir: "~/grit/implicit",
+					Dir:          "~/grit/implicit",
+					ArchiveDir:   "~/grit/.archive/implicit",
+					ArchiveAfter: DefaultArchiveAfter,
 				},
 				Driver: &stubs.SourceConfig{
 					ArbitraryAttribute: "<explicit>",
@@ -195,7 +205,9 @@ var _ = Describe("func Load() (source configuration)", func() {
 				Name:    "implicit",
 				Enabled: true,
 				Clones: Clones{
-					Dir: "~/grit/implicit",
+					Dir:          "~/grit/implicit",
+					ArchiveDir:   "~/grit/.archive/implicit",
+					ArchiveAfter: DefaultArchiveAfter,
 				},
 				Driver: &stubs.SourceConfig{
 					VCSs: map[string]vcsdriver.Config{
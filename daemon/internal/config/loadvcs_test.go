@@ -26,7 +26,9 @@ var _ = Describe("func Load() (VCS configuration)", func() {
 				Name:    "test_source",
 				Enabled: true,
 				Clones: Clones{
-					Dir: "~/grit/test_source",
+					Dir:          "~/grit/test_source",
+					ArchiveDir:   "~/grit/.archive/test_source",
+					ArchiveAfter: DefaultArchiveAfter,
 				},
 				Driver: &stubs.SourceConfig{
 					ArbitraryAttribute: "<default>",
@@ -51,7 +53,9 @@ var _ = Describe("func Load() (VCS configuration)", func() {
 				Name:    "test_source",
 				Enabled: true,
 				Clones: Clones{
-					Dir: "~/grit/test_source",
+					Dir:          "~/grit/test_source",
+					ArchiveDir:   "~/grit/.archive/test_source",
+					ArchiveAfter: DefaultArchiveAfter,
 				},
 				Driver: &stubs.SourceConfig{
 					ArbitraryAttribute: "<default>",
@@ -80,7 +84,9 @@ var _ = Describe("func Load() (VCS configuration)", func() {
 				Name:    "test_source",
 				Enabled: true,
 				Clones: Clones{
-					Dir: "~/grit/test_source",
+					Dir:          "~/grit/test_source",
+					ArchiveDir:   "~/grit/.archive/test_source",
+					ArchiveAfter: DefaultArchiveAfter,
 				},
 				Driver: &stubs.SourceConfig{
 					ArbitraryAttribute: "<default>",
@@ -28,6 +28,13 @@ type fileSchema struct {
 	// all of the loaded configuration files.
 	GlobalVCSs []vcsSchema `hcl:"vcs,block"`
 
+	// GlobalHooks is the configuration for hooks that are run for
+	// repositories from every source.
+	//
+	// Hooks may be defined in any number of the loaded configuration files.
+	// Within each event, they are run in the order in which they are loaded.
+	GlobalHooks []hookSchema `hcl:"hook,block"`
+
 	// Sources is the configuration for repository sources.
 	//
 	// Each source has a (case-insensitive) name which must be unique across all
@@ -40,6 +47,9 @@ type daemonSchema struct {
 	// Socket is the path to the unix-socket address used for gRPC communication
 	// between the CLI and the daemon.
 	Socket string `hcl:"socket,optional"`
+
+	// DataDir is the directory in which the daemon stores its internal data.
+	DataDir string `hcl:"data_dir,optional"`
 }
 
 // clonesSchema is the HCL schema for a "clones" block.
@@ -52,6 +62,21 @@ type clonesSchema struct {
 	// For clones configuration within a specific source, this is the exact
 	// path under which clones are stored.
 	Dir string `hcl:"dir,optional"`
+
+	// ArchiveDir is the directory in which archives of local clones are
+	// stored.
+	//
+	// As with Dir, if this is a global clones block this is the base directory
+	// under which each source has its own archive directory by default.
+	ArchiveDir string `hcl:"archive_dir,optional"`
+
+	// ArchiveAfter is the period of inactivity after which a local clone is
+	// eligible to be archived, such as "90d" or "720h".
+	ArchiveAfter string `hcl:"archive_after,optional"`
+
+	// Layout is a template for the path of each clone within the clones
+	// directory, such as "{owner}/{name}".
+	Layout string `hcl:"layout,optional"`
 }
 
 // vcsSchema is the HCL schema for a "vcs" block.
@@ -65,6 +90,21 @@ type vcsSchema struct {
 	DriverBody hcl.Body `hcl:",remain"`
 }
 
+// hookSchema is the HCL schema for a "hook" block.
+type hookSchema struct {
+	// Event is the name of the event that triggers the hook, such as
+	// "post_clone".
+	Event string `hcl:",label"`
+
+	// Command is the command to run and its arguments.
+	Command []string `hcl:"command"`
+
+	// Required indicates whether a failure of the hook causes the operation
+	// that triggered it to fail. If it is null (absent) the failure is only
+	// reported.
+	Required *bool `hcl:"required"`
+}
+
 // sourceSchema is the HCL schema for a "source" block.
 type sourceSchema struct {
 	// Name is the unique name for the source.
@@ -89,6 +129,11 @@ type sourceSchema struct {
 	// systems when dealing with repositories from this source.
 	VCSs []vcsSchema `hcl:"vcs,block"`
 
+	// Hooks is the configuration for hooks that are run only for repositories
+	// from this source. They are run after any global hooks for the same
+	// event.
+	Hooks []hookSchema `hcl:"hook,block"`
+
 	// DriverBody the source-driver-specific configuration. The schema is defined by
 	// the driver.
 	DriverBody hcl.Body `hcl:",remain"`
@@ -15,6 +15,40 @@ type Cloner interface {
 	Clone(
 		ctx context.Context,
 		dir string,
+		opts CloneOptions,
 		log logs.Log,
 	) error
 }
+
+// CloneOptions contains options that alter how a repository is cloned.
+//
+// The zero-value does not alter the Cloner's default behavior. Options that
+// are not supported by the VCS are ignored.
+type CloneOptions struct {
+	// Depth, if non-nil, limits the history of the clone to the given number
+	// of commits. A value of zero means the full history is cloned, even if
+	// the Cloner is configured to make shallow clones.
+	Depth *int
+
+	// SingleBranch, if non-nil, indicates whether only the repository's
+	// default branch should be cloned.
+	SingleBranch *bool
+}
+
+// CloneURLLister is an optional interface that may be implemented by a
+// [Cloner] to describe the URLs it can clone from.
+type CloneURLLister interface {
+	// CloneURLs returns the URLs that may be used to clone the repository, in
+	// order of preference.
+	CloneURLs() []CloneURL
+}
+
+// CloneURL is a URL that may be used to clone a repository.
+type CloneURL struct {
+	// Protocol is the name of the protocol used by the URL, such as "ssh" or
+	// "https".
+	Protocol string
+
+	// URL is the URL itself.
+	URL string
+}
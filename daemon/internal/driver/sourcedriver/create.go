@@ -0,0 +1,38 @@
+package sourcedriver
+
+import (
+	"context"
+
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// Creator is an interface for creating new repositories on a source.
+//
+// It is an optional interface that may be implemented by a [Source].
+type Creator interface {
+	// Create creates a new repository with the given name.
+	//
+	// The name is typically captured directly from user input and has not been
+	// sanitized. Its format is implementation defined.
+	//
+	// It returns a cloner that clones the new repository, and information
+	// about the new repository. The repository must be available to
+	// subsequent calls to Resolve(), Cloner() and Suggest().
+	Create(
+		ctx context.Context,
+		name string,
+		opts CreateOptions,
+		log logs.Log,
+	) (Cloner, RemoteRepo, error)
+}
+
+// CreateOptions contains options that alter how a repository is created.
+type CreateOptions struct {
+	// Private indicates that the repository should only be visible to the user
+	// that creates it, and to those that they grant access.
+	Private bool
+
+	// Template is the name of an existing repository to use as a template for
+	// the new repository. If it is empty, the new repository is empty.
+	Template string
+}
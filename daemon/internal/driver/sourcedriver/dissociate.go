@@ -0,0 +1,23 @@
+package sourcedriver
+
+import (
+	"context"
+
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// Dissociator is an interface for making a local clone self-contained, such
+// that it no longer depends on data stored outside of the clone directory.
+//
+// It is an optional interface that may be implemented by a [LocalClone] that
+// may borrow data from other repositories, such as a local mirror.
+type Dissociator interface {
+	// Dissociate copies any data that the local clone borrows from other
+	// repositories into the clone directory, then stops borrowing it.
+	//
+	// It is a no-op if the local clone does not borrow any data.
+	Dissociate(
+		ctx context.Context,
+		log logs.Log,
+	) error
+}
@@ -0,0 +1,25 @@
+package sourcedriver
+
+import (
+	"context"
+
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// Forker is an interface for creating forks of a source's repositories.
+//
+// It is an optional interface that may be implemented by a [Source].
+type Forker interface {
+	// Fork creates a fork of the repository with the given ID, owned by the
+	// authenticated user, and waits until it is ready to be cloned.
+	//
+	// If the user already has a fork of the repository, it is used instead of
+	// creating a new one.
+	//
+	// It returns a cloner that clones the fork and configures the original
+	// repository as its "upstream" remote, and information about the fork.
+	//
+	// id is the ID of the repository to fork, as discovered by a prior call
+	// to Resolve().
+	Fork(ctx context.Context, id string, log logs.Log) (Cloner, RemoteRepo, error)
+}
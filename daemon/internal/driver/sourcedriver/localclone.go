@@ -0,0 +1,107 @@
+package sourcedriver
+
+import (
+	"context"
+	"time"
+
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// LocalClone is an interface for operating on an existing local clone of a
+// remote repository.
+//
+// LocalClones are obtained via the LocalClone() method on a [Source].
+type LocalClone interface {
+	// Fetch updates the local clone with any changes from its remote
+	// repositories, without modifying the working tree.
+	Fetch(
+		ctx context.Context,
+		log logs.Log,
+	) error
+
+	// Pull fetches changes from the local clone's remote repositories then
+	// fast-forwards the checked-out branch to match its upstream branch.
+	//
+	// The branch is only updated if the working tree is clean and the branch
+	// can be fast-forwarded; otherwise, the pull is skipped and the reason is
+	// reported in the result. Pull never creates merge commits.
+	Pull(
+		ctx context.Context,
+		log logs.Log,
+	) (PullResult, error)
+
+	// Status returns information about the state of the local clone, such as
+	// whether it contains any changes that do not exist in a remote repository.
+	Status(
+		ctx context.Context,
+		log logs.Log,
+	) (LocalStatus, error)
+
+	// RemoteURL returns the URL of the remote repository that the local clone
+	// was obtained from, such as the URL of Git's "origin" remote.
+	//
+	// The URL is suitable for passing to the ResolveCloneURL() method of a
+	// [Source].
+	RemoteURL(
+		ctx context.Context,
+		log logs.Log,
+	) (string, error)
+}
+
+// PullResult describes the outcome of a pull operation on a local clone.
+type PullResult struct {
+	// Updated is true if the checked-out branch was fast-forwarded.
+	Updated bool
+
+	// SkipReason is a human-readable explanation of why the checked-out branch
+	// was not updated. It is empty unless the pull was skipped.
+	SkipReason string
+}
+
+// LocalStatus describes the state of a local clone.
+type LocalStatus struct {
+	// Branch is the name of the checked-out branch. It is empty if no branch is
+	// checked out.
+	Branch string
+
+	// Head is the VCS-specific identifier of the checked-out revision.
+	Head string
+
+	// HasUncommittedChanges is true if the working tree contains changes that
+	// have not been committed, including untracked files.
+	HasUncommittedChanges bool
+
+	// UnpushedBranches is the names of the local branches that contain commits
+	// that are not present in any remote repository.
+	UnpushedBranches []string
+
+	// HasStashes is true if the local clone contains stashed changes.
+	HasStashes bool
+
+	// LastCommit is the time at which the checked-out revision was committed.
+	// It is the zero-value if there is no such commit.
+	LastCommit time.Time
+
+	// LastAccess is the time at which the local clone was last used, as best
+	// as can be determined by the driver. It is the zero-value if it can not
+	// be determined.
+	LastAccess time.Time
+}
+
+// LastActivity returns the time of the most recent activity in the local
+// clone, whether that be a commit or some other use of the clone.
+func (s LocalStatus) LastActivity() time.Time {
+	if s.LastAccess.After(s.LastCommit) {
+		return s.LastAccess
+	}
+
+	return s.LastCommit
+}
+
+// IsPushed returns true if all of the changes in the local clone are present
+// in a remote repository.
+func (s LocalStatus) IsPushed() bool {
+	return !s.HasUncommittedChanges &&
+		len(s.UnpushedBranches) == 0 &&
+		!s.HasStashes
+}
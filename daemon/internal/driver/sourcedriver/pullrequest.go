@@ -0,0 +1,43 @@
+package sourcedriver
+
+import (
+	"context"
+
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// PullRequestSource is an interface for checking out the pull requests (or
+// equivalent, such as merge requests) that are made against a source's
+// repositories.
+//
+// It is an optional interface that may be implemented by a [Source].
+type PullRequestSource interface {
+	// ResolvePullRequest resolves a reference to a pull request, such as its
+	// URL.
+	//
+	// The query string is typically captured directly from user input and has
+	// not been sanitized. ok is false if the query does not refer to a pull
+	// request made against one of the source's repositories. The
+	// implementation must not return an error if the query is invalid or
+	// unrecognized.
+	ResolvePullRequest(ctx context.Context, query string, log logs.Log) (_ PullRequest, ok bool, _ error)
+
+	// CheckoutPullRequest fetches the head of a pull request into a local
+	// branch of the local clone in dir, then checks that branch out.
+	//
+	// It returns the name of the branch.
+	CheckoutPullRequest(ctx context.Context, dir string, pr PullRequest, log logs.Log) (branch string, _ error)
+}
+
+// PullRequest is a request to merge changes into a remote repository.
+type PullRequest struct {
+	// Repo is the repository that the pull request is made against.
+	Repo RemoteRepo
+
+	// ID is a unique identifier for the pull request within Repo, such as its
+	// number.
+	ID string
+
+	// Title is a short human-readable description of the pull request.
+	Title string
+}
@@ -20,9 +20,22 @@ type RemoteRepo struct {
 	// clone the repository.
 	WebURL string
 
+	// IssuesURL is the URL of the web page that lists the repository's issues,
+	// if available.
+	IssuesURL string
+
+	// PullRequestsURL is the URL of the web page that lists the repository's
+	// pull requests, if available.
+	PullRequestsURL string
+
 	// RelativeCloneDir is the directory into which a local clone of this
 	// repository is placed, relative to the source's clone directory.
 	//
 	// It uses the path separator native to the current OS.
 	RelativeCloneDir string
+
+	// LayoutFields contains values that may be referred to by a clone
+	// directory layout configured by the user, such as "{owner}/{name}", keyed
+	// by field name.
+	LayoutFields map[string]string
 }
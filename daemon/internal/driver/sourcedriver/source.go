@@ -58,12 +58,30 @@ type Source interface {
 	// query is invalid; instead return an empty slice.
 	Resolve(ctx context.Context, query string, log logs.Log) ([]RemoteRepo, error)
 
+	// ResolveCloneURL returns the repository that is cloned from the given URL.
+	//
+	// The URL is typically obtained from the configuration of an existing local
+	// clone, such as the URL of its "origin" remote. It may use any of the
+	// forms accepted by the source's VCS, including SCP-style SSH addresses.
+	//
+	// ok is false if the URL does not refer to one of the source's
+	// repositories. The implementation must not return an error if the URL is
+	// invalid or unrecognized.
+	ResolveCloneURL(ctx context.Context, url string, log logs.Log) (_ RemoteRepo, ok bool, _ error)
+
 	// Cloner returns a cloner that clones the repository with the given ID,
 	// and information about the repository being cloned.
 	//
 	// id is the repository ID, as discovered by a prior call to Resolve().
 	Cloner(ctx context.Context, id string, log logs.Log) (Cloner, RemoteRepo, error)
 
+	// LocalClone returns an interface for operating on an existing local clone
+	// of one of the source's repositories.
+	//
+	// dir is the directory containing the local clone, as previously populated
+	// by a Cloner obtained from this source.
+	LocalClone(ctx context.Context, dir string, log logs.Log) (LocalClone, error)
+
 	// Suggest returns a set of repositories that have names related to the
 	// given word (which may be empty).
 	Suggest(word string, log logs.Log) map[string][]RemoteRepo
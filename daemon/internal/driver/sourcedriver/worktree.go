@@ -0,0 +1,57 @@
+package sourcedriver
+
+import (
+	"context"
+
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// WorktreeManager is an interface for managing the additional working trees of
+// a local clone, allowing several branches to be checked out at once.
+//
+// It is an optional interface that may be implemented by a [LocalClone].
+type WorktreeManager interface {
+	// AddWorktree creates a new working tree in dir with the given branch
+	// checked out.
+	//
+	// If the branch does not exist in the local clone or any of its remote
+	// repositories it is created from the currently checked-out revision.
+	AddWorktree(
+		ctx context.Context,
+		dir, branch string,
+		log logs.Log,
+	) error
+
+	// Worktrees returns the additional working trees of the local clone. The
+	// local clone's own working tree is not included.
+	Worktrees(
+		ctx context.Context,
+		log logs.Log,
+	) ([]Worktree, error)
+
+	// RemoveWorktree removes the working tree in dir.
+	//
+	// Unless force is true, the working tree is not removed if it has
+	// uncommitted changes. The branch that was checked out in the working tree
+	// is not removed.
+	RemoveWorktree(
+		ctx context.Context,
+		dir string,
+		force bool,
+		log logs.Log,
+	) error
+}
+
+// Worktree describes an additional working tree of a local clone.
+type Worktree struct {
+	// Dir is the absolute path to the directory containing the working tree.
+	Dir string
+
+	// Branch is the name of the branch that is checked out in the working
+	// tree. It is empty if no branch is checked out.
+	Branch string
+
+	// HasUncommittedChanges is true if the working tree contains changes that
+	// have not been committed, including untracked files.
+	HasUncommittedChanges bool
+}
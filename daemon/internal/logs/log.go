@@ -8,6 +8,29 @@ import (
 type Message struct {
 	Text      string
 	IsVerbose bool
+
+	// Progress is structured information about the progress of a long-running
+	// operation, such as a clone. It is nil if the message is plain text.
+	Progress *Progress
+}
+
+// Progress describes the progress of a single phase of a long-running
+// operation.
+type Progress struct {
+	// Phase is a human-readable name for the current phase of the operation,
+	// such as "Counting objects".
+	Phase string
+
+	// Current is the number of items that have been processed in this phase.
+	Current uint64
+
+	// Total is the total number of items to be processed in this phase, or
+	// zero if it is not known.
+	Total uint64
+
+	// Bytes is the number of bytes transferred in this phase, or zero if it
+	// is not known.
+	Bytes uint64
 }
 
 // Log is a function that logs a message.
@@ -61,12 +84,8 @@ func (log Log) WithPrefix(format string, args ...any) Log {
 	prefix := fmt.Sprintf(format, args...)
 
 	return func(m Message) {
-		log(
-			Message{
-				prefix + m.Text,
-				m.IsVerbose,
-			},
-		)
+		m.Text = prefix + m.Text
+		log(m)
 	}
 }
 
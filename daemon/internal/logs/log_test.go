@@ -23,5 +23,30 @@ var _ = Describe("type Log", func() {
 				},
 			))
 		})
+
+		It("retains the other message fields", func() {
+			var buffer Buffer
+
+			p := &Progress{
+				Phase:   "<phase>",
+				Current: 1,
+				Total:   2,
+			}
+
+			log := buffer.Log().WithPrefix("prefix: ")
+			log(Message{
+				Text:      "message",
+				IsVerbose: true,
+				Progress:  p,
+			})
+
+			Expect(buffer).To(ConsistOf(
+				Message{
+					Text:      "prefix: message",
+					IsVerbose: true,
+					Progress:  p,
+				},
+			))
+		})
 	})
 })
@@ -0,0 +1,212 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// An Adopter brings existing clones that were not cloned by Grit under its
+// management.
+type Adopter struct {
+	Sources List
+	Index   *Index
+	Log     logs.Log
+}
+
+// Adopt moves the clone in the given directory to the location within the
+// clone directory of the source that provides its remote repository, and
+// records it in the index.
+//
+// If symlink is true the clone is left in place and a symbolic link to it is
+// created in the clone directory instead.
+func (a *Adopter) Adopt(
+	ctx context.Context,
+	dir string,
+	symlink bool,
+	clientLog logs.Log,
+) (_ LocalRepo, err error) {
+	dir = filepath.Clean(dir)
+
+	log := logs.Tee(
+		clientLog,
+		a.Log.WithPrefix("adopt %s: ", dir),
+	)
+
+	defer func() {
+		if err != nil {
+			log.Write("%s", err.Error())
+		}
+	}()
+
+	local, err := a.resolve(ctx, dir, log)
+	if err != nil {
+		return LocalRepo{}, err
+	}
+
+	if local.AbsoluteCloneDir == dir {
+		log.WriteVerbose("the clone is already in the expected location")
+
+		if err := a.Index.Add(local); err != nil {
+			return LocalRepo{}, fmt.Errorf("unable to record local clone: %w", err)
+		}
+
+		return local, nil
+	}
+
+	if err := a.move(dir, local, symlink, log); err != nil {
+		return LocalRepo{}, err
+	}
+
+	if err := a.Index.Add(local); err != nil {
+		if undoErr := a.undoMove(dir, local, symlink); undoErr != nil {
+			return LocalRepo{}, fmt.Errorf(
+				"unable to record local clone: %w (the clone remains at %s: %s)",
+				err,
+				local.AbsoluteCloneDir,
+				undoErr,
+			)
+		}
+
+		return LocalRepo{}, fmt.Errorf("unable to record local clone: %w", err)
+	}
+
+	return local, nil
+}
+
+// resolve returns the local repo that describes where the clone in dir
+// belongs.
+//
+// Each source's driver is asked for the URL of the clone's remote repository,
+// then whether it recognizes that URL.
+func (a *Adopter) resolve(
+	ctx context.Context,
+	dir string,
+	log logs.Log,
+) (LocalRepo, error) {
+	var matches []LocalRepo
+
+	for _, src := range a.Sources {
+		url, err := remoteURL(ctx, src, dir, log)
+		if err != nil {
+			return LocalRepo{}, fmt.Errorf("unable to determine the remote URL of the clone using the '%s' source: %w", src.Name, err)
+		}
+
+		repo, ok, err := src.Driver.ResolveCloneURL(ctx, url, src.Log(log))
+		if err != nil {
+			return LocalRepo{}, fmt.Errorf("unable to resolve %s using the '%s' source: %w", url, src.Name, err)
+		}
+
+		if ok {
+			local, err := src.LocalRepo(repo)
+			if err != nil {
+				return LocalRepo{}, fmt.Errorf("unable to determine clone directory for %s using the '%s' source: %w", url, src.Name, err)
+			}
+
+			matches = append(matches, local)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return LocalRepo{}, fmt.Errorf("none of the configured sources recognize the clone in %s", dir)
+	case 1:
+		return matches[0], nil
+	}
+
+	var names []string
+	for _, m := range matches {
+		names = append(names, m.Source.Name)
+	}
+
+	return LocalRepo{}, fmt.Errorf(
+		"multiple sources recognize the clone in %s: %s",
+		dir,
+		strings.Join(names, ", "),
+	)
+}
+
+// move moves (or links) the clone in dir to its location within the clone
+// directory.
+func (a *Adopter) move(
+	dir string,
+	local LocalRepo,
+	symlink bool,
+	log logs.Log,
+) (err error) {
+	if isWithinDir(local.AbsoluteCloneDir, dir) {
+		return fmt.Errorf("unable to move %s to %s: destination is within the clone", dir, local.AbsoluteCloneDir)
+	}
+
+	if _, err := os.Lstat(local.AbsoluteCloneDir); err == nil {
+		return fmt.Errorf("unable to move %s to %s: destination already exists", dir, local.AbsoluteCloneDir)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	parent := filepath.Dir(local.AbsoluteCloneDir)
+	if err := os.MkdirAll(parent, 0700); err != nil {
+		return fmt.Errorf("unable to create clone directory: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			pruneEmptyDirs(parent, local.Source.BaseCloneDir)
+		}
+	}()
+
+	if symlink {
+		if err := os.Symlink(dir, local.AbsoluteCloneDir); err != nil {
+			return fmt.Errorf("unable to link clone: %w", err)
+		}
+
+		log.WriteVerbose("linked %s to %s", local.AbsoluteCloneDir, dir)
+		return nil
+	}
+
+	if err := os.Rename(dir, local.AbsoluteCloneDir); err != nil {
+		return fmt.Errorf("unable to move clone: %w", err)
+	}
+
+	log.WriteVerbose("moved %s to %s", dir, local.AbsoluteCloneDir)
+	return nil
+}
+
+// undoMove reverses a prior call to move().
+func (a *Adopter) undoMove(
+	dir string,
+	local LocalRepo,
+	symlink bool,
+) error {
+	if symlink {
+		if err := os.Remove(local.AbsoluteCloneDir); err != nil {
+			return err
+		}
+	} else if err := os.Rename(local.AbsoluteCloneDir, dir); err != nil {
+		return err
+	}
+
+	return pruneEmptyDirs(
+		filepath.Dir(local.AbsoluteCloneDir),
+		local.Source.BaseCloneDir,
+	)
+}
+
+// remoteURL returns the URL of the remote repository of the clone in dir, as
+// reported by the driver of the given source.
+func remoteURL(
+	ctx context.Context,
+	src Source,
+	dir string,
+	log logs.Log,
+) (string, error) {
+	clone, err := src.Driver.LocalClone(ctx, dir, src.Log(log))
+	if err != nil {
+		return "", err
+	}
+
+	return clone.RemoteURL(ctx, src.Log(log))
+}
@@ -0,0 +1,228 @@
+package source_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	. "github.com/gritcli/grit/daemon/internal/source"
+	"github.com/gritcli/grit/daemon/internal/stubs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Adopter", func() {
+	var (
+		tempDir string
+		dir     string
+		driver  *stubs.Source
+		src     Source
+		remote  sourcedriver.RemoteRepo
+		index   *Index
+		adopter *Adopter
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = os.MkdirTemp("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(func() {
+			os.RemoveAll(tempDir)
+		})
+
+		dir = filepath.Join(tempDir, "src", "repo")
+
+		_, err = git.PlainInit(dir, false)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		remote = sourcedriver.RemoteRepo{
+			ID:               "<id>",
+			Name:             "owner/repo",
+			RelativeCloneDir: filepath.Join("owner", "repo"),
+		}
+
+		driver = &stubs.Source{
+			LocalCloneFunc: func(
+				context.Context,
+				string,
+				logs.Log,
+			) (sourcedriver.LocalClone, error) {
+				return &stubs.LocalClone{
+					RemoteURLFunc: func(context.Context, logs.Log) (string, error) {
+						return "<url>", nil
+					},
+				}, nil
+			},
+			ResolveCloneURLFunc: func(
+				_ context.Context,
+				url string,
+				_ logs.Log,
+			) (sourcedriver.RemoteRepo, bool, error) {
+				return remote, url == "<url>", nil
+			},
+		}
+
+		src = Source{
+			Name:         "<source>",
+			BaseCloneDir: filepath.Join(tempDir, "clones"),
+			Driver:       driver,
+		}
+
+		index = &Index{
+			File:    filepath.Join(tempDir, "clones.json"),
+			Sources: List{src},
+		}
+
+		adopter = &Adopter{
+			Sources: List{
+				{
+					Name:   "<other>",
+					Driver: &stubs.Source{},
+				},
+				src,
+			},
+			Index: index,
+		}
+	})
+
+	Describe("func Adopt()", func() {
+		It("moves the clone into the source's clone directory", func() {
+			local, err := adopter.Adopt(context.Background(), dir, false, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			expect := LocalRepo{
+				RemoteRepo:       remote,
+				Source:           src,
+				AbsoluteCloneDir: filepath.Join(src.BaseCloneDir, "owner", "repo"),
+			}
+			Expect(local).To(Equal(expect))
+
+			_, err = os.Stat(filepath.Join(expect.AbsoluteCloneDir, ".git"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = os.Stat(dir)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(ConsistOf(expect))
+		})
+
+		It("links to the clone if symlink is true", func() {
+			local, err := adopter.Adopt(context.Background(), dir, true, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			target, err := os.Readlink(local.AbsoluteCloneDir)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(target).To(Equal(dir))
+
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(ConsistOf(local))
+		})
+
+		It("only records the clone if it is already in the expected location", func() {
+			expectDir := filepath.Join(src.BaseCloneDir, "owner", "repo")
+			Expect(os.MkdirAll(filepath.Dir(expectDir), 0700)).To(Succeed())
+			Expect(os.Rename(dir, expectDir)).To(Succeed())
+
+			local, err := adopter.Adopt(context.Background(), expectDir, false, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(local.AbsoluteCloneDir).To(Equal(expectDir))
+
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(ConsistOf(local))
+		})
+
+		It("returns an error if the destination already exists", func() {
+			existing := filepath.Join(src.BaseCloneDir, "owner", "repo")
+			Expect(os.MkdirAll(existing, 0700)).To(Succeed())
+
+			_, err := adopter.Adopt(context.Background(), dir, false, logs.Discard)
+			Expect(err).To(MatchError(
+				"unable to move " + dir + " to " + existing + ": destination already exists",
+			))
+
+			_, err = os.Stat(dir)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("returns an error if no source recognizes the remote URL", func() {
+			driver.ResolveCloneURLFunc = nil
+
+			_, err := adopter.Adopt(context.Background(), dir, false, logs.Discard)
+			Expect(err).To(MatchError("none of the configured sources recognize the clone in " + dir))
+		})
+
+		It("returns an error if multiple sources recognize the remote URL", func() {
+			adopter.Sources[0].Driver = driver
+
+			_, err := adopter.Adopt(context.Background(), dir, false, logs.Discard)
+			Expect(err).To(MatchError("multiple sources recognize the clone in " + dir + ": <other>, <source>"))
+		})
+
+		It("returns an error if the driver fails to resolve the remote URL", func() {
+			driver.ResolveCloneURLFunc = func(
+				context.Context,
+				string,
+				logs.Log,
+			) (sourcedriver.RemoteRepo, bool, error) {
+				return sourcedriver.RemoteRepo{}, false, errors.New("<error>")
+			}
+
+			_, err := adopter.Adopt(context.Background(), dir, false, logs.Discard)
+			Expect(err).To(MatchError("unable to resolve <url> using the '<source>' source: <error>"))
+		})
+
+		It("returns an error if the driver fails to determine the remote URL", func() {
+			driver.LocalCloneFunc = func(
+				context.Context,
+				string,
+				logs.Log,
+			) (sourcedriver.LocalClone, error) {
+				return &stubs.LocalClone{
+					RemoteURLFunc: func(context.Context, logs.Log) (string, error) {
+						return "", errors.New("<error>")
+					},
+				}, nil
+			}
+
+			_, err := adopter.Adopt(context.Background(), dir, false, logs.Discard)
+			Expect(err).To(MatchError("unable to determine the remote URL of the clone using the '<source>' source: <error>"))
+		})
+
+		When("the clone can not be recorded in the index", func() {
+			BeforeEach(func() {
+				// Make the index unwritable by placing it "within" a regular
+				// file.
+				blocker := filepath.Join(tempDir, "blocker")
+				Expect(os.WriteFile(blocker, nil, 0600)).To(Succeed())
+				index.File = filepath.Join(blocker, "clones.json")
+			})
+
+			It("moves the clone back to its original location", func() {
+				_, err := adopter.Adopt(context.Background(), dir, false, logs.Discard)
+				Expect(err).To(MatchError(ContainSubstring("unable to record local clone")))
+
+				_, err = os.Stat(filepath.Join(dir, ".git"))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = os.Stat(filepath.Join(src.BaseCloneDir, "owner"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+
+			It("removes the symbolic link", func() {
+				_, err := adopter.Adopt(context.Background(), dir, true, logs.Discard)
+				Expect(err).To(MatchError(ContainSubstring("unable to record local clone")))
+
+				_, err = os.Lstat(filepath.Join(src.BaseCloneDir, "owner", "repo"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+})
@@ -0,0 +1,622 @@
+package source
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	"github.com/klauspost/compress/zstd"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// archiveExtension is the file extension used for archive files.
+	archiveExtension = ".tar.zst"
+
+	// archiveManifestName is the name of the tar entry that contains
+	// information about the archived repository.
+	archiveManifestName = "grit-archive.json"
+
+	// archiveClonePrefix is the prefix of the names of the tar entries that
+	// contain the content of the archived clone.
+	archiveClonePrefix = "clone/"
+)
+
+// Archive is a compressed archive of a local clone.
+type Archive struct {
+	sourcedriver.RemoteRepo
+	Source Source
+
+	// File is the absolute path to the archive file.
+	File string
+}
+
+// ArchiveResult describes the outcome of archiving a single local clone.
+type ArchiveResult struct {
+	// Eligible is true if the clone has been inactive for long enough to be
+	// archived.
+	Eligible bool
+
+	// File is the path to the archive file. It is empty unless the clone was
+	// archived (or would have been archived, in the case of a dry run).
+	File string
+
+	// SkipReason is a human-readable explanation of why an eligible clone was
+	// not archived. It is empty unless the clone was skipped.
+	SkipReason string
+}
+
+// archiveManifest is the information about the archived repository that is
+// stored within the archive itself.
+type archiveManifest struct {
+	Source           string            `json:"source"`
+	ID               string            `json:"id"`
+	Name             string            `json:"name"`
+	Description      string            `json:"description,omitempty"`
+	WebURL           string            `json:"web_url,omitempty"`
+	IssuesURL        string            `json:"issues_url,omitempty"`
+	PullRequestsURL  string            `json:"pull_requests_url,omitempty"`
+	RelativeCloneDir string            `json:"relative_clone_dir"`
+	LayoutFields     map[string]string `json:"layout_fields,omitempty"`
+}
+
+// An Archiver moves inactive local clones into compressed archives, and
+// restores them.
+type Archiver struct {
+	Sources List
+	Index   *Index
+	Log     logs.Log
+}
+
+// Archive archives a local clone if it has not been used within the given
+// period.
+//
+// If inactiveFor is zero, the source's configured archive period is used. The
+// clone is only archived if all of its changes are present in a remote
+// repository and it has no additional working trees. If dryRun is true, the result describes what would happen without
+// archiving the clone.
+func (a *Archiver) Archive(
+	ctx context.Context,
+	repo LocalRepo,
+	inactiveFor time.Duration,
+	dryRun bool,
+	clientLog logs.Log,
+) (_ ArchiveResult, err error) {
+	daemonLog := repo.Source.
+		Log(a.Log).
+		WithPrefix("archive %s: ", repo.Name)
+
+	log := logs.Tee(clientLog, daemonLog)
+
+	defer func() {
+		if err != nil {
+			log.Write("%s", err.Error())
+		}
+	}()
+
+	if inactiveFor == 0 {
+		inactiveFor = repo.Source.ArchiveAfter
+	}
+
+	status, err := repo.Status(ctx, log)
+	if err != nil {
+		return ArchiveResult{}, err
+	}
+
+	if last := status.LastActivity(); time.Since(last) < inactiveFor {
+		log.WriteVerbose("last used at %s", last.Format(time.RFC3339))
+		return ArchiveResult{}, nil
+	}
+
+	if !status.IsPushed() {
+		return ArchiveResult{
+			Eligible:   true,
+			SkipReason: UnpushedChangesError{status}.Error(),
+		}, nil
+	}
+
+	// Clones that were adopted by linking to them in place are not archived,
+	// as doing so would remove a directory outside of the clone directory.
+	if target, err := os.Readlink(repo.AbsoluteCloneDir); err == nil {
+		return ArchiveResult{
+			Eligible:   true,
+			SkipReason: fmt.Sprintf("local clone is a symbolic link to %s", target),
+		}, nil
+	}
+
+	// The archive only contains the clone directory, so any additional working
+	// trees would be left behind, and their changes are not checked above.
+	if _, worktrees, err := linkedWorktrees(ctx, repo, log); err != nil {
+		return ArchiveResult{}, err
+	} else if len(worktrees) != 0 {
+		return ArchiveResult{
+			Eligible:   true,
+			SkipReason: WorktreesExistError{worktrees}.Error(),
+		}, nil
+	}
+
+	file := filepath.Join(
+		repo.Source.BaseArchiveDir,
+		repo.RelativeCloneDir+archiveExtension,
+	)
+
+	if dryRun {
+		return ArchiveResult{
+			Eligible: true,
+			File:     file,
+		}, nil
+	}
+
+	if _, err := os.Stat(file); err == nil {
+		return ArchiveResult{}, fmt.Errorf("archive file already exists: %s", file)
+	} else if !os.IsNotExist(err) {
+		return ArchiveResult{}, err
+	}
+
+	if err := dissociate(ctx, repo, log); err != nil {
+		return ArchiveResult{}, fmt.Errorf("unable to copy borrowed data into the clone: %w", err)
+	}
+
+	if err := writeArchive(file, repo); err != nil {
+		return ArchiveResult{}, fmt.Errorf("unable to write archive: %w", err)
+	}
+
+	if err := os.RemoveAll(repo.AbsoluteCloneDir); err != nil {
+		return ArchiveResult{}, fmt.Errorf("unable to remove clone directory: %w", err)
+	}
+
+	if err := a.Index.Remove(repo.AbsoluteCloneDir); err != nil {
+		return ArchiveResult{}, fmt.Errorf("unable to record removal of local clone: %w", err)
+	}
+
+	if err := pruneEmptyDirs(
+		filepath.Dir(repo.AbsoluteCloneDir),
+		repo.Source.BaseCloneDir,
+	); err != nil {
+		log.Write("unable to remove empty parent directories: %s", err)
+	}
+
+	daemonLog.Write("archived to %s", file)
+
+	return ArchiveResult{
+		Eligible: true,
+		File:     file,
+	}, nil
+}
+
+// Unarchive restores an archived clone to its original location within the
+// source's clone directory, then removes the archive file.
+func (a *Archiver) Unarchive(
+	ctx context.Context,
+	arc Archive,
+	clientLog logs.Log,
+) (_ LocalRepo, err error) {
+	daemonLog := arc.Source.
+		Log(a.Log).
+		WithPrefix("unarchive %s: ", arc.Name)
+
+	log := logs.Tee(clientLog, daemonLog)
+
+	defer func() {
+		if err != nil {
+			log.Write("%s", err.Error())
+		}
+	}()
+
+	dir := filepath.Join(arc.Source.BaseCloneDir, arc.RelativeCloneDir)
+
+	if err := makeCloneDir(dir); err != nil {
+		return LocalRepo{}, fmt.Errorf("unable to create clone directory: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			os.RemoveAll(dir)
+		}
+	}()
+
+	if err := readArchive(arc.File, dir); err != nil {
+		return LocalRepo{}, fmt.Errorf("unable to extract archive: %w", err)
+	}
+
+	local := LocalRepo{
+		arc.RemoteRepo,
+		arc.Source,
+		dir,
+	}
+
+	if err := a.Index.Add(local); err != nil {
+		return LocalRepo{}, fmt.Errorf("unable to record local clone: %w", err)
+	}
+
+	if err := os.Remove(arc.File); err != nil {
+		log.Write("unable to remove archive file: %s", err)
+	} else if err := pruneEmptyDirs(
+		filepath.Dir(arc.File),
+		arc.Source.BaseArchiveDir,
+	); err != nil {
+		log.Write("unable to remove empty parent directories: %s", err)
+	}
+
+	daemonLog.Write("restored to %s", dir)
+
+	return local, nil
+}
+
+// List returns the archives of clones from all sources, sorted by source and
+// name.
+func (a *Archiver) List() ([]Archive, error) {
+	var archives []Archive
+
+	for _, src := range a.Sources {
+		err := filepath.WalkDir(
+			src.BaseArchiveDir,
+			func(p string, d fs.DirEntry, err error) error {
+				if err != nil {
+					if os.IsNotExist(err) && p == src.BaseArchiveDir {
+						return nil
+					}
+					return err
+				}
+
+				if d.IsDir() || !strings.HasSuffix(p, archiveExtension) {
+					return nil
+				}
+
+				m, err := readArchiveManifest(p)
+				if err != nil {
+					a.Log.Write("ignoring unreadable archive %s: %s", p, err)
+					return nil
+				}
+
+				archives = append(archives, Archive{
+					RemoteRepo: sourcedriver.RemoteRepo{
+						ID:               m.ID,
+						Name:             m.Name,
+						Description:      m.Description,
+						WebURL:           m.WebURL,
+						IssuesURL:        m.IssuesURL,
+						PullRequestsURL:  m.PullRequestsURL,
+						RelativeCloneDir: m.RelativeCloneDir,
+						LayoutFields:     m.LayoutFields,
+					},
+					Source: src,
+					File:   p,
+				})
+
+				return nil
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	slices.SortFunc(
+		archives,
+		func(a, b Archive) bool {
+			if a.Source.Name != b.Source.Name {
+				return a.Source.Name < b.Source.Name
+			}
+			return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+		},
+	)
+
+	return archives, nil
+}
+
+// Resolve returns the archives of clones that match the given query.
+//
+// The query is matched in the same way as [Index.Resolve], except that paths
+// are not supported.
+func (a *Archiver) Resolve(query string) ([]Archive, error) {
+	archives, err := a.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []Archive
+
+	for _, arc := range archives {
+		if matchesQuery(query, arc.RemoteRepo) {
+			matches = append(matches, arc)
+		}
+	}
+
+	return matches, nil
+}
+
+// dissociate makes a local clone self-contained if its driver supports it, so
+// that the archive can be restored even after any repository that the clone
+// borrows data from (such as a mirror) has been removed.
+func dissociate(ctx context.Context, repo LocalRepo, log logs.Log) error {
+	clone, err := repo.Source.Driver.LocalClone(ctx, repo.AbsoluteCloneDir, log)
+	if err != nil {
+		return fmt.Errorf("unable to open local clone: %w", err)
+	}
+
+	if d, ok := clone.(sourcedriver.Dissociator); ok {
+		return d.Dissociate(ctx, log)
+	}
+
+	return nil
+}
+
+// writeArchive writes the content of a local clone to a compressed archive
+// file.
+//
+// The archive is written to a temporary file which then replaces the archive
+// file, such that the archive file is never left partially written.
+func writeArchive(file string, repo LocalRepo) error {
+	dir := filepath.Dir(file)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	fp, err := os.CreateTemp(dir, filepath.Base(file)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(fp.Name())
+	defer fp.Close()
+
+	zw, err := zstd.NewWriter(fp)
+	if err != nil {
+		return err
+	}
+	defer zw.Close()
+
+	tw := tar.NewWriter(zw)
+	defer tw.Close()
+
+	manifest, err := json.Marshal(archiveManifest{
+		Source:           repo.Source.Name,
+		ID:               repo.ID,
+		Name:             repo.Name,
+		Description:      repo.Description,
+		WebURL:           repo.WebURL,
+		IssuesURL:        repo.IssuesURL,
+		PullRequestsURL:  repo.PullRequestsURL,
+		RelativeCloneDir: repo.RelativeCloneDir,
+		LayoutFields:     repo.LayoutFields,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     archiveManifestName,
+		Mode:     0600,
+		Size:     int64(len(manifest)),
+		ModTime:  time.Now(),
+	}); err != nil {
+		return err
+	}
+
+	if _, err := tw.Write(manifest); err != nil {
+		return err
+	}
+
+	if err := writeArchiveEntries(tw, repo.AbsoluteCloneDir); err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	if err := fp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(fp.Name(), file)
+}
+
+// writeArchiveEntries writes a tar entry for each file and directory within
+// dir.
+func writeArchiveEntries(tw *tar.Writer, dir string) error {
+	return filepath.WalkDir(
+		dir,
+		func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			rel, err := filepath.Rel(dir, p)
+			if err != nil {
+				return err
+			}
+
+			if rel == "." {
+				return nil
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+
+			var link string
+			switch {
+			case info.Mode()&fs.ModeSymlink != 0:
+				link, err = os.Readlink(p)
+				if err != nil {
+					return err
+				}
+			case !info.Mode().IsRegular() && !info.IsDir():
+				return nil // ignore sockets, devices, etc
+			}
+
+			hdr, err := tar.FileInfoHeader(info, link)
+			if err != nil {
+				return err
+			}
+
+			hdr.Name = archiveClonePrefix + filepath.ToSlash(rel)
+			if info.IsDir() {
+				hdr.Name += "/"
+			}
+
+			if err := tw.WriteHeader(hdr); err != nil {
+				return err
+			}
+
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+
+			fp, err := os.Open(p)
+			if err != nil {
+				return err
+			}
+			defer fp.Close()
+
+			_, err = io.Copy(tw, fp)
+			return err
+		},
+	)
+}
+
+// readArchiveManifest reads the manifest from an archive file.
+func readArchiveManifest(file string) (archiveManifest, error) {
+	fp, err := os.Open(file)
+	if err != nil {
+		return archiveManifest{}, err
+	}
+	defer fp.Close()
+
+	zr, err := zstd.NewReader(fp)
+	if err != nil {
+		return archiveManifest{}, err
+	}
+	defer zr.Close()
+
+	tr := tar.NewReader(zr)
+
+	hdr, err := tr.Next()
+	if err != nil {
+		return archiveManifest{}, err
+	}
+
+	if hdr.Name != archiveManifestName {
+		return archiveManifest{}, errors.New("archive does not begin with a manifest")
+	}
+
+	var m archiveManifest
+	if err := json.NewDecoder(tr).Decode(&m); err != nil {
+		return archiveManifest{}, err
+	}
+
+	return m, nil
+}
+
+// readArchive extracts the content of the clone within an archive file into
+// dir.
+func readArchive(file, dir string) error {
+	fp, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer fp.Close()
+
+	zr, err := zstd.NewReader(fp)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	// Resolve any symbolic links in dir itself so that it can be compared to
+	// the resolved parent directory of each entry.
+	dir, err = filepath.EvalSymlinks(dir)
+	if err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(zr)
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		rel, ok := strings.CutPrefix(hdr.Name, archiveClonePrefix)
+		if !ok {
+			continue
+		}
+
+		rel = path.Clean(rel)
+		if !filepath.IsLocal(rel) {
+			return fmt.Errorf("archive contains an invalid path: %s", hdr.Name)
+		}
+
+		if err := extractArchiveEntry(tr, hdr, dir, filepath.FromSlash(rel)); err != nil {
+			return err
+		}
+	}
+}
+
+// extractArchiveEntry extracts a single tar entry to the path rel within dir.
+func extractArchiveEntry(
+	tr *tar.Reader,
+	hdr *tar.Header,
+	dir, rel string,
+) error {
+	p := filepath.Join(dir, rel)
+
+	// Guard against entries that would be written via a symbolic link that
+	// points outside of the clone directory.
+	parent, err := filepath.EvalSymlinks(filepath.Dir(p))
+	if err != nil {
+		return err
+	}
+	if !isWithinDir(parent, dir) {
+		return fmt.Errorf("archive contains an invalid path: %s", hdr.Name)
+	}
+
+	mode := hdr.FileInfo().Mode().Perm()
+
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		return os.MkdirAll(p, mode|0700)
+
+	case tar.TypeSymlink:
+		return os.Symlink(hdr.Linkname, p)
+
+	case tar.TypeReg:
+		fp, err := os.OpenFile(p, os.O_CREATE|os.O_EXCL|os.O_WRONLY, mode)
+		if err != nil {
+			return err
+		}
+
+		if _, err := io.Copy(fp, tr); err != nil {
+			fp.Close()
+			return err
+		}
+
+		// Modification times are deliberately not restored, such that the
+		// restored clone is not considered inactive immediately.
+		return fp.Close()
+
+	default:
+		return nil
+	}
+}
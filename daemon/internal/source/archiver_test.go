@@ -0,0 +1,481 @@
+package source_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/gritcli/grit/daemon/internal/builtins/gitvcs"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	. "github.com/gritcli/grit/daemon/internal/source"
+	"github.com/gritcli/grit/daemon/internal/stubs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Archiver", func() {
+	var (
+		tempDir  string
+		status   sourcedriver.LocalStatus
+		driver   *stubs.Source
+		src      Source
+		repo     LocalRepo
+		index    *Index
+		archiver *Archiver
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = os.MkdirTemp("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(func() {
+			os.RemoveAll(tempDir)
+		})
+
+		status = sourcedriver.LocalStatus{
+			LastCommit: time.Now().Add(-48 * time.Hour),
+			LastAccess: time.Now().Add(-36 * time.Hour),
+		}
+
+		driver = &stubs.Source{
+			LocalCloneFunc: func(
+				context.Context,
+				string,
+				logs.Log,
+			) (sourcedriver.LocalClone, error) {
+				return &stubs.LocalClone{
+					StatusFunc: func(context.Context, logs.Log) (sourcedriver.LocalStatus, error) {
+						return status, nil
+					},
+				}, nil
+			},
+		}
+
+		src = Source{
+			Name:           "<source>",
+			BaseCloneDir:   filepath.Join(tempDir, "clones"),
+			BaseArchiveDir: filepath.Join(tempDir, "archive"),
+			ArchiveAfter:   24 * time.Hour,
+			Driver:         driver,
+		}
+
+		repo = LocalRepo{
+			RemoteRepo: sourcedriver.RemoteRepo{
+				ID:               "<id>",
+				Name:             "owner/repo",
+				Description:      "<description>",
+				WebURL:           "<url>",
+				IssuesURL:        "<issues-url>",
+				PullRequestsURL:  "<pulls-url>",
+				RelativeCloneDir: "owner/repo",
+				LayoutFields: map[string]string{
+					"dir":  "owner/repo",
+					"name": "repo",
+				},
+			},
+			Source:           src,
+			AbsoluteCloneDir: filepath.Join(src.BaseCloneDir, "owner", "repo"),
+		}
+
+		err = os.MkdirAll(filepath.Join(repo.AbsoluteCloneDir, "subdir"), 0700)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = os.WriteFile(filepath.Join(repo.AbsoluteCloneDir, "README.md"), []byte("<readme>"), 0644)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = os.WriteFile(filepath.Join(repo.AbsoluteCloneDir, "subdir", "script.sh"), []byte("<script>"), 0755)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = os.Symlink("README.md", filepath.Join(repo.AbsoluteCloneDir, "link"))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		index = &Index{
+			File:    filepath.Join(tempDir, "clones.json"),
+			Sources: List{src},
+		}
+		Expect(index.Add(repo)).To(Succeed())
+
+		archiver = &Archiver{
+			Sources: List{src},
+			Index:   index,
+		}
+	})
+
+	archiveFile := func() string {
+		return filepath.Join(src.BaseArchiveDir, "owner", "repo.tar.zst")
+	}
+
+	Describe("func Archive()", func() {
+		It("archives an inactive clone", func() {
+			result, err := archiver.Archive(context.Background(), repo, 0, false, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(ArchiveResult{
+				Eligible: true,
+				File:     archiveFile(),
+			}))
+
+			_, err = os.Stat(archiveFile())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = os.Stat(filepath.Join(src.BaseCloneDir, "owner"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(BeEmpty())
+		})
+
+		It("does not archive a clone that has been used recently", func() {
+			status.LastAccess = time.Now()
+
+			result, err := archiver.Archive(context.Background(), repo, 0, false, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(ArchiveResult{}))
+
+			_, err = os.Stat(repo.AbsoluteCloneDir)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("uses the given inactivity period in preference to the source's", func() {
+			result, err := archiver.Archive(context.Background(), repo, 72*time.Hour, false, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(ArchiveResult{}))
+		})
+
+		It("skips clones with unpushed changes", func() {
+			status.HasStashes = true
+
+			result, err := archiver.Archive(context.Background(), repo, 0, false, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(ArchiveResult{
+				Eligible:   true,
+				SkipReason: "local clone has stashed changes",
+			}))
+
+			_, err = os.Stat(repo.AbsoluteCloneDir)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("skips clones that are symbolic links", func() {
+			target := filepath.Join(tempDir, "elsewhere")
+			Expect(os.Rename(repo.AbsoluteCloneDir, target)).To(Succeed())
+			Expect(os.Symlink(target, repo.AbsoluteCloneDir)).To(Succeed())
+
+			result, err := archiver.Archive(context.Background(), repo, 0, false, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(ArchiveResult{
+				Eligible:   true,
+				SkipReason: "local clone is a symbolic link to " + target,
+			}))
+
+			_, err = os.Stat(archiveFile())
+			Expect(os.IsNotExist(err)).To(BeTrue())
+
+			Expect(filepath.Join(target, "README.md")).To(BeAnExistingFile())
+			Expect(repo.AbsoluteCloneDir).To(BeADirectory())
+		})
+
+		It("skips clones that have worktrees", func() {
+			driver.LocalCloneFunc = func(
+				context.Context,
+				string,
+				logs.Log,
+			) (sourcedriver.LocalClone, error) {
+				return &stubs.WorktreeClone{
+					LocalClone: stubs.LocalClone{
+						StatusFunc: func(context.Context, logs.Log) (sourcedriver.LocalStatus, error) {
+							return status, nil
+						},
+					},
+					WorktreesFunc: func(context.Context, logs.Log) ([]sourcedriver.Worktree, error) {
+						return []sourcedriver.Worktree{
+							{
+								Dir:    repo.AbsoluteCloneDir + "@feature",
+								Branch: "feature",
+							},
+						}, nil
+					},
+				}, nil
+			}
+
+			result, err := archiver.Archive(context.Background(), repo, 0, false, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(ArchiveResult{
+				Eligible:   true,
+				SkipReason: "local clone has worktrees (repo@feature)",
+			}))
+
+			_, err = os.Stat(archiveFile())
+			Expect(os.IsNotExist(err)).To(BeTrue())
+			Expect(repo.AbsoluteCloneDir).To(BeADirectory())
+		})
+
+		It("does not modify anything during a dry run", func() {
+			result, err := archiver.Archive(context.Background(), repo, 0, true, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(ArchiveResult{
+				Eligible: true,
+				File:     archiveFile(),
+			}))
+
+			_, err = os.Stat(archiveFile())
+			Expect(os.IsNotExist(err)).To(BeTrue())
+
+			_, err = os.Stat(repo.AbsoluteCloneDir)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("copies borrowed data into the clone before archiving it", func() {
+			driver.LocalCloneFunc = func(
+				_ context.Context,
+				dir string,
+				_ logs.Log,
+			) (sourcedriver.LocalClone, error) {
+				return &stubs.DissociatingClone{
+					LocalClone: stubs.LocalClone{
+						StatusFunc: func(context.Context, logs.Log) (sourcedriver.LocalStatus, error) {
+							return status, nil
+						},
+					},
+					DissociateFunc: func(context.Context, logs.Log) error {
+						return os.WriteFile(filepath.Join(dir, "borrowed"), []byte("<borrowed>"), 0600)
+					},
+				}, nil
+			}
+
+			_, err := archiver.Archive(context.Background(), repo, 0, false, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			archives, err := archiver.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(archives).To(HaveLen(1))
+
+			_, err = archiver.Unarchive(context.Background(), archives[0], logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			data, err := os.ReadFile(filepath.Join(repo.AbsoluteCloneDir, "borrowed"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(data)).To(Equal("<borrowed>"))
+		})
+
+		It("does not archive the clone if borrowed data can not be copied", func() {
+			driver.LocalCloneFunc = func(
+				context.Context,
+				string,
+				logs.Log,
+			) (sourcedriver.LocalClone, error) {
+				return &stubs.DissociatingClone{
+					LocalClone: stubs.LocalClone{
+						StatusFunc: func(context.Context, logs.Log) (sourcedriver.LocalStatus, error) {
+							return status, nil
+						},
+					},
+					DissociateFunc: func(context.Context, logs.Log) error {
+						return errors.New("<error>")
+					},
+				}, nil
+			}
+
+			_, err := archiver.Archive(context.Background(), repo, 0, false, logs.Discard)
+			Expect(err).To(MatchError("unable to copy borrowed data into the clone: <error>"))
+
+			_, err = os.Stat(archiveFile())
+			Expect(os.IsNotExist(err)).To(BeTrue())
+			Expect(repo.AbsoluteCloneDir).To(BeADirectory())
+		})
+
+		It("produces an archive that can be restored after the mirror of a Git clone is removed", func() {
+			mirror := filepath.Join(tempDir, "mirror")
+			runGit(tempDir, "init", "--quiet", mirror)
+			runGit(mirror, "-c", "user.name=<name>", "-c", "user.email=<email>", "commit", "--quiet", "--allow-empty", "-m", "initial")
+
+			Expect(os.RemoveAll(repo.AbsoluteCloneDir)).To(Succeed())
+			runGit(tempDir, "clone", "--quiet", "--shared", mirror, repo.AbsoluteCloneDir)
+
+			driver.LocalCloneFunc = func(
+				_ context.Context,
+				dir string,
+				_ logs.Log,
+			) (sourcedriver.LocalClone, error) {
+				return &stubs.DissociatingClone{
+					LocalClone: stubs.LocalClone{
+						StatusFunc: func(context.Context, logs.Log) (sourcedriver.LocalStatus, error) {
+							return status, nil
+						},
+					},
+					DissociateFunc: (&gitvcs.LocalClone{Dir: dir}).Dissociate,
+				}, nil
+			}
+
+			_, err := archiver.Archive(context.Background(), repo, 0, false, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(os.RemoveAll(mirror)).To(Succeed())
+
+			archives, err := archiver.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(archives).To(HaveLen(1))
+
+			_, err = archiver.Unarchive(context.Background(), archives[0], logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(filepath.Join(repo.AbsoluteCloneDir, ".git", "objects", "info", "alternates")).NotTo(BeAnExistingFile())
+			runGit(repo.AbsoluteCloneDir, "fsck", "--no-progress")
+			runGit(repo.AbsoluteCloneDir, "log", "--oneline")
+		})
+
+		It("returns an error if the archive file already exists", func() {
+			err := os.MkdirAll(filepath.Dir(archiveFile()), 0700)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = os.WriteFile(archiveFile(), nil, 0600)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = archiver.Archive(context.Background(), repo, 0, false, logs.Discard)
+			Expect(err).To(MatchError("archive file already exists: " + archiveFile()))
+
+			_, err = os.Stat(repo.AbsoluteCloneDir)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+
+	When("the clone has been archived", func() {
+		BeforeEach(func() {
+			_, err := archiver.Archive(context.Background(), repo, 0, false, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		Describe("func List()", func() {
+			It("returns the archive", func() {
+				archives, err := archiver.List()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(archives).To(Equal([]Archive{
+					{
+						RemoteRepo: repo.RemoteRepo,
+						Source:     src,
+						File:       archiveFile(),
+					},
+				}))
+			})
+
+			It("ignores files that are not archives", func() {
+				err := os.WriteFile(filepath.Join(src.BaseArchiveDir, "README"), nil, 0600)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				archives, err := archiver.List()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(archives).To(HaveLen(1))
+			})
+		})
+
+		Describe("func Resolve()", func() {
+			It("returns archives that match the query", func() {
+				archives, err := archiver.Resolve("repo")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(archives).To(HaveLen(1))
+
+				archives, err = archiver.Resolve("other")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(archives).To(BeEmpty())
+			})
+		})
+
+		Describe("func Unarchive()", func() {
+			var arc Archive
+
+			BeforeEach(func() {
+				archives, err := archiver.List()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(archives).To(HaveLen(1))
+				arc = archives[0]
+			})
+
+			It("restores the clone to its original location", func() {
+				local, err := archiver.Unarchive(context.Background(), arc, logs.Discard)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(local).To(Equal(repo))
+
+				data, err := os.ReadFile(filepath.Join(repo.AbsoluteCloneDir, "README.md"))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(string(data)).To(Equal("<readme>"))
+
+				info, err := os.Stat(filepath.Join(repo.AbsoluteCloneDir, "subdir", "script.sh"))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0755)))
+
+				link, err := os.Readlink(filepath.Join(repo.AbsoluteCloneDir, "link"))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(link).To(Equal("README.md"))
+			})
+
+			It("adds the clone to the index", func() {
+				_, err := archiver.Unarchive(context.Background(), arc, logs.Discard)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				repos, err := index.List()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(repos).To(Equal([]LocalRepo{repo}))
+			})
+
+			It("removes the archive file", func() {
+				_, err := archiver.Unarchive(context.Background(), arc, logs.Discard)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = os.Stat(archiveFile())
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+
+			It("restores the layout fields so that the clone can be relocated", func() {
+				_, err := archiver.Unarchive(context.Background(), arc, logs.Discard)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				index.Sources[0].Layout = "{name}"
+				relocator := &Relocator{
+					Index: index,
+					Log:   logs.Discard,
+				}
+
+				relocations, err := relocator.Relocations()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(relocations).To(HaveLen(1))
+
+				rel := relocations[0]
+				Expect(rel.PreviousCloneDir).To(Equal(repo.AbsoluteCloneDir))
+				Expect(rel.AbsoluteCloneDir).To(Equal(filepath.Join(src.BaseCloneDir, "repo")))
+
+				err = relocator.Relocate(context.Background(), rel, logs.Discard)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				data, err := os.ReadFile(filepath.Join(rel.AbsoluteCloneDir, "README.md"))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(string(data)).To(Equal("<readme>"))
+			})
+
+			It("returns an error if the clone directory already exists", func() {
+				err := os.MkdirAll(repo.AbsoluteCloneDir, 0700)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = archiver.Unarchive(context.Background(), arc, logs.Discard)
+				Expect(err).To(MatchError(
+					"unable to create clone directory: " + repo.AbsoluteCloneDir + " already exists",
+				))
+
+				_, err = os.Stat(archiveFile())
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+	})
+})
+
+// runGit runs a Git command in dir and fails the test if it does not succeed.
+func runGit(dir string, args ...string) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+
+	out, err := cmd.CombinedOutput()
+	Expect(err).ShouldNot(HaveOccurred(), string(out))
+}
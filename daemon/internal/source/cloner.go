@@ -2,9 +2,11 @@ package source
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
 	"github.com/gritcli/grit/daemon/internal/logs"
@@ -20,17 +22,287 @@ type LocalRepo struct {
 	AbsoluteCloneDir string
 }
 
+// Status returns information about the state of the local clone.
+func (r LocalRepo) Status(
+	ctx context.Context,
+	log logs.Log,
+) (sourcedriver.LocalStatus, error) {
+	clone, err := r.Source.Driver.LocalClone(ctx, r.AbsoluteCloneDir, log)
+	if err != nil {
+		return sourcedriver.LocalStatus{}, fmt.Errorf("unable to open local clone: %w", err)
+	}
+
+	status, err := clone.Status(ctx, log)
+	if err != nil {
+		return sourcedriver.LocalStatus{}, fmt.Errorf("unable to determine local clone status: %w", err)
+	}
+
+	return status, nil
+}
+
 // A Cloner clones repositories.
 type Cloner struct {
 	Sources List
+	Index   *Index
 	Log     logs.Log
+
+	m        sync.Mutex
+	inflight map[string]*cloneOperation
+
+	stagingM sync.Mutex
+	staging  map[string]struct{}
 }
 
 // Clone clones a repository identified by source name and ID and returns the
 // directory it was cloned into.
+//
+// If the repository is already being cloned, Clone() waits for the existing
+// operation to complete instead of starting a new one, in which case opts is
+// ignored. The output of the operation is sent to the clientLog of every
+// caller that is waiting for it.
+//
+// The operation is only cancelled if the contexts of all of the callers that
+// are waiting for it are cancelled.
 func (c *Cloner) Clone(
 	ctx context.Context,
 	source, repoID string,
+	opts sourcedriver.CloneOptions,
+	clientLog logs.Log,
+) (LocalRepo, error) {
+	op, id, err := c.attach(ctx, source, repoID, opts, clientLog)
+	if err != nil {
+		return LocalRepo{}, err
+	}
+
+	select {
+	case <-op.done:
+		return op.repo, op.err
+	case <-ctx.Done():
+		c.detach(op, id)
+		return LocalRepo{}, ctx.Err()
+	}
+}
+
+// attach attaches a client to the operation that clones the given repository,
+// starting a new operation if there is not one already in progress.
+//
+// If the operation in progress has been cancelled it waits for that operation
+// to finish before starting a new one, so that two operations never use the
+// same clone directory at once.
+//
+// It returns the operation and an ID that identifies the client within it.
+func (c *Cloner) attach(
+	ctx context.Context,
+	source, repoID string,
+	opts sourcedriver.CloneOptions,
+	clientLog logs.Log,
+) (*cloneOperation, int, error) {
+	key := source + "/" + repoID
+
+	for {
+		c.m.Lock()
+		op, ok := c.inflight[key]
+
+		if ok && op.cancelled {
+			c.m.Unlock()
+
+			select {
+			case <-op.done:
+				continue
+			case <-ctx.Done():
+				return nil, 0, ctx.Err()
+			}
+		}
+
+		var intro []logs.Message
+		if ok {
+			intro = append(intro, logs.Message{
+				Text: "waiting for another clone of the same repository to complete",
+			})
+		} else {
+			op = c.start(key, source, repoID, opts)
+		}
+
+		id, replay := op.attach(clientLog, intro...)
+		c.m.Unlock()
+
+		// The messages are replayed without holding c.m, so that a slow client
+		// does not block clones of other repositories.
+		replay()
+
+		return op, id, nil
+	}
+}
+
+// start starts a new operation that clones the given repository.
+//
+// The operation remains in c.inflight until it has finished, even if it is
+// cancelled.
+//
+// c.m must be locked.
+func (c *Cloner) start(
+	key, source, repoID string,
+	opts sourcedriver.CloneOptions,
+) *cloneOperation {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	op := &cloneOperation{
+		cancel:  cancel,
+		done:    make(chan struct{}),
+		clients: map[int]*cloneClient{},
+	}
+
+	if c.inflight == nil {
+		c.inflight = map[string]*cloneOperation{}
+	}
+	c.inflight[key] = op
+
+	go func() {
+		defer cancel()
+
+		repo, err := c.clone(ctx, source, repoID, opts, op.broadcast)
+
+		c.m.Lock()
+		delete(c.inflight, key)
+		c.m.Unlock()
+
+		op.repo, op.err = repo, err
+		close(op.done)
+	}()
+
+	return op
+}
+
+// detach detaches a client from an operation, cancelling the operation if no
+// other clients are attached to it.
+func (c *Cloner) detach(op *cloneOperation, id int) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if op.detach(id) == 0 {
+		op.cancelled = true
+		op.cancel()
+	}
+}
+
+// cloneOperation is an in-flight clone that may be shared by multiple clients.
+type cloneOperation struct {
+	cancel context.CancelFunc
+
+	// cancelled is true if all of the clients have detached from the
+	// operation. It is protected by the Cloner's mutex.
+	cancelled bool
+
+	// done is closed when the operation completes, at which point repo and
+	// err are populated.
+	done chan struct{}
+	repo LocalRepo
+	err  error
+
+	m        sync.Mutex
+	nextID   int
+	clients  map[int]*cloneClient
+	history  []logs.Message
+	progress *logs.Message
+}
+
+// cloneClient is a client that is attached to a cloneOperation.
+type cloneClient struct {
+	// m serializes the messages sent to the client, so that messages that are
+	// replayed when the client attaches are sent before any new messages.
+	m   sync.Mutex
+	log logs.Log
+}
+
+// attach adds a client to the operation and returns its ID.
+//
+// The intro messages, followed by the messages that have already been logged
+// by the operation, are sent to the client's log by the returned replay
+// function, which must be called exactly once. The client does not receive any
+// new messages until it has been called.
+func (op *cloneOperation) attach(
+	clientLog logs.Log,
+	intro ...logs.Message,
+) (int, func()) {
+	op.m.Lock()
+	defer op.m.Unlock()
+
+	client := &cloneClient{log: clientLog}
+	client.m.Lock()
+
+	messages := append(intro, op.history...)
+	if op.progress != nil {
+		messages = append(messages, *op.progress)
+	}
+
+	op.nextID++
+	op.clients[op.nextID] = client
+
+	return op.nextID, func() {
+		defer client.m.Unlock()
+
+		if clientLog != nil {
+			for _, m := range messages {
+				clientLog(m)
+			}
+		}
+	}
+}
+
+// detach removes a client from the operation and returns the number of
+// clients that remain.
+func (op *cloneOperation) detach(id int) int {
+	op.m.Lock()
+	defer op.m.Unlock()
+
+	delete(op.clients, id)
+
+	return len(op.clients)
+}
+
+// broadcast is a logs.Log that sends messages to all attached clients.
+//
+// Only the most recent progress message is retained for replay, as earlier
+// progress messages are superseded by it. The messages are sent without
+// holding op.m, so that a slow client does not prevent other clients from
+// attaching or detaching.
+func (op *cloneOperation) broadcast(m logs.Message) {
+	op.m.Lock()
+
+	if m.Progress != nil {
+		op.progress = &m
+	} else {
+		op.history = append(op.history, m)
+	}
+
+	clients := make([]*cloneClient, 0, len(op.clients))
+	for _, client := range op.clients {
+		clients = append(clients, client)
+	}
+
+	op.m.Unlock()
+
+	for _, client := range clients {
+		client.send(m)
+	}
+}
+
+// send sends a message to the client.
+func (c *cloneClient) send(m logs.Message) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if c.log != nil {
+		c.log(m)
+	}
+}
+
+// clone clones a repository identified by source name and ID and returns the
+// directory it was cloned into.
+func (c *Cloner) clone(
+	ctx context.Context,
+	source, repoID string,
+	opts sourcedriver.CloneOptions,
 	clientLog logs.Log,
 ) (_ LocalRepo, err error) {
 	log := logs.Tee(
@@ -55,39 +327,246 @@ func (c *Cloner) Clone(
 		return LocalRepo{}, fmt.Errorf("unable to prepare for cloning: %w", err)
 	}
 
-	dir := filepath.Join(src.BaseCloneDir, repo.RelativeCloneDir)
+	return c.cloneRepo(ctx, src, cloner, repo, opts, log)
+}
+
+// Fork creates a fork of a repository identified by source name and ID, then
+// clones the fork and returns the directory it was cloned into.
+//
+// Unlike Clone(), concurrent calls are not coalesced into a single operation.
+func (c *Cloner) Fork(
+	ctx context.Context,
+	source, repoID string,
+	opts sourcedriver.CloneOptions,
+	clientLog logs.Log,
+) (_ LocalRepo, err error) {
+	log := logs.Tee(
+		clientLog,
+		newLog(source, c.Log).
+			WithPrefix("fork %s: ", repoID),
+	)
+
+	defer func() {
+		if err != nil {
+			log.Write("%s", err.Error())
+		}
+	}()
+
+	src, ok := c.Sources.ByName(source)
+	if !ok {
+		return LocalRepo{}, fmt.Errorf("unable to fork: unrecognized source (%s)", source)
+	}
+
+	forker, ok := src.Driver.(sourcedriver.Forker)
+	if !ok {
+		return LocalRepo{}, fmt.Errorf("the '%s' source does not support forking", source)
+	}
+
+	cloner, repo, err := forker.Fork(ctx, repoID, log)
+	if err != nil {
+		return LocalRepo{}, fmt.Errorf("unable to fork: %w", err)
+	}
+
+	log.WriteVerbose("forked as %s", repo.Name)
+
+	return c.cloneRepo(ctx, src, cloner, repo, opts, log)
+}
+
+// Create creates a new repository on a source, then clones it and returns the
+// directory it was cloned into.
+//
+// If source is empty and there is exactly one source that supports creating
+// repositories, that source is used.
+func (c *Cloner) Create(
+	ctx context.Context,
+	source, name string,
+	opts sourcedriver.CreateOptions,
+	clientLog logs.Log,
+) (_ LocalRepo, err error) {
+	src, creator, err := c.creator(source)
+	if err != nil {
+		return LocalRepo{}, err
+	}
+
+	log := logs.Tee(
+		clientLog,
+		newLog(src.Name, c.Log).
+			WithPrefix("create %s: ", name),
+	)
+
+	defer func() {
+		if err != nil {
+			log.Write("%s", err.Error())
+		}
+	}()
+
+	cloner, repo, err := creator.Create(ctx, name, opts, log)
+	if err != nil {
+		return LocalRepo{}, fmt.Errorf("unable to create repository: %w", err)
+	}
+
+	return c.cloneRepo(ctx, src, cloner, repo, sourcedriver.CloneOptions{}, log)
+}
+
+// creator returns the source with the given name and its driver's
+// sourcedriver.Creator implementation.
+//
+// If name is empty it returns the only source that supports creating
+// repositories, if there is exactly one.
+func (c *Cloner) creator(name string) (Source, sourcedriver.Creator, error) {
+	if name != "" {
+		src, ok := c.Sources.ByName(name)
+		if !ok {
+			return Source{}, nil, fmt.Errorf("unable to create repository: unrecognized source (%s)", name)
+		}
+
+		creator, ok := src.Driver.(sourcedriver.Creator)
+		if !ok {
+			return Source{}, nil, fmt.Errorf("the '%s' source does not support creating repositories", src.Name)
+		}
+
+		return src, creator, nil
+	}
+
+	var (
+		found   []Source
+		creator sourcedriver.Creator
+	)
+
+	for _, src := range c.Sources {
+		if cr, ok := src.Driver.(sourcedriver.Creator); ok {
+			found = append(found, src)
+			creator = cr
+		}
+	}
+
+	switch len(found) {
+	case 1:
+		return found[0], creator, nil
+	case 0:
+		return Source{}, nil, errors.New("none of the configured sources support creating repositories")
+	default:
+		return Source{}, nil, errors.New("multiple sources support creating repositories, specify which one to use")
+	}
+}
+
+// cloneRepo clones repo from src using the given driver cloner and returns the
+// directory it was cloned into.
+func (c *Cloner) cloneRepo(
+	ctx context.Context,
+	src Source,
+	cloner sourcedriver.Cloner,
+	repo sourcedriver.RemoteRepo,
+	opts sourcedriver.CloneOptions,
+	log logs.Log,
+) (_ LocalRepo, err error) {
+	local, err := src.LocalRepo(repo)
+	if err != nil {
+		return LocalRepo{}, fmt.Errorf("unable to determine clone directory: %w", err)
+	}
+
+	dir := local.AbsoluteCloneDir
+
+	if err := checkCloneDir(dir); err != nil {
+		// Report a more helpful error if the directory is occupied by a
+		// clone of a different repository.
+		if err := c.checkCollision(local); err != nil {
+			return LocalRepo{}, err
+		}
 
-	if err := makeCloneDir(dir); err != nil {
 		return LocalRepo{}, fmt.Errorf("unable to create clone directory: %w", err)
 	}
+
+	// Clone into a staging directory beside the final clone directory, so
+	// that an interrupted clone never leaves a partially populated directory
+	// in the place of the clone.
+	staging, err := c.makeStagingDir(dir)
+	if err != nil {
+		return LocalRepo{}, fmt.Errorf("unable to create clone directory: %w", err)
+	}
+	defer c.releaseStagingDir(staging)
 	defer func() {
 		if err != nil {
-			os.RemoveAll(dir)
+			os.RemoveAll(staging)
+			pruneEmptyDirs(filepath.Dir(staging), src.BaseCloneDir)
 		}
 	}()
 
-	if err := cloner.Clone(ctx, dir, log); err != nil {
+	if err := cloner.Clone(ctx, staging, opts, log); err != nil {
 		return LocalRepo{}, fmt.Errorf("unable to clone: %w", err)
 	}
 
-	return LocalRepo{
-		repo,
-		src,
-		filepath.Join(
-			src.BaseCloneDir,
-			repo.RelativeCloneDir,
-		),
-	}, nil
+	if err := os.Rename(staging, dir); err != nil {
+		return LocalRepo{}, fmt.Errorf("unable to move clone into place: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			os.RemoveAll(dir)
+			pruneEmptyDirs(filepath.Dir(dir), src.BaseCloneDir)
+		}
+	}()
+
+	if err := runHooks(ctx, src.PostCloneHooks, local, log); err != nil {
+		return LocalRepo{}, err
+	}
+
+	if err := c.Index.Add(local); err != nil {
+		return LocalRepo{}, fmt.Errorf("unable to record local clone: %w", err)
+	}
+
+	return local, nil
+}
+
+// checkCollision returns a CollisionError if the clone directory of r is
+// already used by a clone of a different repository.
+func (c *Cloner) checkCollision(r LocalRepo) error {
+	existing, ok, err := c.Index.ByDir(r.AbsoluteCloneDir)
+	if err != nil {
+		return err
+	}
+
+	if ok && (existing.Source.Name != r.Source.Name || existing.ID != r.ID) {
+		return CollisionError{r.AbsoluteCloneDir, existing}
+	}
+
+	return nil
+}
+
+// CollisionError is returned when a repository can not be cloned because its
+// clone directory is already used by a clone of a different repository, such
+// as when the clones layout does not distinguish between repositories with
+// the same name.
+type CollisionError struct {
+	Dir      string
+	Existing LocalRepo
+}
+
+func (e CollisionError) Error() string {
+	return fmt.Sprintf(
+		"the clone directory (%s) is already used by %s from the '%s' source, check the clones layout configuration",
+		e.Dir,
+		e.Existing.Name,
+		e.Existing.Source.Name,
+	)
+}
+
+// checkCloneDir returns an error if the given directory already exists.
+func checkCloneDir(dir string) error {
+	if _, err := os.Stat(dir); err == nil {
+		return fmt.Errorf("%s already exists", dir)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
 }
 
 // makeCloneDir makes the given directory (and all of its parents) only if it
 // does not already exist.
 func makeCloneDir(dir string) error {
-	if _, err := os.Stat(dir); err == nil {
-		return fmt.Errorf("mkdir %s: file exists", dir)
-	} else if os.IsNotExist(err) {
-		return os.MkdirAll(dir, 0700)
-	} else {
+	if err := checkCloneDir(dir); err != nil {
 		return err
 	}
+
+	return os.MkdirAll(dir, 0700)
 }
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
+	"github.com/gritcli/grit/daemon/internal/config"
 	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
 	"github.com/gritcli/grit/daemon/internal/logs"
 	. "github.com/gritcli/grit/daemon/internal/source"
@@ -22,6 +24,7 @@ var _ = Describe("type Cloner", func() {
 		sourceCloner *stubs.SourceCloner
 		driver       *stubs.Source
 		src          Source
+		index        *Index
 		cloner       *Cloner
 	)
 
@@ -57,8 +60,14 @@ var _ = Describe("type Cloner", func() {
 			Driver:       driver,
 		}
 
+		index = &Index{
+			File:    filepath.Join(tempDir, "data", "clones.json"),
+			Sources: List{src},
+		}
+
 		cloner = &Cloner{
 			Sources: List{src},
+			Index:   index,
 		}
 	})
 
@@ -68,6 +77,7 @@ var _ = Describe("type Cloner", func() {
 				context.Background(),
 				"<source>",
 				"<id>",
+				sourcedriver.CloneOptions{},
 				logs.Discard,
 			)
 			Expect(err).ShouldNot(HaveOccurred())
@@ -80,6 +90,225 @@ var _ = Describe("type Cloner", func() {
 			))
 		})
 
+		It("adds the local repo to the index", func() {
+			local, err := cloner.Clone(
+				context.Background(),
+				"<source>",
+				"<id>",
+				sourcedriver.CloneOptions{},
+				logs.Discard,
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(ConsistOf(local))
+		})
+
+		It("clones into a staging directory before moving the clone into place", func() {
+			dir := filepath.Join(tempDir, "clone-dir")
+
+			sourceCloner.CloneFunc = func(
+				_ context.Context,
+				staging string,
+				_ sourcedriver.CloneOptions,
+				_ logs.Log,
+			) error {
+				Expect(filepath.Dir(staging)).To(Equal(tempDir))
+				Expect(staging).NotTo(Equal(dir))
+				Expect(dir).NotTo(BeADirectory())
+
+				return os.WriteFile(filepath.Join(staging, "file"), nil, 0600)
+			}
+
+			_, err := cloner.Clone(
+				context.Background(),
+				"<source>",
+				"<id>",
+				sourcedriver.CloneOptions{},
+				logs.Discard,
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(filepath.Join(dir, "file")).To(BeARegularFile())
+
+			entries, err := os.ReadDir(tempDir)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var names []string
+			for _, e := range entries {
+				names = append(names, e.Name())
+			}
+			Expect(names).To(ConsistOf("clone-dir", "data"))
+		})
+
+		When("the repository is already being cloned", func() {
+			var (
+				started chan struct{}
+				release chan struct{}
+				stopped chan error
+				calls   int
+			)
+
+			// channelLog returns a log that sends message text to a channel.
+			channelLog := func() (logs.Log, chan string) {
+				ch := make(chan string, 10)
+				return func(m logs.Message) {
+					ch <- m.Text
+				}, ch
+			}
+
+			BeforeEach(func() {
+				started = make(chan struct{})
+				release = make(chan struct{})
+				stopped = make(chan error, 1)
+				calls = 0
+
+				sourceCloner.CloneFunc = func(
+					ctx context.Context,
+					_ string,
+					_ sourcedriver.CloneOptions,
+					log logs.Log,
+				) error {
+					calls++
+					log.Write("<output>")
+					close(started)
+
+					var err error
+					select {
+					case <-release:
+					case <-ctx.Done():
+						err = ctx.Err()
+					}
+
+					stopped <- err
+					return err
+				}
+			})
+
+			It("attaches to the existing operation", func() {
+				type result struct {
+					repo LocalRepo
+					err  error
+				}
+
+				first := make(chan result, 1)
+				go func() {
+					r, err := cloner.Clone(context.Background(), "<source>", "<id>", sourcedriver.CloneOptions{}, logs.Discard)
+					first <- result{r, err}
+				}()
+
+				<-started
+
+				log, output := channelLog()
+				second := make(chan result, 1)
+				go func() {
+					r, err := cloner.Clone(context.Background(), "<source>", "<id>", sourcedriver.CloneOptions{}, log)
+					second <- result{r, err}
+				}()
+
+				Eventually(output).Should(Receive(Equal("<output>")))
+				close(release)
+
+				r1 := <-first
+				r2 := <-second
+				Expect(r1.err).ShouldNot(HaveOccurred())
+				Expect(r2.err).ShouldNot(HaveOccurred())
+				Expect(r2.repo).To(Equal(r1.repo))
+				Expect(calls).To(Equal(1))
+			})
+
+			It("continues the operation if only some of the callers are cancelled", func() {
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+
+				first := make(chan error, 1)
+				go func() {
+					_, err := cloner.Clone(ctx, "<source>", "<id>", sourcedriver.CloneOptions{}, logs.Discard)
+					first <- err
+				}()
+
+				<-started
+
+				log, output := channelLog()
+				second := make(chan error, 1)
+				go func() {
+					_, err := cloner.Clone(context.Background(), "<source>", "<id>", sourcedriver.CloneOptions{}, log)
+					second <- err
+				}()
+
+				Eventually(output).Should(Receive(Equal("<output>")))
+
+				cancel()
+				Expect(<-first).To(Equal(context.Canceled))
+
+				close(release)
+				Expect(<-second).ShouldNot(HaveOccurred())
+				Expect(<-stopped).ShouldNot(HaveOccurred())
+			})
+
+			It("cancels the operation if all of the callers are cancelled", func() {
+				ctx, cancel := context.WithCancel(context.Background())
+
+				result := make(chan error, 1)
+				go func() {
+					_, err := cloner.Clone(ctx, "<source>", "<id>", sourcedriver.CloneOptions{}, logs.Discard)
+					result <- err
+				}()
+
+				<-started
+				cancel()
+				Expect(<-result).To(Equal(context.Canceled))
+
+				Eventually(stopped).Should(Receive(Equal(context.Canceled)))
+			})
+
+			It("waits for a cancelled operation to finish before starting a new one", func() {
+				sourceCloner.CloneFunc = func(
+					ctx context.Context,
+					_ string,
+					_ sourcedriver.CloneOptions,
+					_ logs.Log,
+				) error {
+					calls++
+					if calls > 1 {
+						return nil
+					}
+
+					close(started)
+					<-ctx.Done()
+
+					// Simulate a cancelled clone that takes some time to
+					// clean up after itself.
+					<-release
+					return ctx.Err()
+				}
+
+				ctx, cancel := context.WithCancel(context.Background())
+
+				first := make(chan error, 1)
+				go func() {
+					_, err := cloner.Clone(ctx, "<source>", "<id>", sourcedriver.CloneOptions{}, logs.Discard)
+					first <- err
+				}()
+
+				<-started
+				cancel()
+				Expect(<-first).To(Equal(context.Canceled))
+
+				second := make(chan error, 1)
+				go func() {
+					_, err := cloner.Clone(context.Background(), "<source>", "<id>", sourcedriver.CloneOptions{}, logs.Discard)
+					second <- err
+				}()
+
+				Consistently(second, 100*time.Millisecond).ShouldNot(Receive())
+
+				close(release)
+				Eventually(second).Should(Receive(BeNil()))
+				Expect(calls).To(Equal(2))
+			})
+		})
+
 		It("returns an error if the directory already exists", func() {
 			dir := filepath.Join(tempDir, "clone-dir")
 			err := os.Mkdir(dir, 0700)
@@ -89,11 +318,12 @@ var _ = Describe("type Cloner", func() {
 				context.Background(),
 				"<source>",
 				"<id>",
+				sourcedriver.CloneOptions{},
 				logs.Discard,
 			)
 			Expect(err).To(MatchError(
 				fmt.Sprintf(
-					"unable to create clone directory: mkdir %s: file exists",
+					"unable to create clone directory: %s already exists",
 					dir,
 				),
 			))
@@ -106,6 +336,7 @@ var _ = Describe("type Cloner", func() {
 				context.Background(),
 				"<source>",
 				"<id>",
+				sourcedriver.CloneOptions{},
 				logs.Discard,
 			)
 			Expect(err).To(MatchError(
@@ -121,6 +352,7 @@ var _ = Describe("type Cloner", func() {
 				context.Background(),
 				"<unknown>",
 				"<id>",
+				sourcedriver.CloneOptions{},
 				logs.Discard,
 			)
 			Expect(err).To(MatchError("unable to clone: unrecognized source (<unknown>)"))
@@ -139,6 +371,7 @@ var _ = Describe("type Cloner", func() {
 				context.Background(),
 				"<source>",
 				"<id>",
+				sourcedriver.CloneOptions{},
 				logs.Discard,
 			)
 			Expect(err).To(MatchError("unable to prepare for cloning: <error>"))
@@ -148,6 +381,7 @@ var _ = Describe("type Cloner", func() {
 			sourceCloner.CloneFunc = func(
 				context.Context,
 				string,
+				sourcedriver.CloneOptions,
 				logs.Log,
 			) error {
 				return errors.New("<error>")
@@ -157,6 +391,7 @@ var _ = Describe("type Cloner", func() {
 				context.Background(),
 				"<source>",
 				"<id>",
+				sourcedriver.CloneOptions{},
 				logs.Discard,
 			)
 			Expect(err).To(MatchError("unable to clone: <error>"))
@@ -166,6 +401,421 @@ var _ = Describe("type Cloner", func() {
 			)
 			Expect(err).Should(HaveOccurred())
 			Expect(os.IsNotExist(err)).To(BeTrue(), err.Error())
+
+			entries, err := os.ReadDir(tempDir)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(entries).To(BeEmpty(), "staging directory was not removed")
+		})
+
+		It("places the clone according to the source's clones layout", func() {
+			repo.LayoutFields = map[string]string{
+				"owner": "acme",
+				"name":  "api",
+			}
+			src.Layout = "{owner}-{name}"
+			cloner.Sources = List{src}
+
+			local, err := cloner.Clone(
+				context.Background(),
+				"<source>",
+				"<id>",
+				sourcedriver.CloneOptions{},
+				logs.Discard,
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(local.AbsoluteCloneDir).To(Equal(filepath.Join(tempDir, "acme-api")))
+			Expect(local.RelativeCloneDir).To(Equal("acme-api"))
+			Expect(local.LayoutFields).To(HaveKeyWithValue("dir", "clone-dir"))
+
+			Expect(local.AbsoluteCloneDir).To(BeADirectory())
+		})
+
+		It("returns a CollisionError if the clone directory is used by a different repository", func() {
+			src.Layout = "{source}"
+			cloner.Sources = List{src}
+			index.Sources = List{src}
+
+			existing := LocalRepo{
+				RemoteRepo: sourcedriver.RemoteRepo{
+					ID:               "<other>",
+					Name:             "<other repo>",
+					RelativeCloneDir: "<source>",
+				},
+				Source:           src,
+				AbsoluteCloneDir: filepath.Join(tempDir, "<source>"),
+			}
+			Expect(os.MkdirAll(existing.AbsoluteCloneDir, 0700)).To(Succeed())
+			Expect(index.Add(existing)).To(Succeed())
+
+			_, err := cloner.Clone(
+				context.Background(),
+				"<source>",
+				"<id>",
+				sourcedriver.CloneOptions{},
+				logs.Discard,
+			)
+			Expect(err).To(MatchError(CollisionError{
+				Dir:      existing.AbsoluteCloneDir,
+				Existing: existing,
+			}))
+		})
+
+		It("runs the source's post-clone hooks in the clone directory", func() {
+			src.PostCloneHooks = []config.Hook{
+				{Command: []string{"sh", "-c", `echo "$GRIT_REPO_NAME" > hook.txt`}},
+				{Command: []string{"echo", "<output>"}},
+			}
+			cloner.Sources = List{src}
+
+			var buffer logs.Buffer
+			local, err := cloner.Clone(
+				context.Background(),
+				"<source>",
+				"<id>",
+				sourcedriver.CloneOptions{},
+				buffer.Log(),
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			data, err := os.ReadFile(filepath.Join(local.AbsoluteCloneDir, "hook.txt"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(data)).To(Equal("<repo>\n"))
+
+			Expect(buffer).To(ContainElement(
+				logs.Message{
+					Text: "hook echo <output>: <output>",
+				},
+			))
+		})
+
+		It("does not fail if an optional post-clone hook fails", func() {
+			src.PostCloneHooks = []config.Hook{
+				{Command: []string{"false"}},
+			}
+			cloner.Sources = List{src}
+
+			var buffer logs.Buffer
+			_, err := cloner.Clone(
+				context.Background(),
+				"<source>",
+				"<id>",
+				sourcedriver.CloneOptions{},
+				buffer.Log(),
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buffer).To(ContainElement(
+				logs.Message{
+					Text: "hook false: exit status 1",
+				},
+			))
+		})
+
+		It("returns an error if a required post-clone hook fails", func() {
+			src.PostCloneHooks = []config.Hook{
+				{Command: []string{"false"}, Required: true},
+			}
+			cloner.Sources = List{src}
+
+			_, err := cloner.Clone(
+				context.Background(),
+				"<source>",
+				"<id>",
+				sourcedriver.CloneOptions{},
+				logs.Discard,
+			)
+			Expect(err).To(MatchError("hook failed: exit status 1 (false)"))
+
+			_, err = os.Stat(
+				filepath.Join(tempDir, "clone-dir"),
+			)
+			Expect(err).Should(HaveOccurred())
+			Expect(os.IsNotExist(err)).To(BeTrue(), err.Error())
+		})
+
+		It("removes parent directories left empty if a required post-clone hook fails", func() {
+			repo.RelativeCloneDir = filepath.Join("owner", "clone-dir")
+
+			src.PostCloneHooks = []config.Hook{
+				{Command: []string{"false"}, Required: true},
+			}
+			cloner.Sources = List{src}
+
+			_, err := cloner.Clone(
+				context.Background(),
+				"<source>",
+				"<id>",
+				sourcedriver.CloneOptions{},
+				logs.Discard,
+			)
+			Expect(err).Should(HaveOccurred())
+
+			Expect(filepath.Join(tempDir, "owner")).NotTo(BeADirectory())
+			Expect(tempDir).To(BeADirectory())
+		})
+
+		It("returns an error if the local repo can not be added to the index", func() {
+			index.File = tempDir // a directory, not a file
+
+			_, err := cloner.Clone(
+				context.Background(),
+				"<source>",
+				"<id>",
+				sourcedriver.CloneOptions{},
+				logs.Discard,
+			)
+			Expect(err).To(MatchError(
+				fmt.Sprintf(
+					"unable to record local clone: read %s: is a directory",
+					tempDir,
+				),
+			))
+
+			_, err = os.Stat(
+				filepath.Join(tempDir, "clone-dir"),
+			)
+			Expect(err).Should(HaveOccurred())
+			Expect(os.IsNotExist(err)).To(BeTrue(), err.Error())
+		})
+	})
+
+	Describe("func Fork()", func() {
+		var forker *stubs.ForkingSource
+
+		BeforeEach(func() {
+			forker = &stubs.ForkingSource{
+				ForkFunc: func(
+					context.Context,
+					string,
+					logs.Log,
+				) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error) {
+					return sourceCloner, repo, nil
+				},
+			}
+
+			src.Driver = forker
+			cloner.Sources = List{src}
+			index.Sources = List{src}
+		})
+
+		It("forks the repository and clones the fork", func() {
+			var forkedID string
+			forker.ForkFunc = func(
+				_ context.Context,
+				id string,
+				_ logs.Log,
+			) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error) {
+				forkedID = id
+				return sourceCloner, repo, nil
+			}
+
+			cloned := false
+			sourceCloner.CloneFunc = func(
+				context.Context,
+				string,
+				sourcedriver.CloneOptions,
+				logs.Log,
+			) error {
+				cloned = true
+				return nil
+			}
+
+			local, err := cloner.Fork(
+				context.Background(),
+				"<source>",
+				"<upstream-id>",
+				sourcedriver.CloneOptions{},
+				logs.Discard,
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(forkedID).To(Equal("<upstream-id>"))
+			Expect(cloned).To(BeTrue())
+			Expect(local).To(Equal(
+				LocalRepo{
+					RemoteRepo:       repo,
+					Source:           src,
+					AbsoluteCloneDir: filepath.Join(tempDir, "clone-dir"),
+				},
+			))
+
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(ConsistOf(local))
+		})
+
+		It("returns an error if the source does not support forking", func() {
+			src.Driver = driver
+			cloner.Sources = List{src}
+
+			_, err := cloner.Fork(
+				context.Background(),
+				"<source>",
+				"<upstream-id>",
+				sourcedriver.CloneOptions{},
+				logs.Discard,
+			)
+			Expect(err).To(MatchError("the '<source>' source does not support forking"))
+		})
+
+		It("returns an error if the fork can not be created", func() {
+			forker.ForkFunc = func(
+				context.Context,
+				string,
+				logs.Log,
+			) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error) {
+				return nil, sourcedriver.RemoteRepo{}, errors.New("<error>")
+			}
+
+			_, err := cloner.Fork(
+				context.Background(),
+				"<source>",
+				"<upstream-id>",
+				sourcedriver.CloneOptions{},
+				logs.Discard,
+			)
+			Expect(err).To(MatchError("unable to fork: <error>"))
+		})
+
+		It("returns an error if the source is not recognized", func() {
+			_, err := cloner.Fork(
+				context.Background(),
+				"<unknown>",
+				"<upstream-id>",
+				sourcedriver.CloneOptions{},
+				logs.Discard,
+			)
+			Expect(err).To(MatchError("unable to fork: unrecognized source (<unknown>)"))
+		})
+	})
+
+	Describe("func Create()", func() {
+		var creator *stubs.CreatingSource
+
+		BeforeEach(func() {
+			creator = &stubs.CreatingSource{
+				CreateFunc: func(
+					context.Context,
+					string,
+					sourcedriver.CreateOptions,
+					logs.Log,
+				) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error) {
+					return sourceCloner, repo, nil
+				},
+			}
+
+			src.Driver = creator
+			cloner.Sources = List{src}
+			index.Sources = List{src}
+		})
+
+		It("creates the repository and clones it", func() {
+			var (
+				createdName string
+				createdOpts sourcedriver.CreateOptions
+			)
+
+			creator.CreateFunc = func(
+				_ context.Context,
+				name string,
+				opts sourcedriver.CreateOptions,
+				_ logs.Log,
+			) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error) {
+				createdName, createdOpts = name, opts
+				return sourceCloner, repo, nil
+			}
+
+			opts := sourcedriver.CreateOptions{
+				Private:  true,
+				Template: "<template>",
+			}
+
+			local, err := cloner.Create(
+				context.Background(),
+				"<source>",
+				"<name>",
+				opts,
+				logs.Discard,
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(createdName).To(Equal("<name>"))
+			Expect(createdOpts).To(Equal(opts))
+			Expect(local).To(Equal(
+				LocalRepo{
+					RemoteRepo:       repo,
+					Source:           src,
+					AbsoluteCloneDir: filepath.Join(tempDir, "clone-dir"),
+				},
+			))
+
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(ConsistOf(local))
+		})
+
+		It("uses the only source that supports creating repositories if no source is specified", func() {
+			other := Source{
+				Name:   "<other>",
+				Driver: driver,
+			}
+			cloner.Sources = List{other, src}
+
+			local, err := cloner.Create(
+				context.Background(),
+				"",
+				"<name>",
+				sourcedriver.CreateOptions{},
+				logs.Discard,
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(local.Source.Name).To(Equal("<source>"))
+		})
+
+		It("returns an error if no source is specified and multiple sources support creating repositories", func() {
+			other := src
+			other.Name = "<other>"
+			cloner.Sources = List{other, src}
+
+			_, err := cloner.Create(
+				context.Background(),
+				"",
+				"<name>",
+				sourcedriver.CreateOptions{},
+				logs.Discard,
+			)
+			Expect(err).To(MatchError("multiple sources support creating repositories, specify which one to use"))
+		})
+
+		It("returns an error if the source does not support creating repositories", func() {
+			src.Driver = driver
+			cloner.Sources = List{src}
+
+			_, err := cloner.Create(
+				context.Background(),
+				"<source>",
+				"<name>",
+				sourcedriver.CreateOptions{},
+				logs.Discard,
+			)
+			Expect(err).To(MatchError("the '<source>' source does not support creating repositories"))
+		})
+
+		It("returns an error if the repository can not be created", func() {
+			creator.CreateFunc = func(
+				context.Context,
+				string,
+				sourcedriver.CreateOptions,
+				logs.Log,
+			) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error) {
+				return nil, sourcedriver.RemoteRepo{}, errors.New("<error>")
+			}
+
+			_, err := cloner.Create(
+				context.Background(),
+				"<source>",
+				"<name>",
+				sourcedriver.CreateOptions{},
+				logs.Discard,
+			)
+			Expect(err).To(MatchError("unable to create repository: <error>"))
 		})
 	})
 })
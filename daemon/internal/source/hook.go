@@ -0,0 +1,71 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/gritcli/grit/daemon/internal/config"
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// runHooks runs each of the given hooks, in order, within the clone directory
+// of the given repository.
+//
+// The output of each hook is written to log. It returns an error if a required
+// hook fails; failures of other hooks are only logged.
+func runHooks(
+	ctx context.Context,
+	hooks []config.Hook,
+	repo LocalRepo,
+	log logs.Log,
+) error {
+	env := append(
+		os.Environ(),
+		"GRIT_SOURCE="+repo.Source.Name,
+		"GRIT_REPO_ID="+repo.ID,
+		"GRIT_REPO_NAME="+repo.Name,
+		"GRIT_REPO_DESCRIPTION="+repo.Description,
+		"GRIT_REPO_WEB_URL="+repo.WebURL,
+		"GRIT_CLONE_DIR="+repo.AbsoluteCloneDir,
+	)
+
+	for _, h := range hooks {
+		name := strings.Join(h.Command, " ")
+		hookLog := log.WithPrefix("hook %s: ", name)
+
+		if err := runHook(ctx, h, repo.AbsoluteCloneDir, env, hookLog); err != nil {
+			if h.Required {
+				return fmt.Errorf("hook failed: %w (%s)", err, name)
+			}
+
+			hookLog.Write("%s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// runHook runs a single hook in the given directory.
+func runHook(
+	ctx context.Context,
+	h config.Hook,
+	dir string,
+	env []string,
+	log logs.Log,
+) error {
+	w := &logs.Writer{
+		Target: log,
+	}
+	defer w.Close()
+
+	cmd := exec.CommandContext(ctx, h.Command[0], h.Command[1:]...)
+	cmd.Dir = dir
+	cmd.Env = env
+	cmd.Stdout = w
+	cmd.Stderr = w
+
+	return cmd.Run()
+}
@@ -0,0 +1,313 @@
+package source
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"golang.org/x/exp/slices"
+)
+
+// Index is a persistent record of the local clones managed by Grit.
+type Index struct {
+	// File is the path to the file in which the index is stored.
+	File string
+
+	// Sources is the list of sources from which the local clones were obtained.
+	Sources List
+
+	m sync.Mutex
+}
+
+// indexEntry is the persisted representation of a LocalRepo.
+type indexEntry struct {
+	Source           string            `json:"source"`
+	ID               string            `json:"id"`
+	Name             string            `json:"name"`
+	Description      string            `json:"description,omitempty"`
+	WebURL           string            `json:"web_url,omitempty"`
+	IssuesURL        string            `json:"issues_url,omitempty"`
+	PullRequestsURL  string            `json:"pull_requests_url,omitempty"`
+	RelativeCloneDir string            `json:"relative_clone_dir"`
+	AbsoluteCloneDir string            `json:"absolute_clone_dir"`
+	LayoutFields     map[string]string `json:"layout_fields,omitempty"`
+}
+
+// Add adds a local clone to the index.
+//
+// Any existing entry for the same clone directory is replaced.
+func (x *Index) Add(r LocalRepo) error {
+	x.m.Lock()
+	defer x.m.Unlock()
+
+	entries, err := x.load()
+	if err != nil {
+		return err
+	}
+
+	entries = removeIndexEntry(entries, r.AbsoluteCloneDir)
+	entries = append(entries, newIndexEntry(r))
+
+	return x.save(entries)
+}
+
+// Remove removes the local clone in the given directory from the index.
+//
+// It does nothing if there is no such clone in the index.
+func (x *Index) Remove(dir string) error {
+	x.m.Lock()
+	defer x.m.Unlock()
+
+	entries, err := x.load()
+	if err != nil {
+		return err
+	}
+
+	return x.save(
+		removeIndexEntry(entries, dir),
+	)
+}
+
+// List returns the local clones in the index, sorted by source and name.
+//
+// Clones that belong to sources that are not in x.Sources, or that no longer
+// exist on disk are excluded.
+func (x *Index) List() ([]LocalRepo, error) {
+	all, err := x.all()
+	if err != nil {
+		return nil, err
+	}
+
+	var repos []LocalRepo
+
+	for _, r := range all {
+		if _, err := os.Stat(r.AbsoluteCloneDir); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		repos = append(repos, r)
+	}
+
+	return repos, nil
+}
+
+// ByDir returns the local clone in the given directory.
+//
+// ok is false if there is no clone in the index with that directory.
+func (x *Index) ByDir(dir string) (_ LocalRepo, ok bool, _ error) {
+	all, err := x.all()
+	if err != nil {
+		return LocalRepo{}, false, err
+	}
+
+	for _, r := range all {
+		if r.AbsoluteCloneDir == dir {
+			return r, true, nil
+		}
+	}
+
+	return LocalRepo{}, false, nil
+}
+
+// Move updates the index entry for the clone in oldDir to describe r.
+//
+// It is used when a local clone is moved to a different directory.
+func (x *Index) Move(oldDir string, r LocalRepo) error {
+	x.m.Lock()
+	defer x.m.Unlock()
+
+	entries, err := x.load()
+	if err != nil {
+		return err
+	}
+
+	entries = removeIndexEntry(entries, oldDir)
+	entries = removeIndexEntry(entries, r.AbsoluteCloneDir)
+	entries = append(entries, newIndexEntry(r))
+
+	return x.save(entries)
+}
+
+// all returns the local clones in the index, sorted by source and name,
+// regardless of whether they still exist on disk.
+//
+// Clones that belong to sources that are not in x.Sources are excluded.
+func (x *Index) all() ([]LocalRepo, error) {
+	x.m.Lock()
+	entries, err := x.load()
+	x.m.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var repos []LocalRepo
+
+	for _, e := range entries {
+		src, ok := x.Sources.ByName(e.Source)
+		if !ok {
+			continue
+		}
+
+		repos = append(repos, LocalRepo{
+			RemoteRepo: sourcedriver.RemoteRepo{
+				ID:               e.ID,
+				Name:             e.Name,
+				Description:      e.Description,
+				WebURL:           e.WebURL,
+				IssuesURL:        e.IssuesURL,
+				PullRequestsURL:  e.PullRequestsURL,
+				RelativeCloneDir: e.RelativeCloneDir,
+				LayoutFields:     e.LayoutFields,
+			},
+			Source:           src,
+			AbsoluteCloneDir: e.AbsoluteCloneDir,
+		})
+	}
+
+	slices.SortFunc(
+		repos,
+		func(a, b LocalRepo) bool {
+			if a.Source.Name != b.Source.Name {
+				return a.Source.Name < b.Source.Name
+			}
+			return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+		},
+	)
+
+	return repos, nil
+}
+
+// Resolve returns the local clones that match the given query.
+//
+// If the query is an absolute path, it matches the clone that contains that
+// path. Otherwise, it matches clones with a name, last name component or ID
+// that is equal to the query, ignoring case.
+func (x *Index) Resolve(query string) ([]LocalRepo, error) {
+	repos, err := x.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []LocalRepo
+
+	for _, r := range repos {
+		if filepath.IsAbs(query) {
+			if isWithinDir(query, r.AbsoluteCloneDir) {
+				matches = append(matches, r)
+			}
+		} else if matchesQuery(query, r.RemoteRepo) {
+			matches = append(matches, r)
+		}
+	}
+
+	return matches, nil
+}
+
+// matchesQuery returns true if the name, last name component or ID of a
+// repository is equal to the query, ignoring case.
+func matchesQuery(query string, r sourcedriver.RemoteRepo) bool {
+	return strings.EqualFold(query, r.Name) ||
+		strings.EqualFold(query, path.Base(r.Name)) ||
+		strings.EqualFold(query, r.ID)
+}
+
+// load reads the index entries from the index file.
+//
+// A non-existent index file is equivalent to an empty index.
+func (x *Index) load() ([]indexEntry, error) {
+	data, err := os.ReadFile(x.File)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var entries []indexEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// save writes the index entries to the index file.
+//
+// The entries are written to a temporary file which then replaces the index
+// file, such that the index file is never left partially written.
+func (x *Index) save(entries []indexEntry) error {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(x.File)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	fp, err := os.CreateTemp(dir, filepath.Base(x.File)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(fp.Name())
+	defer fp.Close()
+
+	if _, err := fp.Write(data); err != nil {
+		return err
+	}
+
+	if err := fp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(fp.Name(), x.File)
+}
+
+// newIndexEntry returns the index entry that describes r.
+func newIndexEntry(r LocalRepo) indexEntry {
+	return indexEntry{
+		Source:           r.Source.Name,
+		ID:               r.ID,
+		Name:             r.Name,
+		Description:      r.Description,
+		WebURL:           r.WebURL,
+		IssuesURL:        r.IssuesURL,
+		PullRequestsURL:  r.PullRequestsURL,
+		RelativeCloneDir: r.RelativeCloneDir,
+		AbsoluteCloneDir: r.AbsoluteCloneDir,
+		LayoutFields:     r.LayoutFields,
+	}
+}
+
+// removeIndexEntry returns entries with the entry for the given clone
+// directory removed.
+func removeIndexEntry(entries []indexEntry, dir string) []indexEntry {
+	var result []indexEntry
+
+	for _, e := range entries {
+		if e.AbsoluteCloneDir != dir {
+			result = append(result, e)
+		}
+	}
+
+	return result
+}
+
+// isWithinDir returns true if p is dir, or a path within dir.
+func isWithinDir(p, dir string) bool {
+	rel, err := filepath.Rel(dir, filepath.Clean(p))
+	if err != nil {
+		return false
+	}
+
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
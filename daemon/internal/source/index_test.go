@@ -0,0 +1,235 @@
+package source_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	. "github.com/gritcli/grit/daemon/internal/source"
+	"github.com/gritcli/grit/daemon/internal/stubs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Index", func() {
+	var (
+		tempDir    string
+		src1, src2 Source
+		index      *Index
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = os.MkdirTemp("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(func() {
+			os.RemoveAll(tempDir)
+		})
+
+		src1 = Source{
+			Name:         "<source-1>",
+			BaseCloneDir: filepath.Join(tempDir, "source-1"),
+			Driver:       &stubs.Source{},
+		}
+
+		src2 = Source{
+			Name:         "<source-2>",
+			BaseCloneDir: filepath.Join(tempDir, "source-2"),
+			Driver:       &stubs.Source{},
+		}
+
+		index = &Index{
+			File:    filepath.Join(tempDir, "data", "clones.json"),
+			Sources: List{src1, src2},
+		}
+	})
+
+	// makeLocalRepo returns a LocalRepo for a clone within the given source,
+	// creating its clone directory.
+	makeLocalRepo := func(src Source, name string) LocalRepo {
+		r := LocalRepo{
+			RemoteRepo: sourcedriver.RemoteRepo{
+				ID:               "<id-" + name + ">",
+				Name:             name,
+				Description:      "<description>",
+				WebURL:           "<url>",
+				IssuesURL:        "<issues-url>",
+				PullRequestsURL:  "<pulls-url>",
+				RelativeCloneDir: name,
+			},
+			Source:           src,
+			AbsoluteCloneDir: filepath.Join(src.BaseCloneDir, name),
+		}
+
+		err := os.MkdirAll(r.AbsoluteCloneDir, 0700)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		return r
+	}
+
+	Describe("func List()", func() {
+		It("returns an empty list if the index file does not exist", func() {
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(BeEmpty())
+		})
+
+		It("returns the repos sorted by source and name", func() {
+			a := makeLocalRepo(src2, "a")
+			b := makeLocalRepo(src1, "b")
+			c := makeLocalRepo(src1, "C")
+
+			Expect(index.Add(a)).To(Succeed())
+			Expect(index.Add(c)).To(Succeed())
+			Expect(index.Add(b)).To(Succeed())
+
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(Equal([]LocalRepo{b, c, a}))
+		})
+
+		It("excludes repos that no longer exist on disk", func() {
+			a := makeLocalRepo(src1, "a")
+			b := makeLocalRepo(src1, "b")
+
+			Expect(index.Add(a)).To(Succeed())
+			Expect(index.Add(b)).To(Succeed())
+
+			err := os.RemoveAll(a.AbsoluteCloneDir)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(Equal([]LocalRepo{b}))
+		})
+
+		It("excludes repos from unrecognized sources", func() {
+			a := makeLocalRepo(src1, "a")
+			b := makeLocalRepo(src2, "b")
+
+			Expect(index.Add(a)).To(Succeed())
+			Expect(index.Add(b)).To(Succeed())
+
+			index.Sources = List{src2}
+
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(Equal([]LocalRepo{b}))
+		})
+
+		It("returns an error if the index file is malformed", func() {
+			err := os.MkdirAll(filepath.Dir(index.File), 0700)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = os.WriteFile(index.File, []byte("<malformed>"), 0600)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = index.List()
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Describe("func Add()", func() {
+		It("replaces any existing entry for the same directory", func() {
+			a := makeLocalRepo(src1, "a")
+			Expect(index.Add(a)).To(Succeed())
+
+			a.Description = "<updated>"
+			Expect(index.Add(a)).To(Succeed())
+
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(Equal([]LocalRepo{a}))
+		})
+	})
+
+	Describe("func Remove()", func() {
+		It("removes the entry for the given directory", func() {
+			a := makeLocalRepo(src1, "a")
+			b := makeLocalRepo(src1, "b")
+
+			Expect(index.Add(a)).To(Succeed())
+			Expect(index.Add(b)).To(Succeed())
+			Expect(index.Remove(a.AbsoluteCloneDir)).To(Succeed())
+
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(Equal([]LocalRepo{b}))
+		})
+
+		It("does nothing if the directory is not in the index", func() {
+			a := makeLocalRepo(src1, "a")
+			Expect(index.Add(a)).To(Succeed())
+			Expect(index.Remove("/path/to/nowhere")).To(Succeed())
+
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(Equal([]LocalRepo{a}))
+		})
+	})
+
+	Describe("func Move()", func() {
+		It("replaces the entry for the old directory", func() {
+			a := makeLocalRepo(src1, "a")
+			b := makeLocalRepo(src1, "b")
+
+			Expect(index.Add(a)).To(Succeed())
+			Expect(index.Add(b)).To(Succeed())
+
+			moved := makeLocalRepo(src2, "a")
+			Expect(index.Move(a.AbsoluteCloneDir, moved)).To(Succeed())
+
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(Equal([]LocalRepo{b, moved}))
+		})
+	})
+
+	Describe("func Resolve()", func() {
+		var a, b, c LocalRepo
+
+		BeforeEach(func() {
+			a = makeLocalRepo(src1, "owner/a")
+			b = makeLocalRepo(src1, "other/a")
+			c = makeLocalRepo(src2, "owner/c")
+
+			Expect(index.Add(a)).To(Succeed())
+			Expect(index.Add(b)).To(Succeed())
+			Expect(index.Add(c)).To(Succeed())
+		})
+
+		It("matches the full name, ignoring case", func() {
+			repos, err := index.Resolve("OWNER/A")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(Equal([]LocalRepo{a}))
+		})
+
+		It("matches the last name component", func() {
+			repos, err := index.Resolve("a")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(Equal([]LocalRepo{b, a}))
+		})
+
+		It("matches the ID", func() {
+			repos, err := index.Resolve(c.ID)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(Equal([]LocalRepo{c}))
+		})
+
+		It("matches the clone that contains an absolute path", func() {
+			repos, err := index.Resolve(c.AbsoluteCloneDir)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(Equal([]LocalRepo{c}))
+
+			repos, err = index.Resolve(filepath.Join(c.AbsoluteCloneDir, "subdir"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(Equal([]LocalRepo{c}))
+		})
+
+		It("does not match paths outside of a clone", func() {
+			repos, err := index.Resolve(src1.BaseCloneDir)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(BeEmpty())
+		})
+	})
+})
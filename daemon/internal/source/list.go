@@ -31,11 +31,15 @@ func NewList(baseURL *url.URL, sources []config.Source) List {
 		list = append(
 			list,
 			Source{
-				Name:         cfg.Name,
-				Description:  cfg.Driver.DescribeSourceConfig(),
-				BaseCloneDir: cfg.Clones.Dir,
-				BaseURL:      u,
-				Driver:       cfg.Driver.NewSource(),
+				Name:           cfg.Name,
+				Description:    cfg.Driver.DescribeSourceConfig(),
+				BaseCloneDir:   cfg.Clones.Dir,
+				Layout:         cfg.Clones.Layout,
+				BaseArchiveDir: cfg.Clones.ArchiveDir,
+				ArchiveAfter:   cfg.Clones.ArchiveAfter,
+				PostCloneHooks: cfg.Hooks.PostClone,
+				BaseURL:        u,
+				Driver:         cfg.Driver.NewSource(),
 			},
 		)
 	}
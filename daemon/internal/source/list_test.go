@@ -3,6 +3,7 @@ package source_test
 import (
 	"context"
 	"net/url"
+	"time"
 
 	"github.com/gritcli/grit/daemon/internal/config"
 	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
@@ -43,7 +44,9 @@ var _ = Describe("type List", func() {
 						Name:    "<source-a>",
 						Enabled: true,
 						Clones: config.Clones{
-							Dir: "/path/to/clones-a",
+							Dir:          "/path/to/clones-a",
+							ArchiveDir:   "/path/to/archive-a",
+							ArchiveAfter: 24 * time.Hour,
 						},
 						Driver: &stubs.SourceConfig{
 							NewSourceFunc: func() sourcedriver.Source {
@@ -55,7 +58,9 @@ var _ = Describe("type List", func() {
 						Name:    "<source-b>",
 						Enabled: true,
 						Clones: config.Clones{
-							Dir: "/path/to/clones-b",
+							Dir:          "/path/to/clones-b",
+							ArchiveDir:   "/path/to/archive-b",
+							ArchiveAfter: 24 * time.Hour,
 						},
 						Driver: &stubs.SourceConfig{
 							NewSourceFunc: func() sourcedriver.Source {
@@ -68,9 +73,11 @@ var _ = Describe("type List", func() {
 
 			Expect(list).To(ConsistOf(
 				Source{
-					Name:         "<source-a>",
-					Description:  "<description>",
-					BaseCloneDir: "/path/to/clones-a",
+					Name:           "<source-a>",
+					Description:    "<description>",
+					BaseCloneDir:   "/path/to/clones-a",
+					BaseArchiveDir: "/path/to/archive-a",
+					ArchiveAfter:   24 * time.Hour,
 					BaseURL: &url.URL{
 						Scheme: "http",
 						Host:   "localhost:8080",
@@ -79,9 +86,11 @@ var _ = Describe("type List", func() {
 					Driver: srcA,
 				},
 				Source{
-					Name:         "<source-b>",
-					Description:  "<description>",
-					BaseCloneDir: "/path/to/clones-b",
+					Name:           "<source-b>",
+					Description:    "<description>",
+					BaseCloneDir:   "/path/to/clones-b",
+					BaseArchiveDir: "/path/to/archive-b",
+					ArchiveAfter:   24 * time.Hour,
 					BaseURL: &url.URL{
 						Scheme: "http",
 						Host:   "localhost:8080",
@@ -0,0 +1,138 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// A PullRequestManager checks out the pull requests that are made against
+// remote repositories.
+type PullRequestManager struct {
+	Sources List
+	Index   *Index
+	Cloner  *Cloner
+	Log     logs.Log
+}
+
+// Checkout resolves a reference to a pull request, then checks the pull
+// request out in a local branch of the local clone of its repository. The
+// repository is cloned if there is no existing local clone.
+//
+// If source is non-empty, only pull requests from that source are considered.
+//
+// It returns the local clone and the name of the branch.
+func (m *PullRequestManager) Checkout(
+	ctx context.Context,
+	query, source string,
+	clientLog logs.Log,
+) (_ LocalRepo, branch string, err error) {
+	src, driver, pr, err := m.resolve(ctx, query, source, clientLog)
+	if err != nil {
+		return LocalRepo{}, "", err
+	}
+
+	log := logs.Tee(
+		clientLog,
+		src.
+			Log(m.Log).
+			WithPrefix("pull request %s: ", query),
+	)
+
+	defer func() {
+		if err != nil {
+			log.Write("%s", err.Error())
+		}
+	}()
+
+	repo, ok, err := m.localRepo(src, pr.Repo.ID)
+	if err != nil {
+		return LocalRepo{}, "", err
+	}
+
+	if !ok {
+		repo, err = m.Cloner.Clone(
+			ctx,
+			src.Name,
+			pr.Repo.ID,
+			sourcedriver.CloneOptions{},
+			clientLog,
+		)
+		if err != nil {
+			return LocalRepo{}, "", err
+		}
+	}
+
+	branch, err = driver.CheckoutPullRequest(ctx, repo.AbsoluteCloneDir, pr, log)
+	if err != nil {
+		return LocalRepo{}, "", fmt.Errorf("unable to check out pull request: %w", err)
+	}
+
+	log.Write("checked out '%s' in the '%s' branch", pr.Title, branch)
+
+	return repo, branch, nil
+}
+
+// resolve resolves a reference to a pull request to a single pull request.
+func (m *PullRequestManager) resolve(
+	ctx context.Context,
+	query, source string,
+	clientLog logs.Log,
+) (Source, sourcedriver.PullRequestSource, sourcedriver.PullRequest, error) {
+	var (
+		matchedSource Source
+		matchedDriver sourcedriver.PullRequestSource
+		matchedPR     sourcedriver.PullRequest
+		matches       int
+	)
+
+	for _, src := range m.Sources {
+		if source != "" && !strings.EqualFold(src.Name, source) {
+			continue
+		}
+
+		driver, ok := src.Driver.(sourcedriver.PullRequestSource)
+		if !ok {
+			continue
+		}
+
+		pr, ok, err := driver.ResolvePullRequest(ctx, query, src.Log(clientLog))
+		if err != nil {
+			return Source{}, nil, sourcedriver.PullRequest{}, err
+		}
+
+		if ok {
+			matchedSource, matchedDriver, matchedPR = src, driver, pr
+			matches++
+		}
+	}
+
+	switch matches {
+	case 1:
+		return matchedSource, matchedDriver, matchedPR, nil
+	case 0:
+		return Source{}, nil, sourcedriver.PullRequest{}, fmt.Errorf("'%s' does not refer to a pull request", query)
+	default:
+		return Source{}, nil, sourcedriver.PullRequest{}, fmt.Errorf("'%s' refers to pull requests from multiple sources, specify which source to use", query)
+	}
+}
+
+// localRepo returns the local clone of the repository with the given ID from
+// src, if there is one.
+func (m *PullRequestManager) localRepo(src Source, id string) (LocalRepo, bool, error) {
+	repos, err := m.Index.List()
+	if err != nil {
+		return LocalRepo{}, false, err
+	}
+
+	for _, r := range repos {
+		if r.Source.Name == src.Name && r.ID == id {
+			return r, true, nil
+		}
+	}
+
+	return LocalRepo{}, false, nil
+}
@@ -0,0 +1,226 @@
+package source_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	. "github.com/gritcli/grit/daemon/internal/source"
+	"github.com/gritcli/grit/daemon/internal/stubs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type PullRequestManager", func() {
+	var (
+		tempDir string
+		repo    sourcedriver.RemoteRepo
+		pr      sourcedriver.PullRequest
+		driver  *stubs.PullRequestSource
+		src     Source
+		index   *Index
+		manager *PullRequestManager
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = os.MkdirTemp("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(func() {
+			os.RemoveAll(tempDir)
+		})
+
+		repo = sourcedriver.RemoteRepo{
+			ID:               "<id>",
+			Name:             "<repo>",
+			RelativeCloneDir: "clone-dir",
+		}
+
+		pr = sourcedriver.PullRequest{
+			Repo:  repo,
+			ID:    "123",
+			Title: "<title>",
+		}
+
+		driver = &stubs.PullRequestSource{
+			Source: stubs.Source{
+				ClonerFunc: func(
+					context.Context,
+					string,
+					logs.Log,
+				) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error) {
+					return &stubs.SourceCloner{}, repo, nil
+				},
+			},
+			ResolvePullRequestFunc: func(
+				_ context.Context,
+				query string,
+				_ logs.Log,
+			) (sourcedriver.PullRequest, bool, error) {
+				return pr, query == "<query>", nil
+			},
+			CheckoutPullRequestFunc: func(
+				context.Context,
+				string,
+				sourcedriver.PullRequest,
+				logs.Log,
+			) (string, error) {
+				return "pr/123", nil
+			},
+		}
+
+		src = Source{
+			Name:         "<source>",
+			BaseCloneDir: tempDir,
+			Driver:       driver,
+		}
+
+		index = &Index{
+			File:    filepath.Join(tempDir, "data", "clones.json"),
+			Sources: List{src},
+		}
+
+		manager = &PullRequestManager{
+			Sources: List{src},
+			Index:   index,
+			Cloner: &Cloner{
+				Sources: List{src},
+				Index:   index,
+			},
+		}
+	})
+
+	Describe("func Checkout()", func() {
+		It("clones the repository and checks out the pull request", func() {
+			var (
+				checkoutDir string
+				checkoutPR  sourcedriver.PullRequest
+			)
+
+			driver.CheckoutPullRequestFunc = func(
+				_ context.Context,
+				dir string,
+				p sourcedriver.PullRequest,
+				_ logs.Log,
+			) (string, error) {
+				checkoutDir, checkoutPR = dir, p
+				return "pr/123", nil
+			}
+
+			local, branch, err := manager.Checkout(
+				context.Background(),
+				"<query>",
+				"",
+				logs.Discard,
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(branch).To(Equal("pr/123"))
+			Expect(local).To(Equal(
+				LocalRepo{
+					RemoteRepo:       repo,
+					Source:           src,
+					AbsoluteCloneDir: filepath.Join(tempDir, "clone-dir"),
+				},
+			))
+			Expect(checkoutDir).To(Equal(local.AbsoluteCloneDir))
+			Expect(checkoutPR).To(Equal(pr))
+
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(ConsistOf(local))
+		})
+
+		It("uses an existing local clone", func() {
+			existing := LocalRepo{
+				RemoteRepo:       repo,
+				Source:           src,
+				AbsoluteCloneDir: filepath.Join(tempDir, "existing"),
+			}
+
+			err := os.MkdirAll(existing.AbsoluteCloneDir, 0700)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = index.Add(existing)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			driver.ClonerFunc = func(
+				context.Context,
+				string,
+				logs.Log,
+			) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error) {
+				Fail("unexpected call")
+				return nil, sourcedriver.RemoteRepo{}, nil
+			}
+
+			local, _, err := manager.Checkout(
+				context.Background(),
+				"<query>",
+				"",
+				logs.Discard,
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(local.AbsoluteCloneDir).To(Equal(existing.AbsoluteCloneDir))
+		})
+
+		It("returns an error if the query does not refer to a pull request", func() {
+			_, _, err := manager.Checkout(
+				context.Background(),
+				"<other>",
+				"",
+				logs.Discard,
+			)
+			Expect(err).To(MatchError("'<other>' does not refer to a pull request"))
+		})
+
+		It("returns an error if the query refers to pull requests from multiple sources", func() {
+			other := src
+			other.Name = "<other>"
+			manager.Sources = List{src, other}
+
+			_, _, err := manager.Checkout(
+				context.Background(),
+				"<query>",
+				"",
+				logs.Discard,
+			)
+			Expect(err).To(MatchError("'<query>' refers to pull requests from multiple sources, specify which source to use"))
+		})
+
+		It("only considers the given source", func() {
+			other := src
+			other.Name = "<other>"
+			manager.Sources = List{src, other}
+
+			local, _, err := manager.Checkout(
+				context.Background(),
+				"<query>",
+				"<source>",
+				logs.Discard,
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(local.Source.Name).To(Equal("<source>"))
+		})
+
+		It("returns an error if the pull request can not be checked out", func() {
+			driver.CheckoutPullRequestFunc = func(
+				context.Context,
+				string,
+				sourcedriver.PullRequest,
+				logs.Log,
+			) (string, error) {
+				return "", errors.New("<error>")
+			}
+
+			_, _, err := manager.Checkout(
+				context.Background(),
+				"<query>",
+				"",
+				logs.Discard,
+			)
+			Expect(err).To(MatchError("unable to check out pull request: <error>"))
+		})
+	})
+})
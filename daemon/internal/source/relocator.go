@@ -0,0 +1,185 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// A Relocator moves local clones to the locations dictated by the current
+// configuration, such as after a source's clone directory has changed.
+type Relocator struct {
+	Index *Index
+	Log   logs.Log
+}
+
+// Relocation describes a local clone that is not in the location dictated by
+// the current configuration.
+type Relocation struct {
+	// LocalRepo describes the clone at its new location.
+	LocalRepo
+
+	// PreviousCloneDir is the directory that currently contains the clone.
+	PreviousCloneDir string
+
+	// PreviousRelativeCloneDir is the path of PreviousCloneDir relative to the
+	// clone directory of the source at the time it was cloned.
+	PreviousRelativeCloneDir string
+}
+
+// ConflictError is returned when a local clone can not be relocated because
+// its new location is already occupied.
+type ConflictError struct {
+	Dir string
+}
+
+func (e ConflictError) Error() string {
+	return fmt.Sprintf("%s already exists", e.Dir)
+}
+
+// Relocations returns the local clones in the index that are not in the
+// location dictated by the current configuration.
+func (r *Relocator) Relocations() ([]Relocation, error) {
+	repos, err := r.Index.all()
+	if err != nil {
+		return nil, err
+	}
+
+	var relocations []Relocation
+
+	for _, repo := range repos {
+		prev := repo.AbsoluteCloneDir
+		prevRel := repo.RelativeCloneDir
+
+		rel, err := repo.Source.relativeCloneDir(repo.RemoteRepo)
+		if err != nil {
+			// The index may not contain the fields required by the layout,
+			// such as for clones that were recorded before the layout was
+			// introduced, in which case the clone is left where it is.
+			repo.Source.Log(r.Log).Write(
+				"unable to determine clone directory for %s: %s",
+				repo.Name,
+				err,
+			)
+			continue
+		}
+		repo.RelativeCloneDir = rel
+
+		dir := filepath.Join(repo.Source.BaseCloneDir, repo.RelativeCloneDir)
+		if dir == prev {
+			continue
+		}
+
+		repo.AbsoluteCloneDir = dir
+
+		relocations = append(relocations, Relocation{repo, prev, prevRel})
+	}
+
+	return relocations, nil
+}
+
+// Relocate moves a local clone to its new location.
+//
+// The clone is moved by renaming its directory, and hence is never left
+// partially moved. It returns a ConflictError if there is already a file or
+// directory at the new location, or a WorktreesExistError if the clone has
+// additional working trees. Any parent directories of the previous location
+// that are left empty are removed.
+func (r *Relocator) Relocate(
+	ctx context.Context,
+	rel Relocation,
+	clientLog logs.Log,
+) (err error) {
+	log := logs.Tee(
+		clientLog,
+		rel.Source.
+			Log(r.Log).
+			WithPrefix("relocate %s: ", rel.Name),
+	)
+
+	defer func() {
+		if err != nil {
+			log.Write("%s", err.Error())
+		}
+	}()
+
+	if _, err := os.Lstat(rel.PreviousCloneDir); err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+
+		// The clone may have already been moved by hand, in which case we
+		// only need to update the index.
+		if _, err := os.Lstat(rel.AbsoluteCloneDir); err != nil {
+			return fmt.Errorf("%s no longer exists", rel.PreviousCloneDir)
+		}
+
+		log.WriteVerbose("the clone has already been moved to %s", rel.AbsoluteCloneDir)
+	} else {
+		// Moving the clone would break the links between the clone and its
+		// additional working trees.
+		prev := rel.LocalRepo
+		prev.AbsoluteCloneDir = rel.PreviousCloneDir
+
+		if _, worktrees, err := linkedWorktrees(ctx, prev, log); err != nil {
+			return err
+		} else if len(worktrees) != 0 {
+			return WorktreesExistError{worktrees}
+		}
+
+		if err := r.move(rel); err != nil {
+			return err
+		}
+	}
+
+	if err := r.Index.Move(rel.PreviousCloneDir, rel.LocalRepo); err != nil {
+		return fmt.Errorf("unable to record relocation of local clone: %w", err)
+	}
+
+	// Remove any directories left empty at the previous location, up to the
+	// previous base clone directory.
+	if base, ok := strings.CutSuffix(
+		rel.PreviousCloneDir,
+		string(filepath.Separator)+rel.PreviousRelativeCloneDir,
+	); ok {
+		if err := pruneEmptyDirs(
+			filepath.Dir(rel.PreviousCloneDir),
+			base,
+		); err != nil {
+			log.Write("unable to remove empty parent directories: %s", err)
+		}
+	}
+
+	log.WriteVerbose("moved %s to %s", rel.PreviousCloneDir, rel.AbsoluteCloneDir)
+
+	return nil
+}
+
+// move renames the clone directory to its new location.
+func (r *Relocator) move(rel Relocation) (err error) {
+	if _, err := os.Lstat(rel.AbsoluteCloneDir); err == nil {
+		return ConflictError{rel.AbsoluteCloneDir}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	parent := filepath.Dir(rel.AbsoluteCloneDir)
+	if err := os.MkdirAll(parent, 0700); err != nil {
+		return fmt.Errorf("unable to create clone directory: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			pruneEmptyDirs(parent, rel.Source.BaseCloneDir)
+		}
+	}()
+
+	if err := os.Rename(rel.PreviousCloneDir, rel.AbsoluteCloneDir); err != nil {
+		return fmt.Errorf("unable to move clone: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package source_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	. "github.com/gritcli/grit/daemon/internal/source"
+	"github.com/gritcli/grit/daemon/internal/stubs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Relocator", func() {
+	var (
+		tempDir   string
+		src       Source
+		repo      LocalRepo
+		index     *Index
+		relocator *Relocator
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = os.MkdirTemp("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(func() {
+			os.RemoveAll(tempDir)
+		})
+
+		src = Source{
+			Name:         "<source>",
+			BaseCloneDir: filepath.Join(tempDir, "old"),
+			Driver:       &stubs.Source{},
+		}
+
+		repo = LocalRepo{
+			RemoteRepo: sourcedriver.RemoteRepo{
+				ID:               "<id>",
+				Name:             "owner/repo",
+				RelativeCloneDir: filepath.Join("owner", "repo"),
+			},
+			Source:           src,
+			AbsoluteCloneDir: filepath.Join(src.BaseCloneDir, "owner", "repo"),
+		}
+
+		err = os.MkdirAll(repo.AbsoluteCloneDir, 0700)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = os.WriteFile(filepath.Join(repo.AbsoluteCloneDir, "README.md"), nil, 0600)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// Record the clone in the index, then simulate a configuration change
+		// by using a different base clone directory.
+		Expect((&Index{
+			File:    filepath.Join(tempDir, "clones.json"),
+			Sources: List{src},
+		}).Add(repo)).To(Succeed())
+
+		src.BaseCloneDir = filepath.Join(tempDir, "new")
+
+		index = &Index{
+			File:    filepath.Join(tempDir, "clones.json"),
+			Sources: List{src},
+		}
+
+		relocator = &Relocator{
+			Index: index,
+		}
+	})
+
+	// expectedRelocation returns the relocation for repo.
+	expectedRelocation := func() Relocation {
+		return Relocation{
+			LocalRepo: LocalRepo{
+				RemoteRepo:       repo.RemoteRepo,
+				Source:           src,
+				AbsoluteCloneDir: filepath.Join(src.BaseCloneDir, "owner", "repo"),
+			},
+			PreviousCloneDir:         repo.AbsoluteCloneDir,
+			PreviousRelativeCloneDir: repo.RelativeCloneDir,
+		}
+	}
+
+	Describe("func Relocations()", func() {
+		It("returns clones that are not in their configured location", func() {
+			relocations, err := relocator.Relocations()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(relocations).To(Equal([]Relocation{expectedRelocation()}))
+		})
+
+		It("returns clones that are not in the location dictated by the clones layout", func() {
+			index.Sources[0].BaseCloneDir = filepath.Join(tempDir, "old")
+			index.Sources[0].Layout = "{source}/{dir}"
+
+			relocations, err := relocator.Relocations()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(relocations).To(HaveLen(1))
+			Expect(relocations[0].AbsoluteCloneDir).To(Equal(
+				filepath.Join(tempDir, "old", "<source>", "owner", "repo"),
+			))
+			Expect(relocations[0].RelativeCloneDir).To(Equal(
+				filepath.Join("<source>", "owner", "repo"),
+			))
+		})
+
+		It("does not return clones that lack the fields used by the clones layout", func() {
+			index.Sources[0].Layout = "{domain}/{dir}"
+
+			relocations, err := relocator.Relocations()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(relocations).To(BeEmpty())
+		})
+
+		It("does not return clones that are in their configured location", func() {
+			index.Sources[0].BaseCloneDir = filepath.Join(tempDir, "old")
+
+			relocations, err := relocator.Relocations()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(relocations).To(BeEmpty())
+		})
+	})
+
+	Describe("func Relocate()", func() {
+		It("moves the clone and updates the index", func() {
+			rel := expectedRelocation()
+
+			err := relocator.Relocate(context.Background(), rel, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = os.Stat(filepath.Join(rel.AbsoluteCloneDir, "README.md"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(Equal([]LocalRepo{rel.LocalRepo}))
+		})
+
+		It("removes empty parent directories of the previous location", func() {
+			err := relocator.Relocate(context.Background(), expectedRelocation(), logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = os.Stat(filepath.Join(tempDir, "old", "owner"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+
+			_, err = os.Stat(filepath.Join(tempDir, "old"))
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("only updates the index if the clone has already been moved", func() {
+			rel := expectedRelocation()
+
+			Expect(os.MkdirAll(filepath.Dir(rel.AbsoluteCloneDir), 0700)).To(Succeed())
+			Expect(os.Rename(rel.PreviousCloneDir, rel.AbsoluteCloneDir)).To(Succeed())
+
+			err := relocator.Relocate(context.Background(), rel, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(Equal([]LocalRepo{rel.LocalRepo}))
+		})
+
+		It("returns a ConflictError if the new location is occupied", func() {
+			rel := expectedRelocation()
+
+			Expect(os.MkdirAll(rel.AbsoluteCloneDir, 0700)).To(Succeed())
+
+			err := relocator.Relocate(context.Background(), rel, logs.Discard)
+			Expect(err).To(Equal(ConflictError{rel.AbsoluteCloneDir}))
+
+			_, err = os.Stat(filepath.Join(rel.PreviousCloneDir, "README.md"))
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("refuses to move a clone that has worktrees", func() {
+			var openedDir string
+			src.Driver = &stubs.Source{
+				LocalCloneFunc: func(
+					_ context.Context,
+					dir string,
+					_ logs.Log,
+				) (sourcedriver.LocalClone, error) {
+					openedDir = dir
+					return &stubs.WorktreeClone{
+						WorktreesFunc: func(context.Context, logs.Log) ([]sourcedriver.Worktree, error) {
+							return []sourcedriver.Worktree{
+								{
+									Dir:    dir + "@feature",
+									Branch: "feature",
+								},
+							}, nil
+						},
+					}, nil
+				},
+			}
+
+			rel := expectedRelocation()
+
+			err := relocator.Relocate(context.Background(), rel, logs.Discard)
+			Expect(err).To(MatchError("local clone has worktrees (repo@feature)"))
+			Expect(openedDir).To(Equal(rel.PreviousCloneDir))
+
+			_, err = os.Stat(filepath.Join(rel.PreviousCloneDir, "README.md"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = os.Stat(rel.AbsoluteCloneDir)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("returns an error if the clone no longer exists", func() {
+			rel := expectedRelocation()
+
+			Expect(os.RemoveAll(rel.PreviousCloneDir)).To(Succeed())
+
+			err := relocator.Relocate(context.Background(), rel, logs.Discard)
+			Expect(err).To(MatchError(rel.PreviousCloneDir + " no longer exists"))
+		})
+	})
+})
@@ -0,0 +1,164 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// A Remover removes local clones.
+type Remover struct {
+	Index *Index
+	Log   logs.Log
+}
+
+// UnpushedChangesError is returned when an operation that would discard a
+// local clone is refused because the clone contains changes that are not
+// present in any remote repository.
+type UnpushedChangesError struct {
+	Status sourcedriver.LocalStatus
+}
+
+func (e UnpushedChangesError) Error() string {
+	var reasons []string
+
+	if e.Status.HasUncommittedChanges {
+		reasons = append(reasons, "uncommitted changes")
+	}
+
+	if len(e.Status.UnpushedBranches) != 0 {
+		reasons = append(
+			reasons,
+			fmt.Sprintf(
+				"unpushed commits (%s)",
+				strings.Join(e.Status.UnpushedBranches, ", "),
+			),
+		)
+	}
+
+	if e.Status.HasStashes {
+		reasons = append(reasons, "stashed changes")
+	}
+
+	return "local clone has " + strings.Join(reasons, ", ")
+}
+
+// Remove removes a local clone.
+//
+// Unless force is true, the clone is only removed if all of its changes are
+// present in a remote repository and it has no additional working trees. If
+// force is true, any additional working trees are removed along with the
+// clone. Any parent directories of the clone that are left empty are also
+// removed, up to (but not including) the source's base clone directory.
+func (r *Remover) Remove(
+	ctx context.Context,
+	repo LocalRepo,
+	force bool,
+	clientLog logs.Log,
+) (err error) {
+	log := logs.Tee(
+		clientLog,
+		repo.Source.
+			Log(r.Log).
+			WithPrefix("remove %s: ", repo.Name),
+	)
+
+	defer func() {
+		if err != nil {
+			log.Write("%s", err.Error())
+		}
+	}()
+
+	wm, worktrees, err := linkedWorktrees(ctx, repo, log)
+	if err != nil {
+		return err
+	}
+
+	if !force {
+		if len(worktrees) != 0 {
+			return WorktreesExistError{worktrees}
+		}
+
+		if err := checkPushed(ctx, repo, log); err != nil {
+			return err
+		}
+	}
+
+	for _, wt := range worktrees {
+		if err := wm.RemoveWorktree(ctx, wt.Dir, true, log); err != nil {
+			return fmt.Errorf("unable to remove worktree: %w", err)
+		}
+
+		log.WriteVerbose("removed worktree %s", wt.Dir)
+	}
+
+	if err := os.RemoveAll(repo.AbsoluteCloneDir); err != nil {
+		return fmt.Errorf("unable to remove clone directory: %w", err)
+	}
+
+	if err := r.Index.Remove(repo.AbsoluteCloneDir); err != nil {
+		return fmt.Errorf("unable to record removal of local clone: %w", err)
+	}
+
+	if err := pruneEmptyDirs(
+		filepath.Dir(repo.AbsoluteCloneDir),
+		repo.Source.BaseCloneDir,
+	); err != nil {
+		log.Write("unable to remove empty parent directories: %s", err)
+	}
+
+	log.WriteVerbose("removed %s", repo.AbsoluteCloneDir)
+
+	return nil
+}
+
+// checkPushed returns an UnpushedChangesError if the local clone contains
+// changes that are not present in any remote repository.
+func checkPushed(
+	ctx context.Context,
+	repo LocalRepo,
+	log logs.Log,
+) error {
+	status, err := repo.Status(ctx, log)
+	if err != nil {
+		return err
+	}
+
+	if !status.IsPushed() {
+		return UnpushedChangesError{status}
+	}
+
+	return nil
+}
+
+// pruneEmptyDirs removes dir and each of its parents if they are empty,
+// stopping at (and never removing) base.
+func pruneEmptyDirs(dir, base string) error {
+	for dir != base && isWithinDir(dir, base) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				dir = filepath.Dir(dir)
+				continue
+			}
+			return err
+		}
+
+		if len(entries) != 0 {
+			return nil
+		}
+
+		if err := os.Remove(dir); err != nil {
+			return err
+		}
+
+		dir = filepath.Dir(dir)
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package source_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	. "github.com/gritcli/grit/daemon/internal/source"
+	"github.com/gritcli/grit/daemon/internal/stubs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Remover", func() {
+	var (
+		tempDir string
+		clone   *stubs.WorktreeClone
+		src     Source
+		repo    LocalRepo
+		index   *Index
+		remover *Remover
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = os.MkdirTemp("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(func() {
+			os.RemoveAll(tempDir)
+		})
+
+		clone = &stubs.WorktreeClone{}
+
+		src = Source{
+			Name:         "<source>",
+			BaseCloneDir: filepath.Join(tempDir, "clones"),
+			Driver: &stubs.Source{
+				LocalCloneFunc: func(
+					context.Context,
+					string,
+					logs.Log,
+				) (sourcedriver.LocalClone, error) {
+					return clone, nil
+				},
+			},
+		}
+
+		repo = LocalRepo{
+			RemoteRepo: sourcedriver.RemoteRepo{
+				ID:               "<id>",
+				Name:             "owner/repo",
+				RelativeCloneDir: "host/owner/repo",
+			},
+			Source:           src,
+			AbsoluteCloneDir: filepath.Join(src.BaseCloneDir, "host", "owner", "repo"),
+		}
+
+		err = os.MkdirAll(repo.AbsoluteCloneDir, 0700)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		index = &Index{
+			File:    filepath.Join(tempDir, "clones.json"),
+			Sources: List{src},
+		}
+		Expect(index.Add(repo)).To(Succeed())
+
+		remover = &Remover{
+			Index: index,
+		}
+	})
+
+	Describe("func Remove()", func() {
+		It("removes the clone directory and the index entry", func() {
+			err := remover.Remove(context.Background(), repo, false, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = os.Stat(repo.AbsoluteCloneDir)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+
+			repos, err := index.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repos).To(BeEmpty())
+		})
+
+		It("removes empty parent directories within the base clone directory", func() {
+			sibling := filepath.Join(src.BaseCloneDir, "other")
+			err := os.MkdirAll(sibling, 0700)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = remover.Remove(context.Background(), repo, false, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = os.Stat(filepath.Join(src.BaseCloneDir, "host"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+
+			_, err = os.Stat(sibling)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("does not remove the base clone directory", func() {
+			err := remover.Remove(context.Background(), repo, false, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = os.Stat(src.BaseCloneDir)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("refuses to remove a clone with unpushed changes", func() {
+			status := sourcedriver.LocalStatus{
+				HasUncommittedChanges: true,
+				UnpushedBranches:      []string{"main", "feature"},
+				HasStashes:            true,
+			}
+
+			clone.StatusFunc = func(context.Context, logs.Log) (sourcedriver.LocalStatus, error) {
+				return status, nil
+			}
+
+			err := remover.Remove(context.Background(), repo, false, logs.Discard)
+			Expect(err).To(Equal(UnpushedChangesError{status}))
+			Expect(err).To(MatchError(
+				"local clone has uncommitted changes, unpushed commits (main, feature), stashed changes",
+			))
+
+			_, err = os.Stat(repo.AbsoluteCloneDir)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("removes a clone with unpushed changes if force is true", func() {
+			clone.StatusFunc = func(context.Context, logs.Log) (sourcedriver.LocalStatus, error) {
+				return sourcedriver.LocalStatus{HasUncommittedChanges: true}, nil
+			}
+
+			err := remover.Remove(context.Background(), repo, true, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = os.Stat(repo.AbsoluteCloneDir)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("refuses to remove a clone with worktrees", func() {
+			worktrees := []sourcedriver.Worktree{
+				{
+					Dir:    repo.AbsoluteCloneDir + "@feature",
+					Branch: "feature",
+				},
+			}
+
+			clone.WorktreesFunc = func(context.Context, logs.Log) ([]sourcedriver.Worktree, error) {
+				return worktrees, nil
+			}
+
+			err := remover.Remove(context.Background(), repo, false, logs.Discard)
+			Expect(err).To(Equal(WorktreesExistError{worktrees}))
+			Expect(err).To(MatchError("local clone has worktrees (repo@feature)"))
+
+			_, err = os.Stat(repo.AbsoluteCloneDir)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("removes the worktrees along with the clone if force is true", func() {
+			wtDir := repo.AbsoluteCloneDir + "@feature"
+			Expect(os.MkdirAll(wtDir, 0700)).To(Succeed())
+
+			clone.WorktreesFunc = func(context.Context, logs.Log) ([]sourcedriver.Worktree, error) {
+				return []sourcedriver.Worktree{
+					{
+						Dir:                   wtDir,
+						Branch:                "feature",
+						HasUncommittedChanges: true,
+					},
+				}, nil
+			}
+
+			var removed []string
+			clone.RemoveWorktreeFunc = func(
+				_ context.Context,
+				dir string,
+				force bool,
+				_ logs.Log,
+			) error {
+				Expect(force).To(BeTrue())
+				removed = append(removed, dir)
+				return os.RemoveAll(dir)
+			}
+
+			err := remover.Remove(context.Background(), repo, true, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(removed).To(ConsistOf(wtDir))
+
+			_, err = os.Stat(filepath.Join(src.BaseCloneDir, "host"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("returns an error if the status can not be determined", func() {
+			clone.StatusFunc = func(context.Context, logs.Log) (sourcedriver.LocalStatus, error) {
+				return sourcedriver.LocalStatus{}, errors.New("<error>")
+			}
+
+			err := remover.Remove(context.Background(), repo, false, logs.Discard)
+			Expect(err).To(MatchError("unable to determine local clone status: <error>"))
+		})
+	})
+})
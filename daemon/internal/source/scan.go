@@ -0,0 +1,191 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// Scan adds the existing local clones within each source's clone directory to
+// the index.
+//
+// It is intended to populate the index with clones that were made before the
+// index existed, and so it does nothing if the index file already exists.
+//
+// Each directory is identified by asking the source's driver for the URL of
+// its remote repository, which is then resolved to a repository in the same
+// way as by the Adopter. Clones that can not be identified are reported and
+// left out of the index; they can be added later using "grit adopt".
+func (x *Index) Scan(ctx context.Context, log logs.Log) error {
+	if _, err := os.Stat(x.File); err == nil {
+		return nil
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	var found []LocalRepo
+
+	for _, src := range x.Sources {
+		repos, err := scanCloneDir(ctx, src, log)
+		if err != nil {
+			return fmt.Errorf("unable to scan %s for existing clones: %w", src.BaseCloneDir, err)
+		}
+
+		found = append(found, repos...)
+	}
+
+	x.m.Lock()
+	defer x.m.Unlock()
+
+	// Clones may have been added to the index while the scan was in progress,
+	// in which case the existing entries take precedence.
+	entries, err := x.load()
+	if err != nil {
+		return err
+	}
+
+	indexed := map[string]struct{}{}
+	for _, e := range entries {
+		indexed[e.AbsoluteCloneDir] = struct{}{}
+	}
+
+	for _, r := range found {
+		if _, ok := indexed[r.AbsoluteCloneDir]; !ok {
+			entries = append(entries, newIndexEntry(r))
+		}
+	}
+
+	// The index is saved even if no clones were found, so that the scan is
+	// not repeated.
+	return x.save(entries)
+}
+
+// scanCloneDir returns the local clones within the clone directory of src.
+func scanCloneDir(
+	ctx context.Context,
+	src Source,
+	log logs.Log,
+) ([]LocalRepo, error) {
+	var repos []LocalRepo
+
+	err := filepath.WalkDir(
+		src.BaseCloneDir,
+		func(dir string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+
+			if !d.IsDir() || dir == src.BaseCloneDir {
+				return nil
+			}
+
+			// Don't descend into hidden directories, such as VCS metadata,
+			// archives and staging directories.
+			if strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+
+			url, err := remoteURL(ctx, src, dir, log)
+			if err != nil {
+				if hasVCSMetadata(dir) {
+					src.Log(log).Write(
+						"unable to identify the clone in %s: %s",
+						dir,
+						err,
+					)
+					return filepath.SkipDir
+				}
+
+				// The directory is not a clone, but it may contain clones.
+				return nil
+			}
+
+			r, ok, err := src.Driver.ResolveCloneURL(ctx, url, src.Log(log))
+			if err != nil {
+				return err
+			}
+
+			if !ok {
+				src.Log(log).Write(
+					"the clone in %s does not belong to this source",
+					dir,
+				)
+				return filepath.SkipDir
+			}
+
+			local, err := src.LocalRepo(r)
+			if err != nil {
+				return err
+			}
+
+			if local.AbsoluteCloneDir != dir {
+				src.Log(log).Write(
+					"found a clone of %s in %s, use 'grit relocate' to move it to %s",
+					local.Name,
+					dir,
+					local.AbsoluteCloneDir,
+				)
+
+				local, err = atDir(local, r, dir)
+				if err != nil {
+					return err
+				}
+			} else {
+				src.Log(log).WriteVerbose(
+					"found a clone of %s in %s",
+					local.Name,
+					dir,
+				)
+			}
+
+			repos = append(repos, local)
+
+			return filepath.SkipDir
+		},
+	)
+
+	return repos, err
+}
+
+// atDir returns a copy of local that describes a clone of r in dir, which is
+// not the location dictated by the source's layout.
+//
+// The directory chosen by the driver is retained in the layout fields so that
+// the clone can be relocated later.
+func atDir(local LocalRepo, r sourcedriver.RemoteRepo, dir string) (LocalRepo, error) {
+	rel, err := filepath.Rel(local.Source.BaseCloneDir, dir)
+	if err != nil {
+		return LocalRepo{}, err
+	}
+
+	if _, ok := local.LayoutFields[layoutDirField]; !ok {
+		fields := map[string]string{
+			layoutDirField: filepath.ToSlash(r.RelativeCloneDir),
+		}
+		for k, v := range local.LayoutFields {
+			fields[k] = v
+		}
+		local.LayoutFields = fields
+	}
+
+	local.RelativeCloneDir = rel
+	local.AbsoluteCloneDir = dir
+
+	return local, nil
+}
+
+// hasVCSMetadata returns true if dir contains VCS metadata, indicating that
+// it is the working tree of a clone.
+func hasVCSMetadata(dir string) bool {
+	_, err := os.Lstat(filepath.Join(dir, ".git"))
+	return err == nil
+}
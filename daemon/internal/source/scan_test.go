@@ -0,0 +1,188 @@
+package source_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	. "github.com/gritcli/grit/daemon/internal/source"
+	"github.com/gritcli/grit/daemon/internal/stubs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func Index.Scan()", func() {
+	var (
+		tempDir string
+		baseDir string
+		remote  sourcedriver.RemoteRepo
+		driver  *stubs.Source
+		src     Source
+		index   *Index
+	)
+
+	// makeClone makes a clone of the repository at the given URL in the given
+	// directory, relative to the source's clone directory.
+	makeClone := func(rel, url string) string {
+		dir := filepath.Join(baseDir, rel)
+
+		_, err := git.PlainInit(dir, false)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(os.WriteFile(filepath.Join(dir, ".git", "grit-test-url"), []byte(url), 0600)).To(Succeed())
+
+		return dir
+	}
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = os.MkdirTemp("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(func() {
+			os.RemoveAll(tempDir)
+		})
+
+		baseDir = filepath.Join(tempDir, "clones")
+
+		remote = sourcedriver.RemoteRepo{
+			ID:               "<id>",
+			Name:             "owner/repo",
+			RelativeCloneDir: filepath.Join("owner", "repo"),
+		}
+
+		driver = &stubs.Source{
+			LocalCloneFunc: func(
+				_ context.Context,
+				dir string,
+				_ logs.Log,
+			) (sourcedriver.LocalClone, error) {
+				return &stubs.LocalClone{
+					RemoteURLFunc: func(context.Context, logs.Log) (string, error) {
+						data, err := os.ReadFile(filepath.Join(dir, ".git", "grit-test-url"))
+						if err != nil {
+							return "", errors.New("<not a clone>")
+						}
+						return string(data), nil
+					},
+				}, nil
+			},
+			ResolveCloneURLFunc: func(
+				_ context.Context,
+				url string,
+				_ logs.Log,
+			) (sourcedriver.RemoteRepo, bool, error) {
+				return remote, url == "<url>", nil
+			},
+		}
+
+		src = Source{
+			Name:         "<source>",
+			BaseCloneDir: baseDir,
+			Driver:       driver,
+		}
+
+		index = &Index{
+			File:    filepath.Join(tempDir, "data", "clones.json"),
+			Sources: List{src},
+		}
+	})
+
+	It("adds existing clones to an index that does not exist", func() {
+		dir := makeClone(filepath.Join("owner", "repo"), "<url>")
+
+		err := index.Scan(context.Background(), logs.Discard)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		repos, err := index.List()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(repos).To(ConsistOf(
+			LocalRepo{
+				RemoteRepo:       remote,
+				Source:           src,
+				AbsoluteCloneDir: dir,
+			},
+		))
+	})
+
+	It("records clones that are not in the expected location where they are", func() {
+		dir := makeClone(filepath.Join("elsewhere", "repo"), "<url>")
+
+		err := index.Scan(context.Background(), logs.Discard)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		repos, err := index.List()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(repos).To(HaveLen(1))
+		Expect(repos[0].AbsoluteCloneDir).To(Equal(dir))
+
+		relocations, err := (&Relocator{Index: index}).Relocations()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(relocations).To(HaveLen(1))
+		Expect(relocations[0].AbsoluteCloneDir).To(Equal(filepath.Join(baseDir, "owner", "repo")))
+		Expect(relocations[0].PreviousCloneDir).To(Equal(dir))
+	})
+
+	It("does not descend into clones or hidden directories", func() {
+		makeClone(filepath.Join("owner", "repo"), "<url>")
+		makeClone(filepath.Join("owner", "repo", "nested"), "<url>")
+		makeClone(filepath.Join(".archive", "repo"), "<url>")
+
+		err := index.Scan(context.Background(), logs.Discard)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		repos, err := index.List()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(repos).To(HaveLen(1))
+	})
+
+	It("skips clones that do not belong to the source", func() {
+		makeClone(filepath.Join("owner", "repo"), "<other-url>")
+
+		err := index.Scan(context.Background(), logs.Discard)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		repos, err := index.List()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(repos).To(BeEmpty())
+
+		_, err = os.Stat(index.File)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("does nothing if the index already exists", func() {
+		err := index.Remove("<nothing>")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		makeClone(filepath.Join("owner", "repo"), "<url>")
+
+		err = index.Scan(context.Background(), logs.Discard)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		repos, err := index.List()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(repos).To(BeEmpty())
+	})
+
+	It("returns an error if the driver fails to resolve a clone", func() {
+		makeClone(filepath.Join("owner", "repo"), "<url>")
+
+		driver.ResolveCloneURLFunc = func(
+			context.Context,
+			string,
+			logs.Log,
+		) (sourcedriver.RemoteRepo, bool, error) {
+			return sourcedriver.RemoteRepo{}, false, errors.New("<error>")
+		}
+
+		err := index.Scan(context.Background(), logs.Discard)
+		Expect(err).To(MatchError("unable to scan " + baseDir + " for existing clones: <error>"))
+
+		// The index is not created, so that the scan is retried.
+		_, err = os.Stat(index.File)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+})
@@ -2,7 +2,10 @@ package source
 
 import (
 	"net/url"
+	"path/filepath"
+	"time"
 
+	"github.com/gritcli/grit/daemon/internal/config"
 	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
 	"github.com/gritcli/grit/daemon/internal/logs"
 )
@@ -19,6 +22,23 @@ type Source struct {
 	// source.
 	BaseCloneDir string
 
+	// Layout is a template for the path of each clone within BaseCloneDir,
+	// such as "{owner}/{name}". If it is empty, the directory chosen by the
+	// driver is used.
+	Layout string
+
+	// BaseArchiveDir is the directory containing archives of local clones of
+	// repositories from this source.
+	BaseArchiveDir string
+
+	// ArchiveAfter is the period of inactivity after which a local clone from
+	// this source is eligible to be archived.
+	ArchiveAfter time.Duration
+
+	// PostCloneHooks is the list of hooks that are run in the directory of a
+	// new clone after a repository from this source is cloned.
+	PostCloneHooks []config.Hook
+
 	// BaseURL is the base URL for that the daemon's HTTP server route's to the
 	// source's HTTP handler implementation.
 	BaseURL *url.URL
@@ -28,6 +48,64 @@ type Source struct {
 	Driver sourcedriver.Source
 }
 
+// LocalRepo returns a LocalRepo that describes where a local clone of r
+// belongs within the source's clone directory.
+func (s Source) LocalRepo(r sourcedriver.RemoteRepo) (LocalRepo, error) {
+	dir, err := s.relativeCloneDir(r)
+	if err != nil {
+		return LocalRepo{}, err
+	}
+
+	if dir != r.RelativeCloneDir {
+		// Retain the directory chosen by the driver so that the clone can be
+		// relocated if the layout is changed or removed.
+		fields := map[string]string{
+			layoutDirField: filepath.ToSlash(r.RelativeCloneDir),
+		}
+		for k, v := range r.LayoutFields {
+			fields[k] = v
+		}
+
+		r.RelativeCloneDir = dir
+		r.LayoutFields = fields
+	}
+
+	return LocalRepo{
+		r,
+		s,
+		filepath.Join(s.BaseCloneDir, dir),
+	}, nil
+}
+
+// layoutDirField is the name of the layout field that contains the directory
+// chosen by the driver.
+const layoutDirField = "dir"
+
+// relativeCloneDir returns the directory of the clone of r, relative to the
+// source's clone directory, as dictated by the source's layout.
+func (s Source) relativeCloneDir(r sourcedriver.RemoteRepo) (string, error) {
+	dir := r.RelativeCloneDir
+	if d, ok := r.LayoutFields[layoutDirField]; ok {
+		dir = filepath.FromSlash(d)
+	}
+
+	if s.Layout == "" {
+		return dir, nil
+	}
+
+	fields := map[string]string{
+		"source":       s.Name,
+		"id":           r.ID,
+		layoutDirField: filepath.ToSlash(dir),
+	}
+
+	for k, v := range r.LayoutFields {
+		fields[k] = v
+	}
+
+	return config.ExpandLayout(s.Layout, fields)
+}
+
 // Log returns the logger to use for messages about this source.
 func (s Source) Log(log logs.Log) logs.Log {
 	return newLog(s.Name, log)
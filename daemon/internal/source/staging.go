@@ -0,0 +1,188 @@
+package source
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// stagingDirPrefix is the prefix used for the names of the temporary
+// directories that repositories are cloned into before being moved into
+// place.
+const stagingDirPrefix = ".grit-clone-"
+
+// makeStagingDir makes a new, empty staging directory for a clone that is to
+// be moved into dir once complete.
+//
+// The staging directory is created beside dir so that it resides on the same
+// filesystem, allowing it to be renamed atomically. It is recorded as being in
+// use until releaseStagingDir() is called, so that it is not mistaken for a
+// stale staging directory.
+func (c *Cloner) makeStagingDir(dir string) (string, error) {
+	parent := filepath.Dir(dir)
+
+	if err := os.MkdirAll(parent, 0700); err != nil {
+		return "", err
+	}
+
+	c.stagingM.Lock()
+	defer c.stagingM.Unlock()
+
+	staging, err := os.MkdirTemp(
+		parent,
+		stagingDirPrefix+filepath.Base(dir)+"-*",
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if c.staging == nil {
+		c.staging = map[string]struct{}{}
+	}
+	c.staging[staging] = struct{}{}
+
+	return staging, nil
+}
+
+// releaseStagingDir records that the given staging directory is no longer in
+// use, either because it has been moved into place or removed.
+func (c *Cloner) releaseStagingDir(staging string) {
+	c.stagingM.Lock()
+	defer c.stagingM.Unlock()
+
+	delete(c.staging, staging)
+}
+
+// RemoveStaleStagingDirs removes any staging directories left behind by clone
+// operations that were interrupted, for example by the daemon being killed.
+//
+// Staging directories are only sought where makeStagingDir() can create them,
+// that is, beside the clone directories dictated by each source's layout. It
+// does not descend into local clones. Staging directories in use by clone
+// operations that are in progress are left in place.
+func (c *Cloner) RemoveStaleStagingDirs() error {
+	repos, err := c.Index.List()
+	if err != nil {
+		return err
+	}
+
+	clones := map[string]struct{}{}
+	for _, r := range repos {
+		clones[r.AbsoluteCloneDir] = struct{}{}
+	}
+
+	for _, src := range c.Sources {
+		depth := cloneDirDepth(src, repos)
+		if depth == 0 {
+			// The depth of the clone directories can not be determined until
+			// the source has at least one clone.
+			continue
+		}
+
+		if err := c.removeStaleStagingDirs(
+			src,
+			src.BaseCloneDir,
+			depth,
+			clones,
+		); err != nil {
+			return fmt.Errorf("unable to remove stale staging directories: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// removeStaleStagingDirs removes the stale staging directories within dir,
+// which is depth levels above the clone directories of src.
+func (c *Cloner) removeStaleStagingDirs(
+	src Source,
+	dir string,
+	depth int,
+	clones map[string]struct{},
+) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+
+		p := filepath.Join(dir, e.Name())
+
+		if strings.HasPrefix(e.Name(), stagingDirPrefix) {
+			if err := c.removeStagingDir(src, p); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Don't descend past the depth of the clone directories, or into
+		// local clones or any other hidden directories, such as VCS metadata.
+		if depth == 1 || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+
+		if _, ok := clones[p]; ok || hasVCSMetadata(p) {
+			continue
+		}
+
+		if err := c.removeStaleStagingDirs(src, p, depth-1, clones); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeStagingDir removes the given staging directory, unless it is in use.
+func (c *Cloner) removeStagingDir(src Source, staging string) error {
+	c.stagingM.Lock()
+	defer c.stagingM.Unlock()
+
+	if _, ok := c.staging[staging]; ok {
+		return nil
+	}
+
+	src.Log(c.Log).Write("removing stale staging directory: %s", staging)
+
+	if err := os.RemoveAll(staging); err != nil {
+		return err
+	}
+
+	return pruneEmptyDirs(filepath.Dir(staging), src.BaseCloneDir)
+}
+
+// cloneDirDepth returns the number of path components in the directories of
+// clones from src, relative to its clone directory.
+//
+// It is determined by the source's layout, unless the layout is empty or
+// includes the directory chosen by the driver, in which case it is determined
+// by the deepest existing clone. It returns 0 if the depth can not be
+// determined.
+func cloneDirDepth(src Source, repos []LocalRepo) int {
+	depth := 0
+
+	if src.Layout != "" && !strings.Contains(src.Layout, "{"+layoutDirField+"}") {
+		depth = len(strings.Split(src.Layout, "/"))
+	}
+
+	for _, r := range repos {
+		if r.Source.Name != src.Name {
+			continue
+		}
+
+		n := len(strings.Split(filepath.ToSlash(r.RelativeCloneDir), "/"))
+		if n > depth {
+			depth = n
+		}
+	}
+
+	return depth
+}
@@ -0,0 +1,205 @@
+package source_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	. "github.com/gritcli/grit/daemon/internal/source"
+	"github.com/gritcli/grit/daemon/internal/stubs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func RemoveStaleStagingDirs()", func() {
+	var (
+		tempDir string
+		src     Source
+		index   *Index
+		cloner  *Cloner
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = os.MkdirTemp("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(func() {
+			os.RemoveAll(tempDir)
+		})
+
+		src = Source{
+			Name:         "<source>",
+			BaseCloneDir: filepath.Join(tempDir, "clones"),
+			Layout:       "{owner}/{name}",
+			Driver:       &stubs.Source{},
+		}
+
+		index = &Index{
+			File:    filepath.Join(tempDir, "data", "clones.json"),
+			Sources: List{src},
+		}
+
+		cloner = &Cloner{
+			Sources: List{src},
+			Index:   index,
+		}
+	})
+
+	makeDir := func(rel string) string {
+		dir := filepath.Join(src.BaseCloneDir, rel)
+		err := os.MkdirAll(dir, 0700)
+		Expect(err).ShouldNot(HaveOccurred())
+		return dir
+	}
+
+	It("removes staging directories and any parent directories left empty", func() {
+		makeDir("owner/.grit-clone-repo-123/.git")
+
+		err := cloner.RemoveStaleStagingDirs()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(filepath.Join(src.BaseCloneDir, "owner")).NotTo(BeADirectory())
+		Expect(src.BaseCloneDir).To(BeADirectory())
+	})
+
+	It("does not remove other directories", func() {
+		dir := makeDir("owner/repo")
+		makeDir("owner/.grit-clone-other-123")
+
+		err := cloner.RemoveStaleStagingDirs()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(dir).To(BeADirectory())
+	})
+
+	It("does not look inside local clones", func() {
+		dir := makeDir("owner/repo")
+		staging := makeDir("owner/repo/.grit-clone-not-ours-123")
+
+		err := index.Add(LocalRepo{
+			RemoteRepo: sourcedriver.RemoteRepo{
+				ID:               "<id>",
+				Name:             "owner/repo",
+				RelativeCloneDir: "owner/repo",
+			},
+			Source:           src,
+			AbsoluteCloneDir: dir,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = cloner.RemoveStaleStagingDirs()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(staging).To(BeADirectory())
+	})
+
+	It("does not look deeper than the clone directories", func() {
+		staging := makeDir("owner/repo/sub/.grit-clone-not-ours-123")
+
+		err := cloner.RemoveStaleStagingDirs()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(staging).To(BeADirectory())
+	})
+
+	It("does not look inside unrecorded local clones", func() {
+		makeDir("owner/.git")
+		staging := makeDir("owner/repo/.grit-clone-not-ours-123")
+
+		err := cloner.RemoveStaleStagingDirs()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(staging).To(BeADirectory())
+	})
+
+	It("uses the depth of existing clones if the source has no layout", func() {
+		src.Layout = ""
+		index.Sources = List{src}
+		cloner.Sources = List{src}
+
+		dir := makeDir("a/b/c")
+		makeDir("x/y/.grit-clone-z-123")
+		staging := makeDir("x/y/z/.grit-clone-not-ours-123")
+
+		err := index.Add(LocalRepo{
+			RemoteRepo: sourcedriver.RemoteRepo{
+				ID:               "<id>",
+				Name:             "a/b/c",
+				RelativeCloneDir: "a/b/c",
+			},
+			Source:           src,
+			AbsoluteCloneDir: dir,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = cloner.RemoveStaleStagingDirs()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(filepath.Join(src.BaseCloneDir, "x", "y", ".grit-clone-z-123")).NotTo(BeADirectory())
+		Expect(staging).To(BeADirectory())
+	})
+
+	It("does not remove anything if the depth of the clone directories is unknown", func() {
+		src.Layout = ""
+		cloner.Sources = List{src}
+
+		staging := makeDir("owner/.grit-clone-repo-123")
+
+		err := cloner.RemoveStaleStagingDirs()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(staging).To(BeADirectory())
+	})
+
+	It("does not remove staging directories that are in use", func() {
+		src.Driver = &stubs.Source{
+			ClonerFunc: func(
+				context.Context,
+				string,
+				logs.Log,
+			) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error) {
+				return &stubs.SourceCloner{
+					CloneFunc: func(
+						_ context.Context,
+						staging string,
+						_ sourcedriver.CloneOptions,
+						_ logs.Log,
+					) error {
+						err := cloner.RemoveStaleStagingDirs()
+						Expect(err).ShouldNot(HaveOccurred())
+						Expect(staging).To(BeADirectory())
+
+						return nil
+					},
+				}, sourcedriver.RemoteRepo{
+					ID:               "<id>",
+					Name:             "owner/repo",
+					RelativeCloneDir: "owner/repo",
+					LayoutFields: map[string]string{
+						"owner": "owner",
+						"name":  "repo",
+					},
+				}, nil
+			},
+		}
+		index.Sources = List{src}
+		cloner.Sources = List{src}
+
+		local, err := cloner.Clone(
+			context.Background(),
+			"<source>",
+			"<id>",
+			sourcedriver.CloneOptions{},
+			logs.Discard,
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(local.AbsoluteCloneDir).To(BeADirectory())
+	})
+
+	It("does not return an error if the clone directory does not exist", func() {
+		err := cloner.RemoveStaleStagingDirs()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,85 @@
+package source
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// An Updater updates existing local clones with changes from their remote
+// repositories.
+type Updater struct {
+	Log logs.Log
+}
+
+// Fetch fetches changes from the remote repositories of a local clone without
+// modifying its working tree.
+func (u *Updater) Fetch(
+	ctx context.Context,
+	repo LocalRepo,
+	clientLog logs.Log,
+) (err error) {
+	log := logs.Tee(
+		clientLog,
+		repo.Source.
+			Log(u.Log).
+			WithPrefix("fetch %s: ", repo.Name),
+	)
+
+	defer func() {
+		if err != nil {
+			log.Write("%s", err.Error())
+		}
+	}()
+
+	clone, err := repo.Source.Driver.LocalClone(ctx, repo.AbsoluteCloneDir, log)
+	if err != nil {
+		return fmt.Errorf("unable to open local clone: %w", err)
+	}
+
+	if err := clone.Fetch(ctx, log); err != nil {
+		return fmt.Errorf("unable to fetch: %w", err)
+	}
+
+	return nil
+}
+
+// Pull fetches changes from the remote repositories of a local clone then
+// fast-forwards its checked-out branch, if possible.
+func (u *Updater) Pull(
+	ctx context.Context,
+	repo LocalRepo,
+	clientLog logs.Log,
+) (_ sourcedriver.PullResult, err error) {
+	daemonLog := repo.Source.
+		Log(u.Log).
+		WithPrefix("pull %s: ", repo.Name)
+
+	log := logs.Tee(clientLog, daemonLog)
+
+	defer func() {
+		if err != nil {
+			log.Write("%s", err.Error())
+		}
+	}()
+
+	clone, err := repo.Source.Driver.LocalClone(ctx, repo.AbsoluteCloneDir, log)
+	if err != nil {
+		return sourcedriver.PullResult{}, fmt.Errorf("unable to open local clone: %w", err)
+	}
+
+	result, err := clone.Pull(ctx, log)
+	if err != nil {
+		return sourcedriver.PullResult{}, fmt.Errorf("unable to pull: %w", err)
+	}
+
+	// The skip reason is included in the result, so it is only written to
+	// the daemon's log to avoid duplicating it in the client's output.
+	if result.SkipReason != "" {
+		daemonLog.Write("skipped: %s", result.SkipReason)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,155 @@
+package source_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	. "github.com/gritcli/grit/daemon/internal/source"
+	"github.com/gritcli/grit/daemon/internal/stubs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Updater", func() {
+	var (
+		clone   *stubs.LocalClone
+		driver  *stubs.Source
+		repo    LocalRepo
+		updater *Updater
+	)
+
+	BeforeEach(func() {
+		clone = &stubs.LocalClone{}
+
+		driver = &stubs.Source{
+			LocalCloneFunc: func(
+				context.Context,
+				string,
+				logs.Log,
+			) (sourcedriver.LocalClone, error) {
+				return clone, nil
+			},
+		}
+
+		repo = LocalRepo{
+			RemoteRepo: sourcedriver.RemoteRepo{
+				ID:   "<id>",
+				Name: "<repo>",
+			},
+			Source: Source{
+				Name:   "<source>",
+				Driver: driver,
+			},
+			AbsoluteCloneDir: "/path/to/clone",
+		}
+
+		updater = &Updater{}
+	})
+
+	Describe("func Fetch()", func() {
+		It("fetches changes into the local clone", func() {
+			var dir string
+			driver.LocalCloneFunc = func(
+				_ context.Context,
+				d string,
+				_ logs.Log,
+			) (sourcedriver.LocalClone, error) {
+				dir = d
+				return clone, nil
+			}
+
+			called := false
+			clone.FetchFunc = func(context.Context, logs.Log) error {
+				called = true
+				return nil
+			}
+
+			err := updater.Fetch(context.Background(), repo, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(dir).To(Equal("/path/to/clone"))
+			Expect(called).To(BeTrue())
+		})
+
+		It("returns an error if the local clone can not be opened", func() {
+			driver.LocalCloneFunc = func(
+				context.Context,
+				string,
+				logs.Log,
+			) (sourcedriver.LocalClone, error) {
+				return nil, errors.New("<error>")
+			}
+
+			err := updater.Fetch(context.Background(), repo, logs.Discard)
+			Expect(err).To(MatchError("unable to open local clone: <error>"))
+		})
+
+		It("returns an error if the fetch fails", func() {
+			clone.FetchFunc = func(context.Context, logs.Log) error {
+				return errors.New("<error>")
+			}
+
+			var buffer logs.Buffer
+			err := updater.Fetch(context.Background(), repo, buffer.Log())
+			Expect(err).To(MatchError("unable to fetch: <error>"))
+			Expect(buffer).To(ContainElement(
+				logs.Message{
+					Text: "unable to fetch: <error>",
+				},
+			))
+		})
+	})
+
+	Describe("func Pull()", func() {
+		It("returns the result of the pull", func() {
+			clone.PullFunc = func(context.Context, logs.Log) (sourcedriver.PullResult, error) {
+				return sourcedriver.PullResult{Updated: true}, nil
+			}
+
+			result, err := updater.Pull(context.Background(), repo, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(sourcedriver.PullResult{Updated: true}))
+		})
+
+		It("logs the reason that a pull was skipped to the daemon's log", func() {
+			clone.PullFunc = func(context.Context, logs.Log) (sourcedriver.PullResult, error) {
+				return sourcedriver.PullResult{SkipReason: "<reason>"}, nil
+			}
+
+			var buffer logs.Buffer
+			updater.Log = buffer.Log()
+
+			result, err := updater.Pull(context.Background(), repo, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(Equal(sourcedriver.PullResult{SkipReason: "<reason>"}))
+			Expect(buffer).To(ContainElement(
+				logs.Message{
+					Text: "source/<source>: pull <repo>: skipped: <reason>",
+				},
+			))
+		})
+
+		It("returns an error if the local clone can not be opened", func() {
+			driver.LocalCloneFunc = func(
+				context.Context,
+				string,
+				logs.Log,
+			) (sourcedriver.LocalClone, error) {
+				return nil, errors.New("<error>")
+			}
+
+			_, err := updater.Pull(context.Background(), repo, logs.Discard)
+			Expect(err).To(MatchError("unable to open local clone: <error>"))
+		})
+
+		It("returns an error if the pull fails", func() {
+			clone.PullFunc = func(context.Context, logs.Log) (sourcedriver.PullResult, error) {
+				return sourcedriver.PullResult{}, errors.New("<error>")
+			}
+
+			_, err := updater.Pull(context.Background(), repo, logs.Discard)
+			Expect(err).To(MatchError("unable to pull: <error>"))
+		})
+	})
+})
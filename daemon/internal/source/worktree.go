@@ -0,0 +1,280 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+)
+
+// A WorktreeManager manages the additional working trees of local clones.
+//
+// Each working tree is placed alongside the local clone in a directory named
+// after the clone and the branch, such as "<clone>@<branch>".
+type WorktreeManager struct {
+	Log logs.Log
+}
+
+// Worktree is an additional working tree of a local clone.
+type Worktree struct {
+	sourcedriver.Worktree
+
+	// Repo is the local clone that the working tree belongs to.
+	Repo LocalRepo
+}
+
+// DirtyWorktreeError is returned when the removal of a working tree is refused
+// because it contains uncommitted changes.
+type DirtyWorktreeError struct {
+	Dir string
+}
+
+func (e DirtyWorktreeError) Error() string {
+	return "worktree has uncommitted changes"
+}
+
+// WorktreesExistError is returned when an operation that would remove or move
+// a local clone is refused because the clone has additional working trees,
+// which would otherwise be left behind without a clone.
+type WorktreesExistError struct {
+	Worktrees []sourcedriver.Worktree
+}
+
+func (e WorktreesExistError) Error() string {
+	var dirs []string
+	for _, wt := range e.Worktrees {
+		dirs = append(dirs, filepath.Base(wt.Dir))
+	}
+
+	return fmt.Sprintf("local clone has worktrees (%s)", strings.Join(dirs, ", "))
+}
+
+// Add creates a new working tree for a local clone with the given branch
+// checked out.
+func (m *WorktreeManager) Add(
+	ctx context.Context,
+	repo LocalRepo,
+	branch string,
+	clientLog logs.Log,
+) (_ Worktree, err error) {
+	log := logs.Tee(
+		clientLog,
+		repo.Source.
+			Log(m.Log).
+			WithPrefix("worktree %s@%s: ", repo.Name, branch),
+	)
+
+	defer func() {
+		if err != nil {
+			log.Write("%s", err.Error())
+		}
+	}()
+
+	if branch == "" {
+		return Worktree{}, errors.New("branch name must not be empty")
+	}
+
+	wm, err := worktreeManager(ctx, repo, log)
+	if err != nil {
+		return Worktree{}, err
+	}
+
+	dir := worktreeDir(repo, branch)
+
+	if _, err := os.Stat(dir); err == nil {
+		return Worktree{}, existingDirError(ctx, wm, dir, branch, log)
+	} else if !os.IsNotExist(err) {
+		return Worktree{}, err
+	}
+
+	if err := wm.AddWorktree(ctx, dir, branch, log); err != nil {
+		return Worktree{}, fmt.Errorf("unable to add worktree: %w", err)
+	}
+
+	log.WriteVerbose("added worktree in %s", dir)
+
+	return Worktree{
+		sourcedriver.Worktree{
+			Dir:    dir,
+			Branch: branch,
+		},
+		repo,
+	}, nil
+}
+
+// List returns the additional working trees of a local clone.
+func (m *WorktreeManager) List(
+	ctx context.Context,
+	repo LocalRepo,
+	log logs.Log,
+) ([]Worktree, error) {
+	wm, err := worktreeManager(ctx, repo, log)
+	if err != nil {
+		return nil, err
+	}
+
+	worktrees, err := wm.Worktrees(ctx, log)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list worktrees: %w", err)
+	}
+
+	var result []Worktree
+	for _, wt := range worktrees {
+		result = append(result, Worktree{wt, repo})
+	}
+
+	return result, nil
+}
+
+// Remove removes the working tree of a local clone that has the given branch
+// checked out.
+//
+// Unless force is true, the working tree is only removed if it has no
+// uncommitted changes. The branch itself is never removed.
+func (m *WorktreeManager) Remove(
+	ctx context.Context,
+	repo LocalRepo,
+	branch string,
+	force bool,
+	clientLog logs.Log,
+) (_ Worktree, err error) {
+	log := logs.Tee(
+		clientLog,
+		repo.Source.
+			Log(m.Log).
+			WithPrefix("worktree %s@%s: ", repo.Name, branch),
+	)
+
+	defer func() {
+		if err != nil {
+			log.Write("%s", err.Error())
+		}
+	}()
+
+	worktrees, err := m.List(ctx, repo, log)
+	if err != nil {
+		return Worktree{}, err
+	}
+
+	for _, wt := range worktrees {
+		if wt.Branch != branch {
+			continue
+		}
+
+		if wt.HasUncommittedChanges && !force {
+			return Worktree{}, DirtyWorktreeError{wt.Dir}
+		}
+
+		wm, err := worktreeManager(ctx, repo, log)
+		if err != nil {
+			return Worktree{}, err
+		}
+
+		if err := wm.RemoveWorktree(ctx, wt.Dir, force, log); err != nil {
+			return Worktree{}, fmt.Errorf("unable to remove worktree: %w", err)
+		}
+
+		log.WriteVerbose("removed %s", wt.Dir)
+
+		return wt, nil
+	}
+
+	return Worktree{}, fmt.Errorf("there is no worktree for the '%s' branch", branch)
+}
+
+// existingDirError returns the error to report when the directory in which a
+// working tree for the given branch would be placed already exists.
+//
+// Branch names that differ only by the use of slashes and hyphens map to the
+// same directory, so the error identifies the branch that is checked out in
+// the existing working tree, if any.
+func existingDirError(
+	ctx context.Context,
+	wm sourcedriver.WorktreeManager,
+	dir, branch string,
+	log logs.Log,
+) error {
+	worktrees, err := wm.Worktrees(ctx, log)
+	if err != nil {
+		return fmt.Errorf("%s already exists", dir)
+	}
+
+	for _, wt := range worktrees {
+		if filepath.Clean(wt.Dir) != dir {
+			continue
+		}
+
+		if wt.Branch == branch {
+			return fmt.Errorf("there is already a worktree for the '%s' branch in %s", branch, dir)
+		}
+
+		if wt.Branch != "" {
+			return fmt.Errorf("%s is already used by the worktree for the '%s' branch", dir, wt.Branch)
+		}
+	}
+
+	return fmt.Errorf("%s already exists", dir)
+}
+
+// worktreeManager returns the driver's worktree manager for a local clone.
+func worktreeManager(
+	ctx context.Context,
+	repo LocalRepo,
+	log logs.Log,
+) (sourcedriver.WorktreeManager, error) {
+	clone, err := repo.Source.Driver.LocalClone(ctx, repo.AbsoluteCloneDir, log)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open local clone: %w", err)
+	}
+
+	wm, ok := clone.(sourcedriver.WorktreeManager)
+	if !ok {
+		return nil, fmt.Errorf("the '%s' source does not support worktrees", repo.Source.Name)
+	}
+
+	return wm, nil
+}
+
+// linkedWorktrees returns the driver's worktree manager for a local clone and
+// the clone's additional working trees.
+//
+// Unlike worktreeManager(), it is not an error if the driver does not support
+// worktrees, in which case the manager is nil and there are no working trees.
+func linkedWorktrees(
+	ctx context.Context,
+	repo LocalRepo,
+	log logs.Log,
+) (sourcedriver.WorktreeManager, []sourcedriver.Worktree, error) {
+	clone, err := repo.Source.Driver.LocalClone(ctx, repo.AbsoluteCloneDir, log)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to open local clone: %w", err)
+	}
+
+	wm, ok := clone.(sourcedriver.WorktreeManager)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	worktrees, err := wm.Worktrees(ctx, log)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to list worktrees: %w", err)
+	}
+
+	return wm, worktrees, nil
+}
+
+// worktreeDir returns the directory in which the working tree of repo with the
+// given branch checked out is placed.
+//
+// Slashes in the branch name are replaced with hyphens so that the working
+// tree is always a sibling of the clone directory. This means that branches
+// such as "a/b" and "a-b" share a directory, which is detected when the second
+// working tree is added.
+func worktreeDir(repo LocalRepo, branch string) string {
+	return repo.AbsoluteCloneDir + "@" + strings.ReplaceAll(branch, "/", "-")
+}
@@ -0,0 +1,199 @@
+package source_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/gritcli/grit/daemon/internal/driver/sourcedriver"
+	"github.com/gritcli/grit/daemon/internal/logs"
+	. "github.com/gritcli/grit/daemon/internal/source"
+	"github.com/gritcli/grit/daemon/internal/stubs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type WorktreeManager", func() {
+	var (
+		tempDir string
+		clone   *stubs.WorktreeClone
+		src     Source
+		repo    LocalRepo
+		manager *WorktreeManager
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = os.MkdirTemp("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(func() {
+			os.RemoveAll(tempDir)
+		})
+
+		clone = &stubs.WorktreeClone{}
+
+		src = Source{
+			Name:         "<source>",
+			BaseCloneDir: filepath.Join(tempDir, "clones"),
+			Driver: &stubs.Source{
+				LocalCloneFunc: func(
+					context.Context,
+					string,
+					logs.Log,
+				) (sourcedriver.LocalClone, error) {
+					return clone, nil
+				},
+			},
+		}
+
+		repo = LocalRepo{
+			RemoteRepo: sourcedriver.RemoteRepo{
+				ID:               "<id>",
+				Name:             "owner/repo",
+				RelativeCloneDir: "owner/repo",
+			},
+			Source:           src,
+			AbsoluteCloneDir: filepath.Join(src.BaseCloneDir, "owner", "repo"),
+		}
+
+		manager = &WorktreeManager{}
+	})
+
+	Describe("func Add()", func() {
+		It("adds a worktree alongside the clone directory", func() {
+			var dir, branch string
+			clone.AddWorktreeFunc = func(_ context.Context, d, b string, _ logs.Log) error {
+				dir, branch = d, b
+				return nil
+			}
+
+			wt, err := manager.Add(context.Background(), repo, "feature/x", logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wt).To(Equal(Worktree{
+				Worktree: sourcedriver.Worktree{
+					Dir:    repo.AbsoluteCloneDir + "@feature-x",
+					Branch: "feature/x",
+				},
+				Repo: repo,
+			}))
+			Expect(dir).To(Equal(repo.AbsoluteCloneDir + "@feature-x"))
+			Expect(branch).To(Equal("feature/x"))
+		})
+
+		It("returns an error if the worktree directory already exists", func() {
+			dir := repo.AbsoluteCloneDir + "@feature"
+			err := os.MkdirAll(dir, 0700)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = manager.Add(context.Background(), repo, "feature", logs.Discard)
+			Expect(err).To(MatchError(dir + " already exists"))
+		})
+
+		It("returns an error if the worktree directory is used by another branch", func() {
+			dir := repo.AbsoluteCloneDir + "@feature-x"
+			err := os.MkdirAll(dir, 0700)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			clone.WorktreesFunc = func(context.Context, logs.Log) ([]sourcedriver.Worktree, error) {
+				return []sourcedriver.Worktree{
+					{Dir: dir, Branch: "feature-x"},
+				}, nil
+			}
+
+			_, err = manager.Add(context.Background(), repo, "feature/x", logs.Discard)
+			Expect(err).To(MatchError(dir + " is already used by the worktree for the 'feature-x' branch"))
+		})
+
+		It("returns an error if there is already a worktree for the branch", func() {
+			dir := repo.AbsoluteCloneDir + "@feature"
+			err := os.MkdirAll(dir, 0700)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			clone.WorktreesFunc = func(context.Context, logs.Log) ([]sourcedriver.Worktree, error) {
+				return []sourcedriver.Worktree{
+					{Dir: dir, Branch: "feature"},
+				}, nil
+			}
+
+			_, err = manager.Add(context.Background(), repo, "feature", logs.Discard)
+			Expect(err).To(MatchError("there is already a worktree for the 'feature' branch in " + dir))
+		})
+
+		It("returns an error if the driver fails", func() {
+			clone.AddWorktreeFunc = func(context.Context, string, string, logs.Log) error {
+				return errors.New("<error>")
+			}
+
+			_, err := manager.Add(context.Background(), repo, "feature", logs.Discard)
+			Expect(err).To(MatchError("unable to add worktree: <error>"))
+		})
+
+		It("returns an error if the source does not support worktrees", func() {
+			repo.Source.Driver = &stubs.Source{}
+
+			_, err := manager.Add(context.Background(), repo, "feature", logs.Discard)
+			Expect(err).To(MatchError("the '<source>' source does not support worktrees"))
+		})
+	})
+
+	Describe("func Remove()", func() {
+		var worktree sourcedriver.Worktree
+
+		BeforeEach(func() {
+			worktree = sourcedriver.Worktree{
+				Dir:    repo.AbsoluteCloneDir + "@feature",
+				Branch: "feature",
+			}
+
+			clone.WorktreesFunc = func(context.Context, logs.Log) ([]sourcedriver.Worktree, error) {
+				return []sourcedriver.Worktree{worktree}, nil
+			}
+		})
+
+		It("removes the worktree with the given branch checked out", func() {
+			var removed string
+			clone.RemoveWorktreeFunc = func(_ context.Context, dir string, force bool, _ logs.Log) error {
+				Expect(force).To(BeFalse())
+				removed = dir
+				return nil
+			}
+
+			wt, err := manager.Remove(context.Background(), repo, "feature", false, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wt).To(Equal(Worktree{worktree, repo}))
+			Expect(removed).To(Equal(worktree.Dir))
+		})
+
+		It("refuses to remove a worktree with uncommitted changes", func() {
+			worktree.HasUncommittedChanges = true
+
+			clone.RemoveWorktreeFunc = func(context.Context, string, bool, logs.Log) error {
+				Fail("unexpected call")
+				return nil
+			}
+
+			_, err := manager.Remove(context.Background(), repo, "feature", false, logs.Discard)
+			Expect(err).To(Equal(DirtyWorktreeError{worktree.Dir}))
+		})
+
+		It("removes a worktree with uncommitted changes if force is true", func() {
+			worktree.HasUncommittedChanges = true
+
+			var forced bool
+			clone.RemoveWorktreeFunc = func(_ context.Context, _ string, force bool, _ logs.Log) error {
+				forced = force
+				return nil
+			}
+
+			_, err := manager.Remove(context.Background(), repo, "feature", true, logs.Discard)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(forced).To(BeTrue())
+		})
+
+		It("returns an error if there is no worktree for the branch", func() {
+			_, err := manager.Remove(context.Background(), repo, "other", false, logs.Discard)
+			Expect(err).To(MatchError("there is no worktree for the 'other' branch"))
+		})
+	})
+})
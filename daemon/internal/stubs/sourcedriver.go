@@ -80,15 +80,17 @@ func (s *SourceConfig) DescribeSourceConfig() string {
 
 // Source is a test implementation of the sourcedriver.Source interface.
 type Source struct {
-	InitFunc      func(context.Context, sourcedriver.InitParameters, logs.Log) error
-	RunFunc       func(context.Context, logs.Log) error
-	StatusFunc    func(context.Context, logs.Log) (string, error)
-	SignInFunc    func(context.Context, logs.Log) error
-	SignOutFunc   func(context.Context, logs.Log) error
-	ResolveFunc   func(context.Context, string, logs.Log) ([]sourcedriver.RemoteRepo, error)
-	ClonerFunc    func(context.Context, string, logs.Log) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error)
-	SuggestFunc   func(string, logs.Log) map[string][]sourcedriver.RemoteRepo
-	ServeHTTPFunc http.HandlerFunc
+	InitFunc            func(context.Context, sourcedriver.InitParameters, logs.Log) error
+	RunFunc             func(context.Context, logs.Log) error
+	StatusFunc          func(context.Context, logs.Log) (string, error)
+	SignInFunc          func(context.Context, logs.Log) error
+	SignOutFunc         func(context.Context, logs.Log) error
+	ResolveFunc         func(context.Context, string, logs.Log) ([]sourcedriver.RemoteRepo, error)
+	ResolveCloneURLFunc func(context.Context, string, logs.Log) (sourcedriver.RemoteRepo, bool, error)
+	ClonerFunc          func(context.Context, string, logs.Log) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error)
+	LocalCloneFunc      func(context.Context, string, logs.Log) (sourcedriver.LocalClone, error)
+	SuggestFunc         func(string, logs.Log) map[string][]sourcedriver.RemoteRepo
+	ServeHTTPFunc       http.HandlerFunc
 }
 
 // Init returns s.InitFunc() if it is non-nil; otherwise, it returns nil.
@@ -151,6 +153,20 @@ func (s *Source) Resolve(
 	return nil, nil
 }
 
+// ResolveCloneURL returns s.ResolveCloneURLFunc() if it is non-nil; otherwise,
+// it returns ok == false.
+func (s *Source) ResolveCloneURL(
+	ctx context.Context,
+	url string,
+	log logs.Log,
+) (sourcedriver.RemoteRepo, bool, error) {
+	if s.ResolveCloneURLFunc != nil {
+		return s.ResolveCloneURLFunc(ctx, url, log)
+	}
+
+	return sourcedriver.RemoteRepo{}, false, nil
+}
+
 // Cloner returns s.ClonerFunc() if it is non-nil; otherwise, it returns an
 // error.
 func (s *Source) Cloner(
@@ -165,6 +181,20 @@ func (s *Source) Cloner(
 	return nil, sourcedriver.RemoteRepo{}, errors.New("<not implemented>")
 }
 
+// LocalClone returns s.LocalCloneFunc() if it is non-nil; otherwise, it returns
+// a new LocalClone stub.
+func (s *Source) LocalClone(
+	ctx context.Context,
+	dir string,
+	log logs.Log,
+) (sourcedriver.LocalClone, error) {
+	if s.LocalCloneFunc != nil {
+		return s.LocalCloneFunc(ctx, dir, log)
+	}
+
+	return &LocalClone{}, nil
+}
+
 // Suggest returns s.SuggestFunc() if it is non-nil; otherwise, it returns nil.
 func (s *Source) Suggest(word string, log logs.Log) map[string][]sourcedriver.RemoteRepo {
 	if s.SuggestFunc != nil {
@@ -181,20 +211,236 @@ func (s *Source) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// ForkingSource is a test implementation of the sourcedriver.Source interface
+// that also implements sourcedriver.Forker.
+type ForkingSource struct {
+	Source
+
+	ForkFunc func(context.Context, string, logs.Log) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error)
+}
+
+// Fork returns s.ForkFunc() if it is non-nil; otherwise, it returns an error.
+func (s *ForkingSource) Fork(
+	ctx context.Context,
+	id string,
+	log logs.Log,
+) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error) {
+	if s.ForkFunc != nil {
+		return s.ForkFunc(ctx, id, log)
+	}
+
+	return nil, sourcedriver.RemoteRepo{}, errors.New("<not implemented>")
+}
+
+// CreatingSource is a test implementation of the sourcedriver.Source interface
+// that also implements sourcedriver.Creator.
+type CreatingSource struct {
+	Source
+
+	CreateFunc func(context.Context, string, sourcedriver.CreateOptions, logs.Log) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error)
+}
+
+// Create returns s.CreateFunc() if it is non-nil; otherwise, it returns an
+// error.
+func (s *CreatingSource) Create(
+	ctx context.Context,
+	name string,
+	opts sourcedriver.CreateOptions,
+	log logs.Log,
+) (sourcedriver.Cloner, sourcedriver.RemoteRepo, error) {
+	if s.CreateFunc != nil {
+		return s.CreateFunc(ctx, name, opts, log)
+	}
+
+	return nil, sourcedriver.RemoteRepo{}, errors.New("<not implemented>")
+}
+
+// PullRequestSource is a test implementation of the sourcedriver.Source
+// interface that also implements sourcedriver.PullRequestSource.
+type PullRequestSource struct {
+	Source
+
+	ResolvePullRequestFunc  func(context.Context, string, logs.Log) (sourcedriver.PullRequest, bool, error)
+	CheckoutPullRequestFunc func(context.Context, string, sourcedriver.PullRequest, logs.Log) (string, error)
+}
+
+// ResolvePullRequest returns s.ResolvePullRequestFunc() if it is non-nil;
+// otherwise, it returns (sourcedriver.PullRequest{}, false, nil).
+func (s *PullRequestSource) ResolvePullRequest(
+	ctx context.Context,
+	query string,
+	log logs.Log,
+) (sourcedriver.PullRequest, bool, error) {
+	if s.ResolvePullRequestFunc != nil {
+		return s.ResolvePullRequestFunc(ctx, query, log)
+	}
+
+	return sourcedriver.PullRequest{}, false, nil
+}
+
+// CheckoutPullRequest returns s.CheckoutPullRequestFunc() if it is non-nil;
+// otherwise, it returns an error.
+func (s *PullRequestSource) CheckoutPullRequest(
+	ctx context.Context,
+	dir string,
+	pr sourcedriver.PullRequest,
+	log logs.Log,
+) (string, error) {
+	if s.CheckoutPullRequestFunc != nil {
+		return s.CheckoutPullRequestFunc(ctx, dir, pr, log)
+	}
+
+	return "", errors.New("<not implemented>")
+}
+
 // SourceCloner is a test implementation of the sourcedriver.SourceCloner
 // interface.
 type SourceCloner struct {
-	CloneFunc func(context.Context, string, logs.Log) error
+	CloneFunc func(context.Context, string, sourcedriver.CloneOptions, logs.Log) error
 }
 
 // Clone returns s.CloneFunc() if it is non-nil; otherwise, it returns nil.
 func (s *SourceCloner) Clone(
 	ctx context.Context,
 	dir string,
+	opts sourcedriver.CloneOptions,
 	log logs.Log,
 ) error {
 	if s.CloneFunc != nil {
-		return s.CloneFunc(ctx, dir, log)
+		return s.CloneFunc(ctx, dir, opts, log)
+	}
+
+	return nil
+}
+
+// LocalClone is a test implementation of the sourcedriver.LocalClone
+// interface.
+type LocalClone struct {
+	FetchFunc     func(context.Context, logs.Log) error
+	PullFunc      func(context.Context, logs.Log) (sourcedriver.PullResult, error)
+	StatusFunc    func(context.Context, logs.Log) (sourcedriver.LocalStatus, error)
+	RemoteURLFunc func(context.Context, logs.Log) (string, error)
+}
+
+// Fetch returns s.FetchFunc() if it is non-nil; otherwise, it returns nil.
+func (s *LocalClone) Fetch(
+	ctx context.Context,
+	log logs.Log,
+) error {
+	if s.FetchFunc != nil {
+		return s.FetchFunc(ctx, log)
+	}
+
+	return nil
+}
+
+// Pull returns s.PullFunc() if it is non-nil; otherwise, it returns a zero-value
+// result.
+func (s *LocalClone) Pull(
+	ctx context.Context,
+	log logs.Log,
+) (sourcedriver.PullResult, error) {
+	if s.PullFunc != nil {
+		return s.PullFunc(ctx, log)
+	}
+
+	return sourcedriver.PullResult{}, nil
+}
+
+// Status returns s.StatusFunc() if it is non-nil; otherwise, it returns a
+// zero-value status.
+func (s *LocalClone) Status(
+	ctx context.Context,
+	log logs.Log,
+) (sourcedriver.LocalStatus, error) {
+	if s.StatusFunc != nil {
+		return s.StatusFunc(ctx, log)
+	}
+
+	return sourcedriver.LocalStatus{}, nil
+}
+
+// RemoteURL returns s.RemoteURLFunc() if it is non-nil; otherwise, it returns
+// an empty string.
+func (s *LocalClone) RemoteURL(
+	ctx context.Context,
+	log logs.Log,
+) (string, error) {
+	if s.RemoteURLFunc != nil {
+		return s.RemoteURLFunc(ctx, log)
+	}
+
+	return "", nil
+}
+
+// WorktreeClone is a test implementation of the sourcedriver.LocalClone
+// interface that also implements sourcedriver.WorktreeManager.
+type WorktreeClone struct {
+	LocalClone
+
+	AddWorktreeFunc    func(context.Context, string, string, logs.Log) error
+	WorktreesFunc      func(context.Context, logs.Log) ([]sourcedriver.Worktree, error)
+	RemoveWorktreeFunc func(context.Context, string, bool, logs.Log) error
+}
+
+// AddWorktree returns s.AddWorktreeFunc() if it is non-nil; otherwise, it
+// returns nil.
+func (s *WorktreeClone) AddWorktree(
+	ctx context.Context,
+	dir, branch string,
+	log logs.Log,
+) error {
+	if s.AddWorktreeFunc != nil {
+		return s.AddWorktreeFunc(ctx, dir, branch, log)
+	}
+
+	return nil
+}
+
+// Worktrees returns s.WorktreesFunc() if it is non-nil; otherwise, it returns
+// nil.
+func (s *WorktreeClone) Worktrees(
+	ctx context.Context,
+	log logs.Log,
+) ([]sourcedriver.Worktree, error) {
+	if s.WorktreesFunc != nil {
+		return s.WorktreesFunc(ctx, log)
+	}
+
+	return nil, nil
+}
+
+// RemoveWorktree returns s.RemoveWorktreeFunc() if it is non-nil; otherwise,
+// it returns nil.
+func (s *WorktreeClone) RemoveWorktree(
+	ctx context.Context,
+	dir string,
+	force bool,
+	log logs.Log,
+) error {
+	if s.RemoveWorktreeFunc != nil {
+		return s.RemoveWorktreeFunc(ctx, dir, force, log)
+	}
+
+	return nil
+}
+
+// DissociatingClone is a test implementation of the sourcedriver.LocalClone
+// interface that also implements sourcedriver.Dissociator.
+type DissociatingClone struct {
+	LocalClone
+
+	DissociateFunc func(context.Context, logs.Log) error
+}
+
+// Dissociate returns s.DissociateFunc() if it is non-nil; otherwise, it
+// returns nil.
+func (s *DissociatingClone) Dissociate(
+	ctx context.Context,
+	log logs.Log,
+) error {
+	if s.DissociateFunc != nil {
+		return s.DissociateFunc(ctx, log)
 	}
 
 	return nil
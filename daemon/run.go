@@ -91,6 +91,8 @@ func run(reloads int) (reload bool, err error) {
 
 	g := con.WaitGroup(ctx)
 	imbue.Go2(g, runSourceDrivers)
+	imbue.Go2(g, scanIndex)
+	imbue.Go2(g, removeStaleStagingDirs)
 	imbue.Go3(g, runGRPCServer)
 	imbue.Go3(g, runHTTPServer)
 
@@ -198,6 +200,34 @@ func runSourceDrivers(
 	return g.Wait()
 }
 
+// scanIndex adds any local clones that were made before the index existed to
+// the index.
+func scanIndex(
+	ctx context.Context,
+	x *source.Index,
+	log logs.Log,
+) error {
+	if err := x.Scan(ctx, log); err != nil && ctx.Err() == nil {
+		log.Write("%s", err)
+	}
+
+	return nil
+}
+
+// removeStaleStagingDirs removes the staging directories left behind by
+// interrupted clone operations.
+func removeStaleStagingDirs(
+	ctx context.Context,
+	c *source.Cloner,
+	log logs.Log,
+) error {
+	if err := c.RemoveStaleStagingDirs(); err != nil && ctx.Err() == nil {
+		log.Write("%s", err)
+	}
+
+	return nil
+}
+
 // runGRPCServer runs the gRPC server.
 func runGRPCServer(
 	ctx context.Context,
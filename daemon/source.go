@@ -2,6 +2,7 @@ package daemon
 
 import (
 	"net/url"
+	"path/filepath"
 
 	"github.com/dogmatiq/imbue"
 	"github.com/gritcli/grit/daemon/internal/config"
@@ -25,19 +26,107 @@ func init() {
 	)
 
 	imbue.With2(
+		catalog,
+		func(
+			ctx imbue.Context,
+			cfg config.Config,
+			sources source.List,
+		) (*source.Index, error) {
+			return &source.Index{
+				File:    filepath.Join(cfg.Daemon.DataDir, "clones.json"),
+				Sources: sources,
+			}, nil
+		},
+	)
+
+	imbue.With3(
 		catalog,
 		func(
 			ctx imbue.Context,
 			sources source.List,
+			index *source.Index,
 			log logs.Log,
 		) (*source.Cloner, error) {
 			return &source.Cloner{
 				Sources: sources,
+				Index:   index,
 				Log:     log,
 			}, nil
 		},
 	)
 
+	imbue.With1(
+		catalog,
+		func(
+			ctx imbue.Context,
+			log logs.Log,
+		) (*source.Updater, error) {
+			return &source.Updater{
+				Log: log,
+			}, nil
+		},
+	)
+
+	imbue.With2(
+		catalog,
+		func(
+			ctx imbue.Context,
+			index *source.Index,
+			log logs.Log,
+		) (*source.Remover, error) {
+			return &source.Remover{
+				Index: index,
+				Log:   log,
+			}, nil
+		},
+	)
+
+	imbue.With3(
+		catalog,
+		func(
+			ctx imbue.Context,
+			sources source.List,
+			index *source.Index,
+			log logs.Log,
+		) (*source.Archiver, error) {
+			return &source.Archiver{
+				Sources: sources,
+				Index:   index,
+				Log:     log,
+			}, nil
+		},
+	)
+
+	imbue.With3(
+		catalog,
+		func(
+			ctx imbue.Context,
+			sources source.List,
+			index *source.Index,
+			log logs.Log,
+		) (*source.Adopter, error) {
+			return &source.Adopter{
+				Sources: sources,
+				Index:   index,
+				Log:     log,
+			}, nil
+		},
+	)
+
+	imbue.With2(
+		catalog,
+		func(
+			ctx imbue.Context,
+			index *source.Index,
+			log logs.Log,
+		) (*source.Relocator, error) {
+			return &source.Relocator{
+				Index: index,
+				Log:   log,
+			}, nil
+		},
+	)
+
 	imbue.With2(
 		catalog,
 		func(
@@ -51,4 +140,34 @@ func init() {
 			}, nil
 		},
 	)
+
+	imbue.With1(
+		catalog,
+		func(
+			ctx imbue.Context,
+			log logs.Log,
+		) (*source.WorktreeManager, error) {
+			return &source.WorktreeManager{
+				Log: log,
+			}, nil
+		},
+	)
+
+	imbue.With4(
+		catalog,
+		func(
+			ctx imbue.Context,
+			sources source.List,
+			index *source.Index,
+			cloner *source.Cloner,
+			log logs.Log,
+		) (*source.PullRequestManager, error) {
+			return &source.PullRequestManager{
+				Sources: sources,
+				Index:   index,
+				Cloner:  cloner,
+				Log:     log,
+			}, nil
+		},
+	)
 }